@@ -7,13 +7,16 @@ package api // import "github.com/SevereCloud/vksdk/v2/api"
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"mime"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -82,7 +85,7 @@ const (
 
 // VK struct.
 type VK struct {
-	accessTokens []string
+	accessTokens atomic.Value // []string
 	lastToken    uint32
 	MethodURL    string
 	Version      string
@@ -91,9 +94,43 @@ type VK struct {
 	UserAgent    string
 	Handler      func(method string, params ...Params) (Response, error)
 
+	// TokenSource specifies an optional source of tokens, which is used
+	// instead of the tokens of NewVK.
+	TokenSource TokenSource
+
+	// Logger specifies an optional logger of requests, enabled with
+	// SetDebug.
+	Logger Logger
+
+	// Retry configures retries of requests that failed with retryable
+	// errors.
+	Retry Retry
+
+	// Limiter specifies an optional limiter of requests. If nil, requests
+	// are limited to Limit per second for each token.
+	Limiter Limiter
+
+	// JSON specifies an optional codec of responses. If nil,
+	// encoding/json is used.
+	JSON JSONCodec
+
+	// CaptchaSolver specifies an optional solver of the "Captcha needed"
+	// error. The request is repeated with the key of the captcha.
+	CaptchaSolver CaptchaSolver
+
+	// ctx is the context of requests set by WithContext.
+	ctx           context.Context
+	tokenType     TokenType
+	middlewares   []Middleware
+	msgpack       bool
+	testMode      bool
+	lang          string // empty if not set
+	defaultParams Params
+	debug         int32
+
 	mux      sync.Mutex
-	lastTime time.Time
-	rps      int
+	limit    int
+	limiters map[string]*windowLimiter // by token
 }
 
 // Response struct.
@@ -101,40 +138,178 @@ type Response struct {
 	Response      json.RawMessage `json:"response"`
 	Error         Error           `json:"error"`
 	ExecuteErrors ExecuteErrors   `json:"execute_errors"`
+
+	// Raw is the JSON body of the response, MessagePack bodies are
+	// converted to JSON. It is set by DefaultHandler.
+	Raw json.RawMessage `json:"-"`
+
+	// Header is the header of the HTTP response, for example, with the
+	// request ID for the support of VK. It is set by DefaultHandler.
+	Header http.Header `json:"-"`
+
+	// Attempts is the number of attempts of the request, including
+	// retries. It is set by DefaultHandler.
+	Attempts int `json:"-"`
 }
 
 // NewVK returns a new VK.
 //
-// The VKSDK will use the http.DefaultClient.
-// This means that if the http.DefaultClient is modified by other components
-// of your application the modifications will be picked up by the SDK as well.
+// The VKSDK will use the client of NewClient with the default settings,
+// which is shared by all VKs. You can configure the VKSDK to use the custom
+// HTTP Client by setting the VK.Client value:
 //
-// In some cases this might be intended, but it is a better practice
-// to create a custom HTTP Client to share explicitly through
-// your application. You can configure the VKSDK to use the custom
-// HTTP Client by setting the VK.Client value.
+//	vk.Client = api.NewClient(api.WithMaxIdleConnsPerHost(50))
 //
-// This set limit 20 requests per second for one token.
+// This set limit 20 requests per second for one token and up to 5 attempts
+// of requests that failed with DefaultRetryCodes.
+//
+// With several tokens, requests use them in turn (round-robin) and each
+// token is limited separately:
+//
+//	vk := api.NewVK(token1, token2, token3) // 60 requests per second
 func NewVK(tokens ...string) *VK {
 	var vk VK
 
-	vk.accessTokens = tokens
+	vk.setTokens(tokens)
 	vk.Version = Version
 
 	vk.Handler = vk.DefaultHandler
 
 	vk.MethodURL = MethodURL
-	vk.Client = http.DefaultClient
+	vk.Client = defaultClient
 	vk.Limit = LimitGroupToken
 	vk.UserAgent = internal.UserAgent
+	vk.Retry = Retry{
+		MaxAttempts: 5,
+		Min:         100 * time.Millisecond,
+		Max:         5 * time.Second,
+	}
 
 	return &vk
 }
 
+// WithContext returns a shallow copy of vk, whose requests, including
+// uploads, use the ctx. The context set by Params.WithContext overrides it.
+//
+//	ctx, cancel := context.WithTimeout(ctx, time.Second)
+//	defer cancel()
+//
+//	users, err := vk.WithContext(ctx).UsersGet(nil)
+//
+// The copy shares the handler and so the rate limiter with vk.
+func (vk *VK) WithContext(ctx context.Context) *VK {
+	if ctx == nil {
+		panic("api: nil context")
+	}
+
+	c := vk.clone()
+	c.ctx = ctx
+
+	return c
+}
+
+// clone returns a shallow copy of vk that shares the handler of vk.
+func (vk *VK) clone() *VK {
+	c := &VK{
+		MethodURL:     vk.MethodURL,
+		Version:       vk.Version,
+		Client:        vk.Client,
+		Limit:         vk.Limit,
+		UserAgent:     vk.UserAgent,
+		Handler:       vk.Handler,
+		Retry:         vk.Retry,
+		Limiter:       vk.Limiter,
+		CaptchaSolver: vk.CaptchaSolver,
+		JSON:          vk.JSON,
+		TokenSource:   vk.TokenSource,
+		ctx:           vk.ctx,
+		tokenType:     vk.tokenType,
+		middlewares:   vk.middlewares[:len(vk.middlewares):len(vk.middlewares)],
+		msgpack:       vk.msgpack,
+		testMode:      vk.testMode,
+		lang:          vk.lang,
+		defaultParams: vk.defaultParams,
+		Logger:        vk.Logger,
+		debug:         atomic.LoadInt32(&vk.debug),
+	}
+	c.setTokens(vk.tokens())
+
+	return c
+}
+
+// TestMode adds test_mode=1 to all requests, which allows to send requests
+// from a native app without switching it on for all users, for example,
+// to run a staging bot. Params.TestMode overrides it for the request.
+func (vk *VK) TestMode(v bool) {
+	vk.testMode = v
+}
+
+// SetLang sets the language of the data of all requests, for example,
+// names of countries and cities and cases of names of users. Params.Lang
+// overrides it for the request.
+//
+//	vk.SetLang(object.LangEN)
+func (vk *VK) SetLang(v int) {
+	vk.lang = strconv.Itoa(v)
+}
+
+// DefaultParams sets the params that are added to all requests, for
+// example, the fields of users. Params of a request override them.
+//
+//	vk.DefaultParams(api.Params{"fields": "photo_100,screen_name"})
+func (vk *VK) DefaultParams(params Params) {
+	vk.defaultParams = make(Params, len(params))
+	for k, v := range params {
+		vk.defaultParams[k] = v
+	}
+}
+
+// EnableMessagePack enables MessagePack instead of JSON in responses of
+// the DefaultHandler. MessagePack responses are smaller than JSON ones.
+//
+// See https://msgpack.org
+func (vk *VK) EnableMessagePack() {
+	vk.msgpack = true
+}
+
+// context returns the context of requests.
+func (vk *VK) context() context.Context {
+	if vk.ctx != nil {
+		return vk.ctx
+	}
+
+	return context.Background()
+}
+
+// baseParams returns the params that are added before params of
+// a request.
+func (vk *VK) baseParams() Params {
+	params := Params{"v": vk.Version}
+	if vk.ctx != nil {
+		params.WithContext(vk.ctx)
+	}
+
+	if vk.testMode {
+		params.TestMode(true)
+	}
+
+	if vk.lang != "" {
+		params["lang"] = vk.lang
+	}
+
+	for k, v := range vk.defaultParams {
+		params[k] = v
+	}
+
+	return params
+}
+
 // getToken return next token (simple round-robin).
 func (vk *VK) getToken() string {
+	tokens := vk.tokens()
 	i := atomic.AddUint32(&vk.lastToken, 1)
-	return vk.accessTokens[(int(i)-1)%len(vk.accessTokens)]
+
+	return tokens[(int(i)-1)%len(tokens)]
 }
 
 // Params type.
@@ -153,6 +328,15 @@ func (p Params) Lang(v int) Params {
 	return p
 }
 
+// Version overrides the API version of the request, so methods can be
+// migrated to a newer version one by one.
+//
+// 	p.Version("5.131")
+func (p Params) Version(v string) Params {
+	p["v"] = v
+	return p
+}
+
 // TestMode allows to send requests from a native app without switching it on
 // for all users.
 func (p Params) TestMode(v bool) Params {
@@ -196,10 +380,12 @@ func buildQuery(sliceParams ...Params) (context.Context, url.Values) {
 
 	for _, params := range sliceParams {
 		for key, value := range params {
-			if key != ":context" {
-				query.Set(key, FmtValue(value, 0))
-			} else {
+			switch key {
+			case ":context":
 				ctx = value.(context.Context)
+			case ":header":
+			default:
+				query.Set(key, FmtValue(value, 0))
 			}
 		}
 	}
@@ -207,33 +393,49 @@ func buildQuery(sliceParams ...Params) (context.Context, url.Values) {
 	return ctx, query
 }
 
+// buildHeader returns the header set by middlewares.
+func buildHeader(sliceParams ...Params) http.Header {
+	header := http.Header{}
+
+	for _, params := range sliceParams {
+		if h, ok := params[":header"].(http.Header); ok {
+			for key, values := range h {
+				header[key] = values
+			}
+		}
+	}
+
+	return header
+}
+
 // DefaultHandler provides access to VK API methods.
 func (vk *VK) DefaultHandler(method string, sliceParams ...Params) (Response, error) {
 	u := vk.MethodURL + method
+	if vk.msgpack {
+		u += ".msgpack"
+	}
+
 	ctx, query := buildQuery(sliceParams...)
+	header := buildHeader(sliceParams...)
 	attempt := 0
 
+	// the previous captcha, whose key is in the query
+	var captcha *Captcha
+
 	for {
 		var response Response
 
 		attempt++
+		response.Attempts = attempt
 
-		// Rate limiting
-		if vk.Limit > 0 {
-			vk.mux.Lock()
-
-			sleepTime := time.Second - time.Since(vk.lastTime)
-			if sleepTime < 0 {
-				vk.lastTime = time.Now()
-				vk.rps = 0
-			} else if vk.rps == vk.Limit*len(vk.accessTokens) {
-				time.Sleep(sleepTime)
-				vk.lastTime = time.Now()
-				vk.rps = 0
-			}
-			vk.rps++
+		// the request is not sent after the deadline of the context
+		if err := ctx.Err(); err != nil {
+			return response, err
+		}
 
-			vk.mux.Unlock()
+		// Rate limiting
+		if err := vk.wait(ctx, query.Get("access_token")); err != nil {
+			return response, err
 		}
 
 		rawBody := bytes.NewBufferString(query.Encode())
@@ -245,55 +447,217 @@ func (vk *VK) DefaultHandler(method string, sliceParams ...Params) (Response, er
 
 		req.Header.Set("User-Agent", vk.UserAgent)
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		start := time.Now()
 
 		resp, err := vk.Client.Do(req)
 		if err != nil {
-			return response, err
+			vk.logRequest(method, query, time.Since(start), err)
+			return response, contextError(ctx, err)
+		}
+
+		if retryStatus(resp) {
+			_ = resp.Body.Close()
+
+			response.Header = resp.Header
+			err := &StatusError{
+				StatusCode: resp.StatusCode,
+				RetryAfter: retryAfter(resp.Header, time.Now()),
+			}
+			vk.logRequest(method, query, time.Since(start), err)
+
+			if attempt >= vk.Retry.MaxAttempts {
+				return response, err
+			}
+
+			delay := err.RetryAfter
+			if delay <= 0 {
+				delay = vk.Retry.Delay(attempt)
+			}
+
+			if !beforeDeadline(ctx, delay) {
+				return response, context.DeadlineExceeded
+			}
+
+			if err := sleep(ctx, delay); err != nil {
+				return response, err
+			}
+
+			continue
 		}
 
 		mediatype, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if mediatype != "application/json" {
+		if mediatype != "application/json" && mediatype != "application/x-msgpack" {
 			_ = resp.Body.Close()
-			return response, &InvalidContentType{mediatype}
+
+			err = &InvalidContentType{mediatype}
+			vk.logRequest(method, query, time.Since(start), err)
+
+			return response, err
 		}
 
-		err = json.NewDecoder(resp.Body).Decode(&response)
+		response.Raw, err = vk.decodeBody(resp, mediatype, &response)
 		if err != nil {
 			_ = resp.Body.Close()
-			return response, err
+
+			vk.logRequest(method, query, time.Since(start), err)
+
+			return response, contextError(ctx, err)
 		}
 
 		_ = resp.Body.Close()
 
-		switch response.Error.Code {
-		case ErrNoType:
+		response.Header = resp.Header
+
+		if response.Error.Code == ErrNoType {
+			vk.logRequest(method, query, time.Since(start), nil)
 			return response, nil
-		case ErrTooMany:
-			if attempt < vk.Limit {
-				continue
+		}
+
+		vk.logRequest(method, query, time.Since(start), &response.Error)
+
+		if response.Error.Code == ErrCaptcha && vk.CaptchaSolver != nil {
+			c := Captcha{
+				Method: method,
+				SID:    response.Error.CaptchaSID,
+				Img:    response.Error.CaptchaImg,
+			}
+
+			key, err := vk.solveCaptcha(ctx, c, captcha, query.Get("captcha_key"))
+			if err != nil {
+				return response, err
+			}
+
+			captcha = &c
+
+			query.Set("captcha_sid", response.Error.CaptchaSID)
+			query.Set("captcha_key", key)
+
+			// the captcha does not spend the attempts
+			attempt--
+
+			continue
+		}
+
+		if attempt < vk.Retry.MaxAttempts && vk.Retry.retryable(response.Error.Code) {
+			delay := retryAfter(response.Header, time.Now())
+			if delay <= 0 {
+				delay = vk.Retry.Delay(attempt)
+			}
+
+			// the retry cannot be sent before the deadline, so it is not
+			// waited for
+			if !beforeDeadline(ctx, delay) {
+				return response, context.DeadlineExceeded
+			}
+
+			if err := sleep(ctx, delay); err != nil {
+				return response, err
 			}
 
-			return response, &response.Error
+			continue
 		}
 
+		response.Error.Method = method
+		response.Error.Params = sanitize(query)
+
 		return response, &response.Error
 	}
 }
 
 // Request provides access to VK API methods.
 func (vk *VK) Request(method string, sliceParams ...Params) ([]byte, error) {
-	token := vk.getToken()
+	resp, err := vk.RequestResponse(method, sliceParams...)
 
-	reqParams := Params{
-		"access_token": token,
-		"v":            vk.Version,
+	return resp.Response, err
+}
+
+// RequestResponse provides access to VK API methods like Request, but
+// returns the whole response with the raw body and the HTTP header:
+//
+//	resp, err := vk.RequestResponse("users.get", nil)
+//	log.Print(string(resp.Raw), resp.Header.Get("X-Frontend"))
+//
+// The raw body and the header are set only by DefaultHandler.
+func (vk *VK) RequestResponse(method string, sliceParams ...Params) (Response, error) {
+	token, err := vk.token(sliceParams...)
+	if err != nil {
+		return Response{}, err
 	}
 
-	sliceParams = append(sliceParams, reqParams)
+	// the version and the context can be overridden by params of the request
+	sliceParams = append([]Params{vk.baseParams()}, sliceParams...)
+	sliceParams = append(sliceParams, Params{"access_token": token})
 
-	resp, err := vk.Handler(method, sliceParams...)
+	return vk.do(method, sliceParams...)
+}
 
-	return resp.Response, err
+// decodeBody decodes the JSON or MessagePack body of the response, which
+// may be compressed with gzip, and returns the JSON body.
+func (vk *VK) decodeBody(resp *http.Response, mediatype string, v interface{}) ([]byte, error) {
+	body := resp.Body
+
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+
+		body = zr
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if mediatype == "application/x-msgpack" {
+		data, err = internal.MsgpackToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, vk.unmarshal(data, v)
+}
+
+// contextError returns the error of the context if it is done, so that
+// the error of the request is context.Canceled or
+// context.DeadlineExceeded itself rather than the wrapping *url.Error.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	return err
+}
+
+// beforeDeadline reports whether the delay ends before the deadline of the
+// context.
+func beforeDeadline(ctx context.Context, d time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+
+	return !ok || time.Now().Add(d).Before(deadline)
+}
+
+// sleep pauses the current goroutine for at least the duration d or until
+// the ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 // RequestUnmarshal provides access to VK API methods.
@@ -303,7 +667,7 @@ func (vk *VK) RequestUnmarshal(method string, obj interface{}, sliceParams ...Pa
 		return err
 	}
 
-	return json.Unmarshal(rawResponse, &obj)
+	return vk.unmarshal(rawResponse, &obj)
 }
 
 func fmtReflectValue(value reflect.Value, depth int) string {
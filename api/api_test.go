@@ -1,18 +1,24 @@
 package api_test
 
 import (
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/api/params"
 	"github.com/SevereCloud/vksdk/v2/object"
 	"github.com/stretchr/testify/assert"
 )
@@ -210,6 +216,267 @@ func TestVK_Request(t *testing.T) {
 	}
 }
 
+func TestVK_RequestVersion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":"` + r.FormValue("v") + `"}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	resp, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+api.Version+`"`, string(resp))
+
+	resp, err = vk.Request("test", api.Params{"v": "5.103"})
+	assert.NoError(t, err)
+	assert.Equal(t, `"5.103"`, string(resp))
+
+	resp, err = vk.Request("test", api.Params{}.Version("5.131"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"5.131"`, string(resp))
+
+	var version string
+
+	b := params.NewUsersGetBuilder()
+	b.Version("5.199")
+
+	assert.NoError(t, vk.RequestUnmarshal("test", &version, b.Params))
+	assert.Equal(t, "5.199", version)
+}
+
+func TestVK_TestMode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":"` + r.FormValue("test_mode") + `"}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	resp, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `""`, string(resp))
+
+	vk.TestMode(true)
+
+	resp, err = vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1"`, string(resp))
+
+	resp, err = vk.WithContext(context.Background()).Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"1"`, string(resp))
+
+	resp, err = vk.Request("test", api.Params{}.TestMode(false))
+	assert.NoError(t, err)
+	assert.Equal(t, `"0"`, string(resp))
+}
+
+func TestVK_SetLang(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":"` + r.FormValue("lang") + `"}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	resp, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `""`, string(resp))
+
+	vk.SetLang(object.LangRU)
+
+	resp, err = vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"0"`, string(resp))
+
+	resp, err = vk.Request("test", api.Params{}.Lang(object.LangEN))
+	assert.NoError(t, err)
+	assert.Equal(t, `"3"`, string(resp))
+}
+
+func TestVK_RequestDeadline(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := vk.WithContext(ctx).Request("test", nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// the request is not sent after the deadline
+	_, err = vk.Request("test", api.Params{}.WithContext(ctx))
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestVK_DefaultParams(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("users.get", []int{})
+
+	vk := fake.VK()
+
+	params := api.Params{"fields": "photo_100", "https": 1}
+	vk.DefaultParams(params)
+
+	// the params are copied
+	params["fields"] = "sex"
+
+	_, err := vk.Request("users.get", nil)
+	assert.NoError(t, err)
+
+	_, err = vk.Request("users.get", api.Params{"fields": "city"})
+	assert.NoError(t, err)
+
+	calls := fake.Calls("users.get")
+	if assert.Len(t, calls, 2) {
+		assert.Equal(t, "photo_100", calls[0].Params["fields"])
+		assert.Equal(t, 1, calls[0].Params["https"])
+		assert.Equal(t, "city", calls[1].Params["fields"])
+		assert.Equal(t, 1, calls[1].Params["https"])
+	}
+}
+
+func TestVK_RequestGzip(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			_, _ = w.Write([]byte(`{"response":"plain"}`))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write([]byte(`{"response":"gzip"}`))
+		_ = zw.Close()
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	resp, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"gzip"`, string(resp))
+}
+
+func TestVK_RequestResponse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "42")
+		w.Header().Set("Content-Encoding", "gzip")
+
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write([]byte(`{"response":[1],"extra":true}`))
+		_ = zw.Close()
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	resp, err := vk.RequestResponse("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1]`, string(resp.Response))
+	assert.Equal(t, `{"response":[1],"extra":true}`, string(resp.Raw))
+	assert.Equal(t, "42", resp.Header.Get("X-Request-Id"))
+}
+
+func TestVK_EnableMessagePack(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users.get.msgpack", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/x-msgpack")
+		// {"response":[{"id":1}]}
+		_, _ = w.Write([]byte{0x81, 0xa8, 'r', 'e', 's', 'p', 'o', 'n', 's', 'e', 0x91, 0x81, 0xa2, 'i', 'd', 0x01})
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.EnableMessagePack()
+
+	users, err := vk.UsersGet(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, users[0].ID)
+
+	resp, err := vk.RequestResponse("users.get", nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"response":[{"id":1}]}`, string(resp.Raw))
+}
+
+func TestVK_WithContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":1}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	vkCtx := vk.WithContext(ctx)
+	assert.Equal(t, vk.MethodURL, vkCtx.MethodURL)
+
+	_, err := vkCtx.Request("test", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = vkCtx.UploadFile(server.URL, strings.NewReader("file"), "file", "file.txt")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the context of params overrides the context of vk
+	_, err = vkCtx.Request("test", api.Params{}.WithContext(context.Background()))
+	assert.NoError(t, err)
+
+	_, err = vk.Request("test", nil)
+	assert.NoError(t, err)
+}
+
 func TestVK_RequestLimit(t *testing.T) {
 	t.Parallel()
 
@@ -324,5 +591,5 @@ func TestContext(t *testing.T) {
 	p.WithContext(ctx)
 
 	_, err := vkUser.UsersGet(p)
-	assert.EqualError(t, err, "Post \"https://api.vk.com/method/users.get\": context deadline exceeded")
+	assert.Equal(t, context.DeadlineExceeded, err)
 }
@@ -0,0 +1,130 @@
+/*
+Package apitest implements a programmable fake of VK API for unit tests.
+
+	fake := apitest.New()
+	fake.On("users.get", []object.UsersUser{{ID: 1, FirstName: "Павел"}})
+	fake.OnError("messages.send", api.ErrPermission, "Permission to perform this action is denied")
+
+	vk := fake.VK()
+	users, err := vk.UsersGet(nil)
+
+	calls := fake.Calls("users.get")
+*/
+package apitest // import "github.com/SevereCloud/vksdk/v2/api/apitest"
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+)
+
+// HandlerFunc returns the response to the request.
+type HandlerFunc func(req api.Request) (api.Response, error)
+
+// Fake is a fake of VK API. It implements api.Doer.
+//
+// Methods without handlers return the "Unknown method passed" error.
+type Fake struct {
+	mux      sync.Mutex
+	handlers map[string]HandlerFunc
+	calls    []api.Request
+}
+
+// New returns a new Fake.
+func New() *Fake {
+	return &Fake{
+		handlers: make(map[string]HandlerFunc),
+	}
+}
+
+// VK returns api.VK that sends requests to the fake without rate limiting
+// and retries.
+func (f *Fake) VK(tokens ...string) *api.VK {
+	if len(tokens) == 0 {
+		tokens = []string{"token"}
+	}
+
+	vk := api.NewVK(tokens...)
+	vk.Handler = api.DoerHandler(f)
+	vk.Limit = 0
+	vk.Retry = api.Retry{}
+
+	return vk
+}
+
+// OnFunc sets the handler of the method.
+func (f *Fake) OnFunc(method string, h HandlerFunc) {
+	f.mux.Lock()
+	f.handlers[method] = h
+	f.mux.Unlock()
+}
+
+// On sets the response of the method. The response is encoded to JSON.
+func (f *Fake) On(method string, response interface{}) {
+	b, err := json.Marshal(response)
+	if err != nil {
+		panic("apitest: " + err.Error())
+	}
+
+	f.OnFunc(method, func(req api.Request) (api.Response, error) {
+		return api.Response{Response: b}, nil
+	})
+}
+
+// OnError sets the error of the method.
+func (f *Fake) OnError(method string, code api.ErrorType, msg string) {
+	f.OnFunc(method, func(req api.Request) (api.Response, error) {
+		return errorResponse(code, msg)
+	})
+}
+
+// Do implements api.Doer.
+func (f *Fake) Do(req api.Request) (api.Response, error) {
+	f.mux.Lock()
+	f.calls = append(f.calls, req)
+	h, ok := f.handlers[req.Method]
+	f.mux.Unlock()
+
+	if !ok {
+		return errorResponse(api.ErrMethod, "Unknown method passed")
+	}
+
+	return h(req)
+}
+
+// Calls returns the requests of the method. If the method is empty, all
+// requests are returned.
+func (f *Fake) Calls(method string) []api.Request {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	var calls []api.Request
+
+	for _, req := range f.calls {
+		if method == "" || req.Method == method {
+			calls = append(calls, req)
+		}
+	}
+
+	return calls
+}
+
+// Reset removes the handlers and the requests.
+func (f *Fake) Reset() {
+	f.mux.Lock()
+	f.handlers = make(map[string]HandlerFunc)
+	f.calls = nil
+	f.mux.Unlock()
+}
+
+func errorResponse(code api.ErrorType, msg string) (api.Response, error) {
+	resp := api.Response{
+		Error: api.Error{
+			Code:    code,
+			Message: msg,
+		},
+	}
+
+	return resp, &resp.Error
+}
@@ -0,0 +1,56 @@
+package apitest_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("users.get", []object.UsersUser{{ID: 1, FirstName: "Павел"}})
+	fake.OnError("messages.send", api.ErrPermission, "Permission to perform this action is denied")
+	fake.OnFunc("utils.getServerTime", func(req api.Request) (api.Response, error) {
+		assert.Equal(t, "value", req.Header.Get("X-Test"))
+		return api.Response{Response: []byte(`123`)}, nil
+	})
+
+	vk := fake.VK()
+	vk.Use(func(req api.Request, next api.Doer) (api.Response, error) {
+		req.Header.Set("X-Test", "value")
+		return next.Do(req)
+	})
+
+	users, err := vk.UsersGet(api.Params{"user_ids": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "Павел", users[0].FirstName)
+
+	_, err = vk.MessagesSend(nil)
+	assert.True(t, errors.Is(err, api.ErrPermission), err)
+
+	_, err = vk.FriendsGet(nil)
+	assert.True(t, errors.Is(err, api.ErrMethod), err)
+
+	serverTime, err := vk.UtilsGetServerTime(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 123, serverTime)
+
+	calls := fake.Calls("users.get")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, 1, calls[0].Params["user_ids"])
+		assert.Equal(t, "token", calls[0].Params["access_token"])
+		assert.Equal(t, http.Header{"X-Test": {"value"}}, calls[0].Header)
+	}
+
+	assert.Len(t, fake.Calls(""), 4)
+
+	fake.Reset()
+	assert.Empty(t, fake.Calls(""))
+}
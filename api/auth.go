@@ -24,3 +24,41 @@ func (vk *VK) AuthRestore(params Params) (response AuthRestoreResponse, err erro
 	err = vk.RequestUnmarshal("auth.restore", &response, params)
 	return
 }
+
+// AuthRefreshTokenResponse struct.
+type AuthRefreshTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// AuthRefreshToken allows to refresh the access token.
+//
+// https://vk.com/dev/auth.refreshToken
+func (vk *VK) AuthRefreshToken(params Params) (response AuthRefreshTokenResponse, err error) {
+	err = vk.RequestUnmarshal("auth.refreshToken", &response, params)
+	return
+}
+
+// AuthExchangeSilentAuthTokenResponse struct.
+type AuthExchangeSilentAuthTokenResponse struct {
+	AccessToken       string `json:"access_token"`
+	AccessTokenID     string `json:"access_token_id"`
+	UserID            int    `json:"user_id"`
+	Phone             string `json:"phone"`
+	PhoneValidated    int    `json:"phone_validated"`
+	IsPartial         bool   `json:"is_partial"`
+	IsService         bool   `json:"is_service"`
+	Email             string `json:"email"`
+	Source            int    `json:"source"`
+	SourceDescription string `json:"source_description"`
+}
+
+// AuthExchangeSilentAuthToken exchanges the silent token of VK ID for the
+// access token of the user. Requires the service token.
+//
+// https://id.vk.com/about/business/go/docs/ru/vkid/latest/vk-id/connection/api-integration/api-description
+func (vk *VK) AuthExchangeSilentAuthToken(
+	params Params,
+) (response AuthExchangeSilentAuthTokenResponse, err error) {
+	err = vk.RequestUnmarshal("auth.exchangeSilentAuthToken", &response, params)
+	return
+}
@@ -25,3 +25,37 @@ func TestVK_AuthCheckPhone(t *testing.T) {
 		"client_secret": clientSecret,
 	})
 }
+
+func TestVK_AuthRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":{"token":"new_token"}}`)
+
+	resp, err := vk.AuthRefreshToken(api.Params{"receipt": "receipt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Token != "new_token" {
+		t.Errorf("AuthRefreshToken().Token = %q, want new_token", resp.Token)
+	}
+}
+
+func TestVK_AuthExchangeSilentAuthToken(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":{"access_token":"token","user_id":1,"phone":"+7999"}}`)
+	vk.SetTokenType(api.TokenService)
+
+	resp, err := vk.AuthExchangeSilentAuthToken(api.Params{
+		"token": "silent",
+		"uuid":  "uuid",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.AccessToken != "token" || resp.UserID != 1 {
+		t.Errorf("AuthExchangeSilentAuthToken() = %+v", resp)
+	}
+}
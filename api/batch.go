@@ -0,0 +1,357 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExecuteMaxCalls is the maximum number of API calls in one execute request.
+const ExecuteMaxCalls = 25
+
+// ErrBatchResponse is returned when the response of the batch does not
+// match its calls.
+var ErrBatchResponse = errors.New("api: invalid batch response")
+
+// Batcher combines API calls made within Delay into execute requests.
+//
+//	vk.Handler = vk.NewBatcher(10 * time.Millisecond).Handle
+//
+// Calls are combined if they have the same token and version. Each call
+// waits up to Delay, so batching pays off for bots that make many concurrent
+// requests. The execute method and calls that are alone in the batch are
+// sent as is.
+//
+// The execute request is canceled when the contexts of all its calls are
+// done.
+type Batcher struct {
+	// Handler sends requests.
+	Handler func(method string, params ...Params) (Response, error)
+
+	// JSON decodes the results of execute. If nil, encoding/json is used.
+	JSON JSONCodec
+
+	// Delay is the time of collecting calls into the batch.
+	Delay time.Duration
+
+	// MaxCalls is the maximum number of calls in the batch. If zero,
+	// ExecuteMaxCalls is used.
+	MaxCalls int
+
+	mux     sync.Mutex
+	batches map[batchKey]*batch
+}
+
+type batchKey struct {
+	token   string
+	version string
+}
+
+type batch struct {
+	calls []*batchCall
+}
+
+type batchCall struct {
+	ctx    context.Context
+	method string
+	params map[string]string
+	done   chan batchResult
+}
+
+type batchResult struct {
+	resp Response
+	err  error
+}
+
+// NewBatcher returns a new Batcher.
+func NewBatcher(handler func(method string, params ...Params) (Response, error), delay time.Duration) *Batcher {
+	return &Batcher{
+		Handler: handler,
+		Delay:   delay,
+	}
+}
+
+// NewBatcher returns a new Batcher that sends requests with vk.Handler and
+// decodes the results with vk.JSON.
+func (vk *VK) NewBatcher(delay time.Duration) *Batcher {
+	b := NewBatcher(vk.Handler, delay)
+	b.JSON = vk.JSON
+
+	return b
+}
+
+// Handle adds the call to the batch and waits for its result.
+//
+// If the ctx of the call is done before the batch is sent, the call is
+// removed from the batch.
+func (b *Batcher) Handle(method string, sliceParams ...Params) (Response, error) {
+	if !batchable(method) {
+		return b.Handler(method, sliceParams...)
+	}
+
+	ctx, query := buildQuery(sliceParams...)
+	key := batchKey{
+		token:   query.Get("access_token"),
+		version: query.Get("v"),
+	}
+
+	call := &batchCall{
+		ctx:    ctx,
+		method: method,
+		params: make(map[string]string, len(query)),
+		done:   make(chan batchResult, 1),
+	}
+
+	for k := range query {
+		if k != "access_token" && k != "v" {
+			call.params[k] = query.Get(k)
+		}
+	}
+
+	b.enqueue(key, call)
+
+	select {
+	case r := <-call.done:
+		return r.resp, r.err
+	case <-ctx.Done():
+		return Response{}, ctx.Err()
+	}
+}
+
+func (b *Batcher) enqueue(key batchKey, call *batchCall) {
+	maxCalls := b.MaxCalls
+	if maxCalls <= 0 || maxCalls > ExecuteMaxCalls {
+		maxCalls = ExecuteMaxCalls
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.batches == nil {
+		b.batches = make(map[batchKey]*batch)
+	}
+
+	bt, ok := b.batches[key]
+	if !ok {
+		bt = &batch{}
+		b.batches[key] = bt
+
+		time.AfterFunc(b.Delay, func() {
+			b.mux.Lock()
+
+			// the batch may be already sent after reaching MaxCalls
+			if b.batches[key] != bt {
+				b.mux.Unlock()
+				return
+			}
+
+			delete(b.batches, key)
+			b.mux.Unlock()
+
+			b.send(key, bt.calls)
+		})
+	}
+
+	bt.calls = append(bt.calls, call)
+
+	if len(bt.calls) >= maxCalls {
+		delete(b.batches, key)
+
+		go b.send(key, bt.calls)
+	}
+}
+
+// send sends the calls and passes the results to them.
+func (b *Batcher) send(key batchKey, calls []*batchCall) {
+	active := calls[:0:0]
+
+	for _, call := range calls {
+		if call.ctx.Err() == nil {
+			active = append(active, call)
+		}
+	}
+
+	switch len(active) {
+	case 0:
+		return
+	case 1:
+		call := active[0]
+		params := make(Params, len(call.params)+3)
+
+		for k, v := range call.params {
+			params[k] = v
+		}
+
+		params["access_token"] = key.token
+		params["v"] = key.version
+		params.WithContext(call.ctx)
+
+		resp, err := b.Handler(call.method, params)
+		call.done <- batchResult{resp, err}
+
+		return
+	}
+
+	code, err := batchCode(active)
+	if err != nil {
+		fail(active, Response{}, err)
+		return
+	}
+
+	ctx, cancel := batchContext(active)
+	defer cancel()
+
+	params := Params{
+		"code":         code,
+		"access_token": key.token,
+		"v":            key.version,
+	}
+	params.WithContext(ctx)
+
+	resp, err := b.Handler("execute", params)
+	if err != nil {
+		fail(active, resp, err)
+		return
+	}
+
+	var results []json.RawMessage
+
+	if err := b.unmarshal(resp.Response, &results); err != nil {
+		fail(active, Response{}, err)
+		return
+	}
+
+	if len(results) != len(active) {
+		fail(active, Response{}, ErrBatchResponse)
+		return
+	}
+
+	// execute returns false for failed calls and lists their errors in
+	// the same order
+	executeErrors := resp.ExecuteErrors
+
+	for i, call := range active {
+		if string(results[i]) == "false" && len(executeErrors) > 0 && executeErrors[0].Method == call.method {
+			e := executeErrors[0]
+			executeErrors = executeErrors[1:]
+
+			r := Response{Error: Error{
+				Code:    ErrorType(e.Code),
+				Message: e.Msg,
+			}}
+			call.done <- batchResult{r, &r.Error}
+
+			continue
+		}
+
+		call.done <- batchResult{Response{Response: results[i]}, nil}
+	}
+}
+
+// unmarshal decodes the JSON with b.JSON or, if it is nil, with
+// encoding/json.
+func (b *Batcher) unmarshal(data []byte, v interface{}) error {
+	if b.JSON != nil {
+		return b.JSON.Unmarshal(data, v)
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// batchContext returns the context of the execute request. It has the
+// values of the context of the first call and is canceled when the contexts
+// of all calls are done.
+func batchContext(calls []*batchCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(detachedContext{calls[0].ctx})
+
+	go func() {
+		for _, call := range calls {
+			select {
+			case <-call.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		cancel()
+	}()
+
+	return ctx, cancel
+}
+
+// detachedContext has the values of the parent context, but is never
+// canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+func fail(calls []*batchCall, resp Response, err error) {
+	for _, call := range calls {
+		call.done <- batchResult{resp, err}
+	}
+}
+
+// batchCode returns the VKScript code of the calls.
+func batchCode(calls []*batchCall) (string, error) {
+	var code strings.Builder
+
+	code.WriteString("return [")
+
+	for i, call := range calls {
+		var buf bytes.Buffer
+
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+
+		if err := enc.Encode(call.params); err != nil {
+			return "", err
+		}
+
+		if i > 0 {
+			code.WriteByte(',')
+		}
+
+		code.WriteString("API." + call.method + "(")
+		code.Write(bytes.TrimSpace(buf.Bytes()))
+		code.WriteByte(')')
+	}
+
+	code.WriteString("];")
+
+	return code.String(), nil
+}
+
+// batchable returns true if the method can be called from execute.
+func batchable(method string) bool {
+	if method == "" || method == "execute" {
+		return false
+	}
+
+	for _, r := range method {
+		if !(r == '.' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,204 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mux     sync.Mutex
+		methods []string
+		code    string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		mux.Lock()
+		methods = append(methods, r.URL.Path)
+		code = r.PostForm.Get("code")
+		mux.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/execute":
+			_, _ = w.Write([]byte(`{"response":[1,false,1],"execute_errors":[` +
+				`{"method":"users.get","error_code":113,"error_msg":"Invalid user id"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":2}`))
+		}
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("token")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.Handler = api.NewBatcher(vk.Handler, 50*time.Millisecond).Handle
+
+	var wg sync.WaitGroup
+
+	results := make([]error, 3)
+
+	for i, method := range []string{"status.set", "users.get", "status.set"} {
+		wg.Add(1)
+
+		go func(i int, method string) {
+			defer wg.Done()
+
+			var res int
+
+			results[i] = vk.RequestUnmarshal(method, &res, api.Params{"text": "<a>"})
+			if results[i] == nil {
+				assert.Equal(t, 1, res)
+			}
+		}(i, method)
+
+		// keeps the order of calls in the batch
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	wg.Wait()
+
+	assert.NoError(t, results[0])
+	assert.True(t, errors.Is(results[1], api.ErrParamUserID), results[1])
+	assert.NoError(t, results[2])
+	assert.Equal(t, []string{"/execute"}, methods)
+	assert.Equal(t, `return [API.status.set({"text":"<a>"}),API.users.get({"text":"<a>"}),`+
+		`API.status.set({"text":"<a>"})];`, code)
+
+	// a single call is sent as is
+	var res int
+
+	assert.NoError(t, vk.RequestUnmarshal("users.get", &res, nil))
+	assert.Equal(t, 2, res)
+	assert.Equal(t, "/users.get", methods[1])
+
+	// a canceled call is removed from the batch
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := vk.Request("users.get", api.Params{}.WithContext(ctx))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBatcher_MaxCalls(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":[1,1]}`)
+
+	b := api.NewBatcher(vk.Handler, time.Hour)
+	b.MaxCalls = 2
+	vk.Handler = b.Handle
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vk.Request("users.get", nil)
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestVK_NewBatcher(t *testing.T) {
+	t.Parallel()
+
+	codec := &countingCodec{}
+
+	vk := api.NewVK("token")
+	vk.JSON = codec
+	vk.Handler = func(method string, params ...api.Params) (api.Response, error) {
+		return api.Response{Response: []byte(`[1,1]`)}, nil
+	}
+
+	b := vk.NewBatcher(time.Hour)
+	b.MaxCalls = 2
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := b.Handle("users.get", api.Params{"access_token": "token", "v": api.Version})
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&codec.unmarshal))
+}
+
+func TestBatcher_context(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+
+	executed := make(chan error, 1)
+
+	b := api.NewBatcher(func(method string, params ...api.Params) (api.Response, error) {
+		ctx := params[0][":context"].(context.Context)
+		assert.Equal(t, "value", ctx.Value(ctxKey{}))
+
+		<-ctx.Done()
+		executed <- ctx.Err()
+
+		return api.Response{}, ctx.Err()
+	}, time.Hour)
+	b.MaxCalls = 2
+
+	ctx1, cancel1 := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+	ctx2, cancel2 := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	for _, ctx := range []context.Context{ctx1, ctx2} {
+		wg.Add(1)
+
+		go func(ctx context.Context) {
+			defer wg.Done()
+
+			_, err := b.Handle("users.get", api.Params{}.WithContext(ctx))
+			assert.ErrorIs(t, err, context.Canceled)
+		}(ctx)
+
+		// keeps the order of calls in the batch
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// the batch is sent while one of the calls is waiting
+	cancel1()
+
+	select {
+	case <-executed:
+		t.Fatal("execute is canceled by one call")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancel2()
+	assert.ErrorIs(t, <-executed, context.Canceled)
+
+	wg.Wait()
+}
@@ -0,0 +1,146 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Default settings of NewCircuitBreaker.
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerTimeout   = 30 * time.Second
+)
+
+// CircuitOpenError is returned without calling the API when the circuit
+// breaker is open.
+type CircuitOpenError struct {
+	// Until is the time when the next request is allowed to check whether
+	// the API is available again.
+	Until time.Time
+}
+
+// Error returns the message of a CircuitOpenError.
+func (e CircuitOpenError) Error() string {
+	return "api: circuit breaker is open"
+}
+
+// Is reports whether target is CircuitOpenError.
+func (e CircuitOpenError) Is(target error) bool {
+	switch target.(type) {
+	case *CircuitOpenError, CircuitOpenError:
+		return true
+	}
+
+	return false
+}
+
+// CircuitBreaker stops requests to the API after Threshold failures in a
+// row: transport errors, invalid responses, e.g. HTML pages of 5xx errors,
+// and the errors 1 and 10 that are left after retries. Errors of the
+// context and other errors of the API are not failures.
+//
+// While the breaker is open, requests fail fast with CircuitOpenError.
+// After Timeout one request is allowed, and the breaker is closed if it
+// succeeds or opened again if it fails:
+//
+//	vk.Use(api.NewCircuitBreaker(5, 30*time.Second).Middleware)
+type CircuitBreaker struct {
+	Threshold int
+	Timeout   time.Duration
+
+	mux      sync.Mutex
+	failures int
+	until    time.Time
+	probe    bool
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker. If threshold or timeout
+// is not positive, the default value is used.
+func NewCircuitBreaker(threshold int, timeout time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultBreakerTimeout
+	}
+
+	return &CircuitBreaker{
+		Threshold: threshold,
+		Timeout:   timeout,
+	}
+}
+
+// Open reports whether the breaker is open.
+func (b *CircuitBreaker) Open() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	return b.failures >= b.Threshold
+}
+
+// Middleware implements Middleware.
+func (b *CircuitBreaker) Middleware(req Request, next Doer) (Response, error) {
+	if err := b.allow(); err != nil {
+		return Response{}, err
+	}
+
+	resp, err := next.Do(req)
+	b.done(err)
+
+	return resp, err
+}
+
+// allow returns CircuitOpenError if the request is not allowed.
+func (b *CircuitBreaker) allow() error {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.failures < b.Threshold {
+		return nil
+	}
+
+	if b.probe || time.Now().Before(b.until) {
+		return &CircuitOpenError{Until: b.until}
+	}
+
+	b.probe = true
+
+	return nil
+}
+
+// done records the result of the request.
+func (b *CircuitBreaker) done(err error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.probe = false
+
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		// the availability of the API is unknown
+	case !failure(err):
+		b.failures = 0
+	default:
+		b.failures++
+		if b.failures >= b.Threshold {
+			b.until = time.Now().Add(b.Timeout)
+		}
+	}
+}
+
+// failure reports whether the error means that the API is unavailable.
+func failure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var code ErrorType
+	if errors.As(err, &code) {
+		return code == ErrUnknown || code == ErrServer
+	}
+
+	return true
+}
@@ -0,0 +1,84 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	down := true
+	errTransport := errors.New("connection refused")
+
+	fake := apitest.New()
+	fake.OnFunc("users.get", func(req api.Request) (api.Response, error) {
+		if down {
+			return api.Response{}, errTransport
+		}
+
+		return api.Response{Response: []byte(`[]`)}, nil
+	})
+	fake.OnError("messages.send", api.ErrPermission, "Permission denied")
+
+	breaker := api.NewCircuitBreaker(2, 50*time.Millisecond)
+
+	vk := fake.VK()
+	vk.Use(breaker.Middleware)
+
+	_, err := vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, errTransport)
+
+	// errors of the API are not failures
+	_, err = vk.Request("messages.send", nil)
+	assert.ErrorIs(t, err, api.ErrPermission)
+	assert.False(t, breaker.Open())
+
+	_, err = vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, errTransport)
+
+	_, err = vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, errTransport)
+	assert.True(t, breaker.Open())
+
+	_, err = vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, &api.CircuitOpenError{})
+
+	var openErr *api.CircuitOpenError
+	if assert.ErrorAs(t, err, &openErr) {
+		assert.False(t, openErr.Until.IsZero())
+	}
+
+	assert.Len(t, fake.Calls("users.get"), 3)
+
+	// the failed probe opens the breaker again
+	time.Sleep(60 * time.Millisecond)
+
+	_, err = vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, errTransport)
+
+	_, err = vk.Request("users.get", nil)
+	assert.ErrorIs(t, err, &api.CircuitOpenError{})
+
+	// the successful probe closes the breaker
+	time.Sleep(60 * time.Millisecond)
+
+	down = false
+
+	_, err = vk.Request("users.get", nil)
+	assert.NoError(t, err)
+	assert.False(t, breaker.Open())
+}
+
+func TestNewCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	b := api.NewCircuitBreaker(0, 0)
+	assert.Equal(t, api.DefaultBreakerThreshold, b.Threshold)
+	assert.Equal(t, api.DefaultBreakerTimeout, b.Timeout)
+}
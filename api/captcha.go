@@ -1,7 +1,80 @@
 package api
 
+import "context"
+
 // CaptchaForce api method.
 func (vk *VK) CaptchaForce(params Params) (response int, err error) {
 	err = vk.RequestUnmarshal("captcha.force", &response, params)
 	return
 }
+
+// Captcha is the captcha of the "Captcha needed" error.
+type Captcha struct {
+	// Method is the method of the request that needs the captcha.
+	Method string
+
+	SID string
+	Img string
+}
+
+// CaptchaSolver solves captchas, for example, with rucaptcha or
+// anti-captcha services.
+//
+//	vk.CaptchaSolver = rucaptcha.New(apiKey)
+//
+// If the solver also implements CaptchaReporter, wrong answers are
+// reported to it.
+type CaptchaSolver interface {
+	// Solve returns the text from the image of the captcha.
+	Solve(ctx context.Context, captcha Captcha) (key string, err error)
+}
+
+// CaptchaReporter is implemented by solvers that accept reports of wrong
+// answers, for example, to get a refund.
+type CaptchaReporter interface {
+	// ReportIncorrect reports that the key of the captcha is wrong.
+	ReportIncorrect(ctx context.Context, captcha Captcha, key string) error
+}
+
+// The CaptchaSolverFunc type is an adapter to allow the use of ordinary
+// functions as CaptchaSolver.
+type CaptchaSolverFunc func(ctx context.Context, captcha Captcha) (key string, err error)
+
+// Solve calls f(ctx, captcha).
+func (f CaptchaSolverFunc) Solve(ctx context.Context, captcha Captcha) (string, error) {
+	return f(ctx, captcha)
+}
+
+// CaptchaHandler sets the handler of the "Captcha needed" error. The handler
+// returns the text from the img and the request is repeated with it.
+//
+//	vk.CaptchaHandler(func(sid, img string) (string, error) {
+//		return askUser(img)
+//	})
+//
+// The handler is called again if the text is wrong. The error of the handler
+// is returned by the request. It is a shortcut for vk.CaptchaSolver.
+func (vk *VK) CaptchaHandler(f func(sid, img string) (key string, err error)) {
+	if f == nil {
+		vk.CaptchaSolver = nil
+		return
+	}
+
+	vk.CaptchaSolver = CaptchaSolverFunc(func(_ context.Context, c Captcha) (string, error) {
+		return f(c.SID, c.Img)
+	})
+}
+
+// solveCaptcha returns the key of the captcha. If the request has already
+// been sent with the key of the previous captcha, the key is reported as
+// wrong.
+func (vk *VK) solveCaptcha(ctx context.Context, captcha Captcha, prev *Captcha, prevKey string) (string, error) {
+	if prev != nil {
+		if r, ok := vk.CaptchaSolver.(CaptchaReporter); ok {
+			// the report does not affect the request
+			_ = r.ReportIncorrect(ctx, *prev, prevKey)
+		}
+	}
+
+	return vk.CaptchaSolver.Solve(ctx, captcha)
+}
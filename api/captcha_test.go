@@ -1,10 +1,16 @@
 package api_test
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestVK_CaptchaForce(t *testing.T) {
@@ -18,3 +24,119 @@ func TestVK_CaptchaForce(t *testing.T) {
 		t.Errorf("VK.CaptchaForce() err=%v, want 14", err)
 	}
 }
+
+func TestVK_CaptchaHandler(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.PostFormValue("captcha_sid") == "123" && r.PostFormValue("captcha_key") == "abc" {
+			_, _ = w.Write([]byte(`{"response":1}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"error":{"error_code":14,"error_msg":"Captcha needed",` +
+			`"captcha_sid":"123","captcha_img":"https://api.vk.com/captcha.php?sid=123"}}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	f := func(keys []string, wantCalls int, wantErr error) {
+		t.Helper()
+
+		calls := 0
+
+		vk.CaptchaHandler(func(sid, img string) (string, error) {
+			assert.Equal(t, "123", sid)
+			assert.Equal(t, "https://api.vk.com/captcha.php?sid=123", img)
+
+			if calls == len(keys) {
+				return "", errCaptchaTest
+			}
+
+			calls++
+
+			return keys[calls-1], nil
+		})
+
+		_, err := vk.Request("test", nil)
+		if wantErr == nil {
+			assert.NoError(t, err)
+		} else {
+			assert.True(t, errors.Is(err, wantErr), err)
+		}
+
+		assert.Equal(t, wantCalls, calls)
+	}
+
+	f([]string{"abc"}, 1, nil)
+	f([]string{"wrong", "abc"}, 2, nil)
+	f(nil, 0, errCaptchaTest)
+
+	vk.CaptchaHandler(nil)
+
+	_, err := vk.Request("test", nil)
+	assert.True(t, errors.Is(err, api.ErrCaptcha), err)
+}
+
+var errCaptchaTest = errors.New("captcha test")
+
+type testCaptchaSolver struct {
+	keys      []string
+	captchas  []api.Captcha
+	incorrect []string
+}
+
+func (s *testCaptchaSolver) Solve(ctx context.Context, c api.Captcha) (string, error) {
+	s.captchas = append(s.captchas, c)
+	key := s.keys[0]
+	s.keys = s.keys[1:]
+
+	return key, nil
+}
+
+func (s *testCaptchaSolver) ReportIncorrect(ctx context.Context, c api.Captcha, key string) error {
+	s.incorrect = append(s.incorrect, key)
+	return nil
+}
+
+func TestVK_CaptchaSolver(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, "")
+	vk.Client = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		_ = r.ParseForm()
+
+		body := `{"error":{"error_code":14,"captcha_sid":"123","captcha_img":"img"}}`
+		if r.PostFormValue("captcha_key") == "abc" {
+			body = `{"response":1}`
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})}
+
+	solver := &testCaptchaSolver{keys: []string{"wrong", "abc"}}
+	vk.CaptchaSolver = solver
+
+	_, err := vk.Request("users.get", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []api.Captcha{
+		{Method: "users.get", SID: "123", Img: "img"},
+		{Method: "users.get", SID: "123", Img: "img"},
+	}, solver.captchas)
+	assert.Equal(t, []string{"wrong"}, solver.incorrect)
+}
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,44 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import "strings"
+
+// Maximum numbers of IDs in one request. Methods split larger lists of IDs
+// into several requests and merge their results.
+const (
+	UsersGetMaxIDs      = 1000
+	GroupsGetByIDMaxIDs = 500
+)
+
+// splitIDs returns copies of params, each of which has no more than limit
+// IDs in the key. If the IDs do not exceed the limit, params are returned
+// as is.
+func splitIDs(params Params, key string, limit int) []Params {
+	value, ok := params[key]
+	if !ok {
+		return []Params{params}
+	}
+
+	ids := strings.Split(FmtValue(value, 0), ",")
+	if len(ids) <= limit {
+		return []Params{params}
+	}
+
+	chunks := make([]Params, 0, (len(ids)+limit-1)/limit)
+
+	for start := 0; start < len(ids); start += limit {
+		end := start + limit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		chunk := make(Params, len(params))
+		for k, v := range params {
+			chunk[k] = v
+		}
+
+		chunk[key] = strings.Join(ids[start:end], ",")
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
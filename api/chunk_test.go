@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+// idsHandler returns objects with the IDs of the request.
+func idsHandler(key string) apitest.HandlerFunc {
+	return func(req api.Request) (api.Response, error) {
+		var items []map[string]int
+
+		for _, s := range strings.Split(api.FmtValue(req.Params[key], 0), ",") {
+			id, _ := strconv.Atoi(s)
+			items = append(items, map[string]int{"id": id})
+		}
+
+		b, err := json.Marshal(items)
+
+		return api.Response{Response: b}, err
+	}
+}
+
+func TestVK_UsersGet_chunks(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.OnFunc("users.get", idsHandler("user_ids"))
+
+	vk := fake.VK()
+
+	ids := make([]int, 2500)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	users, err := vk.UsersGet(api.Params{"user_ids": ids, "fields": "city"})
+	assert.NoError(t, err)
+	assert.Len(t, users, 2500)
+	assert.Equal(t, 1, users[0].ID)
+	assert.Equal(t, 2500, users[2499].ID)
+
+	calls := fake.Calls("users.get")
+	if assert.Len(t, calls, 3) {
+		assert.Len(t, strings.Split(api.FmtValue(calls[0].Params["user_ids"], 0), ","), api.UsersGetMaxIDs)
+		assert.Equal(t, "city", calls[2].Params["fields"])
+	}
+
+	fake.Reset()
+	fake.OnFunc("users.get", idsHandler("user_ids"))
+
+	users, err = vk.UsersGet(api.Params{"user_ids": "1,2"})
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+	assert.Len(t, fake.Calls("users.get"), 1)
+}
+
+func TestVK_GroupsGetByID_chunks(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.OnFunc("groups.getById", idsHandler("group_ids"))
+
+	vk := fake.VK()
+
+	ids := make([]string, 501)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	groups, err := vk.GroupsGetByID(api.Params{"group_ids": ids})
+	assert.NoError(t, err)
+	assert.Len(t, groups, 501)
+	assert.Equal(t, 501, groups[500].ID)
+	assert.Len(t, fake.Calls("groups.getById"), 2)
+
+	fake.OnError("groups.getById", api.ErrAccess, "Access denied")
+
+	_, err = vk.GroupsGetByID(api.Params{"group_ids": ids})
+	assert.ErrorIs(t, err, api.ErrAccess)
+}
@@ -0,0 +1,142 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// Default settings of NewClient.
+const (
+	DefaultMaxIdleConns        = 100
+	DefaultMaxIdleConnsPerHost = 20
+	DefaultIdleConnTimeout     = 90 * time.Second
+	DefaultKeepAlive           = 30 * time.Second
+	DefaultDialTimeout         = 30 * time.Second
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// ClientOption configures the client of NewClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	keepAlive           time.Duration
+	dialTimeout         time.Duration
+	tlsHandshakeTimeout time.Duration
+	timeout             time.Duration
+	http2               bool
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections to all hosts.
+func WithMaxIdleConns(n int) ClientOption {
+	return func(o *clientOptions) {
+		o.maxIdleConns = n
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections to
+// one host. The http.DefaultTransport keeps only 2 of them, which is not
+// enough for the parallel requests to api.vk.com.
+func WithMaxIdleConnsPerHost(n int) ClientOption {
+	return func(o *clientOptions) {
+		o.maxIdleConnsPerHost = n
+	}
+}
+
+// WithIdleConnTimeout sets the time after which an idle connection is
+// closed.
+func WithIdleConnTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.idleConnTimeout = d
+	}
+}
+
+// WithKeepAlive sets the interval of TCP keep-alive probes. A negative
+// value disables them.
+func WithKeepAlive(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.keepAlive = d
+	}
+}
+
+// WithDialTimeout sets the timeout of a connection.
+func WithDialTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.dialTimeout = d
+	}
+}
+
+// WithTLSHandshakeTimeout sets the timeout of the TLS handshake.
+func WithTLSHandshakeTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.tlsHandshakeTimeout = d
+	}
+}
+
+// WithClientTimeout sets the timeout of a request, including the reading of
+// the response. Zero means no timeout, requests are limited by the context.
+func WithClientTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// WithHTTP2 enables or disables HTTP/2. It is enabled by default.
+func WithHTTP2(enabled bool) ClientOption {
+	return func(o *clientOptions) {
+		o.http2 = enabled
+	}
+}
+
+// NewClient returns a new HTTP client with the settings suitable for
+// requests to VK API, which can be changed by options:
+//
+//	vk.Client = api.NewClient(
+//		api.WithMaxIdleConnsPerHost(50),
+//		api.WithClientTimeout(time.Minute),
+//	)
+//
+// The client uses the proxy from the environment like http.DefaultClient.
+func NewClient(opts ...ClientOption) *http.Client {
+	o := clientOptions{
+		maxIdleConns:        DefaultMaxIdleConns,
+		maxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		idleConnTimeout:     DefaultIdleConnTimeout,
+		keepAlive:           DefaultKeepAlive,
+		dialTimeout:         DefaultDialTimeout,
+		tlsHandshakeTimeout: DefaultTLSHandshakeTimeout,
+		http2:               true,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   o.dialTimeout,
+		KeepAlive: o.keepAlive,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     o.http2,
+		MaxIdleConns:          o.maxIdleConns,
+		MaxIdleConnsPerHost:   o.maxIdleConnsPerHost,
+		IdleConnTimeout:       o.idleConnTimeout,
+		TLSHandshakeTimeout:   o.tlsHandshakeTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   o.timeout,
+	}
+}
+
+// defaultClient is the client of NewVK, it is shared so that VKs reuse
+// connections.
+var defaultClient = NewClient() // nolint:gochecknoglobals
@@ -0,0 +1,65 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	f := func(client *http.Client, want *http.Transport, timeout time.Duration) {
+		t.Helper()
+
+		transport, ok := client.Transport.(*http.Transport)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, want.MaxIdleConns, transport.MaxIdleConns)
+		assert.Equal(t, want.MaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, want.IdleConnTimeout, transport.IdleConnTimeout)
+		assert.Equal(t, want.TLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+		assert.Equal(t, want.ForceAttemptHTTP2, transport.ForceAttemptHTTP2)
+		assert.NotNil(t, transport.DialContext)
+		assert.NotNil(t, transport.Proxy)
+		assert.Equal(t, timeout, client.Timeout)
+	}
+
+	f(api.NewClient(), &http.Transport{
+		MaxIdleConns:        api.DefaultMaxIdleConns,
+		MaxIdleConnsPerHost: api.DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     api.DefaultIdleConnTimeout,
+		TLSHandshakeTimeout: api.DefaultTLSHandshakeTimeout,
+		ForceAttemptHTTP2:   true,
+	}, 0)
+	f(api.NewClient(
+		api.WithMaxIdleConns(10),
+		api.WithMaxIdleConnsPerHost(5),
+		api.WithIdleConnTimeout(time.Second),
+		api.WithKeepAlive(-1),
+		api.WithDialTimeout(time.Second),
+		api.WithTLSHandshakeTimeout(time.Second),
+		api.WithClientTimeout(time.Minute),
+		api.WithHTTP2(false),
+	), &http.Transport{
+		MaxIdleConns:        10,
+		MaxIdleConnsPerHost: 5,
+		IdleConnTimeout:     time.Second,
+		TLSHandshakeTimeout: time.Second,
+	}, time.Minute)
+}
+
+func TestNewVK_client(t *testing.T) {
+	t.Parallel()
+
+	vk1 := api.NewVK("")
+	vk2 := api.NewVK("")
+
+	assert.NotSame(t, http.DefaultClient, vk1.Client)
+	assert.Same(t, vk1.Client, vk2.Client)
+}
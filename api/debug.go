@@ -0,0 +1,79 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+// Logger is used to log requests at debug level. It is implemented by
+// *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redactedParams are the params with secrets that are not logged.
+var redactedParams = []string{"access_token", "client_secret", "password"} // nolint:gochecknoglobals
+
+// SetDebug enables or disables logging of requests to the Logger. It is safe
+// to call concurrently with requests.
+//
+//	vk.Logger = log.New(os.Stderr, "", log.LstdFlags)
+//	vk.SetDebug(true)
+//
+// The access token and other secrets are redacted.
+func (vk *VK) SetDebug(debug bool) {
+	var v int32
+	if debug {
+		v = 1
+	}
+
+	atomic.StoreInt32(&vk.debug, v)
+}
+
+// Debug returns true if logging of requests is enabled.
+func (vk *VK) Debug() bool {
+	return atomic.LoadInt32(&vk.debug) == 1
+}
+
+// logRequest logs the request if debug is enabled.
+func (vk *VK) logRequest(method string, query url.Values, d time.Duration, err error) {
+	if vk.Logger == nil || !vk.Debug() {
+		return
+	}
+
+	params := redact(query).Encode()
+
+	if err != nil {
+		vk.Logger.Printf("api: %s %s %v: %v", method, params, d, err)
+		return
+	}
+
+	vk.Logger.Printf("api: %s %s %v", method, params, d)
+}
+
+// redact returns a copy of the query without secrets.
+func redact(query url.Values) url.Values {
+	values := make(url.Values, len(query))
+
+	for key, v := range query {
+		values[key] = v
+	}
+
+	for _, key := range redactedParams {
+		if _, ok := values[key]; ok {
+			values.Set(key, "***")
+		}
+	}
+
+	return values
+}
+
+// sanitize returns a copy of the query for errors: without the access token
+// and with other secrets redacted.
+func sanitize(query url.Values) url.Values {
+	values := redact(query)
+	values.Del("access_token")
+
+	return values
+}
@@ -0,0 +1,48 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_SetDebug(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":1}`)
+	vk.SetDebug(true)
+
+	// no logger
+	_, err := vk.Request("users.get", api.Params{"user_ids": 1})
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	vk.Logger = log.New(&buf, "", 0)
+
+	_, err = vk.Request("users.get", api.Params{"user_ids": 1, "client_secret": "s3cr3t"})
+	assert.NoError(t, err)
+	assert.True(t, vk.Debug())
+	assert.Regexp(t, `^api: users.get access_token=%2A%2A%2A&client_secret=%2A%2A%2A&user_ids=1&v=5\.\d+ \S+\n$`, buf.String())
+	assert.NotContains(t, buf.String(), "s3cr3t")
+
+	buf.Reset()
+	vk.SetDebug(false)
+
+	_, err = vk.Request("users.get", nil)
+	assert.NoError(t, err)
+	assert.False(t, vk.Debug())
+	assert.Empty(t, buf.String())
+
+	vk = newTestVK(t, `{"error":{"error_code":5,"error_msg":"User authorization failed"}}`)
+	vk.Logger = log.New(&buf, "", 0)
+	vk.SetDebug(true)
+
+	_, err = vk.Request("users.get", nil)
+	assert.True(t, errors.Is(err, api.ErrAuth))
+	assert.Regexp(t, `^api: users.get access_token=%2A%2A%2A&v=5\.\d+ \S+: api: User authorization failed\n$`, buf.String())
+}
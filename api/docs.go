@@ -114,6 +114,27 @@ type DocsSaveResponse struct {
 	Graffiti     object.MessagesGraffiti     `json:"graffiti"`
 }
 
+// Types of documents of docs.getMessagesUploadServer and DocsSaveResponse.
+const (
+	DocTypeDoc          = "doc"
+	DocTypeAudioMessage = "audio_message"
+	DocTypeGraffiti     = "graffiti"
+)
+
+// ToAttachment returns the attachment of the saved document for
+// messages.send and wall.post, with the access key if it is set. Voice
+// messages and graffiti are attached as documents too.
+func (resp DocsSaveResponse) ToAttachment() string {
+	switch resp.Type {
+	case DocTypeAudioMessage:
+		return attachment("doc", resp.AudioMessage.OwnerID, resp.AudioMessage.ID, resp.AudioMessage.AccessKey)
+	case DocTypeGraffiti:
+		return attachment("doc", resp.Graffiti.OwnerID, resp.Graffiti.ID, resp.Graffiti.AccessKey)
+	default:
+		return attachment("doc", resp.Doc.OwnerID, resp.Doc.ID, resp.Doc.AccessKey)
+	}
+}
+
 // DocsSave saves a document after uploading it to a server.
 //
 // https://vk.com/dev/docs.save
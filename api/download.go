@@ -0,0 +1,122 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+	"io"
+	"net/http"
+)
+
+// ErrDownloadTooLarge is returned when the file is larger than
+// DownloadOptions.MaxSize.
+var ErrDownloadTooLarge = errors.New("api: download is too large")
+
+// ErrChecksum is returned when the checksum of the downloaded file does not
+// match DownloadOptions.Checksum.
+var ErrChecksum = errors.New("api: checksum mismatch")
+
+// DownloadOptions configures Download.
+type DownloadOptions struct {
+	// MaxSize is the maximum size of the file in bytes. Zero means no
+	// limit. If the size is unknown in advance, a part of the file may be
+	// written before ErrDownloadTooLarge.
+	MaxSize int64
+
+	// Hash, if set, is written with the content of the file, for example,
+	// sha256.New().
+	Hash hash.Hash
+
+	// Checksum, if set, is compared with the sum of Hash.
+	Checksum []byte
+
+	// Progress, if set, is called after each written chunk with the number
+	// of written bytes and the size of the file, which is -1 if unknown.
+	Progress func(written, total int64)
+}
+
+// Download downloads the file, for example, a document or a photo of
+// an attachment, with the client and the context of vk and writes it to w:
+//
+//	f, err := os.Create("doc.pdf")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//
+//	_, err = vk.Download(doc.URL, f, api.DownloadOptions{
+//		MaxSize: 50 << 20,
+//		Progress: func(written, total int64) {
+//			log.Printf("%d/%d", written, total)
+//		},
+//	})
+//
+// It returns the number of written bytes. If the server responds with
+// a status other than 200, *StatusError is returned.
+func (vk *VK) Download(url string, w io.Writer, opts DownloadOptions) (int64, error) {
+	req, err := http.NewRequestWithContext(vk.context(), http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("User-Agent", vk.UserAgent)
+
+	resp, err := vk.Client.Do(req)
+	if err != nil {
+		return 0, contextError(vk.context(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	if opts.MaxSize > 0 && resp.ContentLength > opts.MaxSize {
+		return 0, ErrDownloadTooLarge
+	}
+
+	var body io.Reader = resp.Body
+	if opts.MaxSize > 0 {
+		// the extra byte shows that the file is too large
+		body = io.LimitReader(body, opts.MaxSize+1)
+	}
+
+	if opts.Hash != nil {
+		w = io.MultiWriter(w, opts.Hash)
+	}
+
+	if opts.Progress != nil {
+		w = &progressWriter{w: w, total: resp.ContentLength, f: opts.Progress}
+	}
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return n, contextError(vk.context(), err)
+	}
+
+	if opts.MaxSize > 0 && n > opts.MaxSize {
+		return n, ErrDownloadTooLarge
+	}
+
+	if opts.Hash != nil && opts.Checksum != nil && !bytes.Equal(opts.Hash.Sum(nil), opts.Checksum) {
+		return n, ErrChecksum
+	}
+
+	return n, nil
+}
+
+// progressWriter calls f after each write.
+type progressWriter struct {
+	w       io.Writer
+	written int64
+	total   int64
+	f       func(written, total int64)
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.written += int64(n)
+	pw.f(pw.written, pw.total)
+
+	return n, err
+}
@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_Download(t *testing.T) {
+	t.Parallel()
+
+	content := bytes.Repeat([]byte("vksdk"), 1000)
+	sum := sha256.Sum256(content)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/doc":
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			_, _ = w.Write(content)
+		case "/chunked":
+			w.(http.Flusher).Flush()
+			_, _ = w.Write(content)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.Client = server.Client()
+
+	var (
+		buf      bytes.Buffer
+		progress []int64
+	)
+
+	n, err := vk.Download(server.URL+"/doc", &buf, api.DownloadOptions{
+		Hash:     sha256.New(),
+		Checksum: sum[:],
+		Progress: func(written, total int64) {
+			assert.Equal(t, int64(len(content)), total)
+
+			progress = append(progress, written)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, content, buf.Bytes())
+	assert.Equal(t, int64(len(content)), progress[len(progress)-1])
+
+	_, err = vk.Download(server.URL+"/doc", &bytes.Buffer{}, api.DownloadOptions{
+		Hash:     sha256.New(),
+		Checksum: []byte("invalid"),
+	})
+	assert.ErrorIs(t, err, api.ErrChecksum)
+
+	// the size is known from Content-Length
+	n, err = vk.Download(server.URL+"/doc", &bytes.Buffer{}, api.DownloadOptions{MaxSize: 100})
+	assert.ErrorIs(t, err, api.ErrDownloadTooLarge)
+	assert.Zero(t, n)
+
+	// the size is unknown
+	_, err = vk.Download(server.URL+"/chunked", &bytes.Buffer{}, api.DownloadOptions{MaxSize: 100})
+	assert.ErrorIs(t, err, api.ErrDownloadTooLarge)
+
+	_, err = vk.Download(server.URL+"/404", &bytes.Buffer{}, api.DownloadOptions{})
+	assert.ErrorIs(t, err, &api.StatusError{StatusCode: http.StatusNotFound})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = vk.WithContext(ctx).Download(server.URL+"/doc", &bytes.Buffer{}, api.DownloadOptions{})
+	assert.Equal(t, context.Canceled, err)
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2/object"
 )
@@ -99,6 +101,9 @@ const (
 	// for the user in the full version of the site.
 	ErrAccess ErrorType = 15
 
+	// ErrAccessDenied is an alias of ErrAccess.
+	ErrAccessDenied = ErrAccess
+
 	// HTTP authorization failed
 	//
 	// To avoid this error check if a user has the 'Use secure connection'
@@ -907,11 +912,29 @@ type Error struct {
 	// See https://vk.com/dev/need_validation
 	RedirectURI   string                    `json:"redirect_uri"`
 	RequestParams []object.BaseRequestParam `json:"request_params"`
+
+	// Method is the method of the failed request. It is set by
+	// DefaultHandler.
+	Method string `json:"-"`
+
+	// Params are the params of the failed request without the access
+	// token, other secrets are redacted. They are set by DefaultHandler.
+	Params url.Values `json:"-"`
 }
 
-// Error returns the message of a Error.
+// Error returns the message of a Error with the method and the params of
+// the request, if they are set:
+//
+//	api: users.get(user_ids=0&v=5.131): Invalid user id
 func (e Error) Error() string {
-	return "api: " + e.Message
+	switch {
+	case e.Method == "":
+		return "api: " + e.Message
+	case len(e.Params) == 0:
+		return "api: " + e.Method + ": " + e.Message
+	default:
+		return "api: " + e.Method + "(" + e.Params.Encode() + "): " + e.Message
+	}
 }
 
 // Is unwraps its first argument sequentially looking for an error that matches
@@ -927,9 +950,37 @@ func (e Error) Is(target error) bool {
 		return e.Code == tErrorType
 	}
 
+	var tErrorSubtype ErrorSubtype
+	if errors.As(target, &tErrorSubtype) {
+		return e.Subcode == tErrorSubtype
+	}
+
 	return false
 }
 
+// As finds the code or the subcode of the error.
+//
+//	var code api.ErrorType
+//	if errors.As(err, &code) {
+//		log.Print(code)
+//	}
+func (e Error) As(target interface{}) bool {
+	switch t := target.(type) {
+	case *ErrorType:
+		*t = e.Code
+	case *ErrorSubtype:
+		if e.Subcode == 0 {
+			return false
+		}
+
+		*t = e.Subcode
+	default:
+		return false
+	}
+
+	return true
+}
+
 // ExecuteError struct.
 //
 // TODO: v3 Code is ErrorType.
@@ -939,6 +990,22 @@ type ExecuteError struct {
 	Msg    string `json:"error_msg"`
 }
 
+// Error returns the message of a ExecuteError.
+func (e ExecuteError) Error() string {
+	return "api: " + e.Method + ": " + e.Msg
+}
+
+// Is unwraps its first argument sequentially looking for an error that matches
+// the second.
+func (e ExecuteError) Is(target error) bool {
+	var tErrorType ErrorType
+	if errors.As(target, &tErrorType) {
+		return ErrorType(e.Code) == tErrorType
+	}
+
+	return false
+}
+
 // ExecuteErrors type.
 type ExecuteErrors []ExecuteError
 
@@ -947,6 +1014,28 @@ func (e ExecuteErrors) Error() string {
 	return fmt.Sprintf("api: execute errors (%d)", len(e))
 }
 
+// Is reports whether any of the errors matches the target.
+func (e ExecuteErrors) Is(target error) bool {
+	for _, err := range e {
+		if err.Is(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServiceTokenError is returned when the method is not available with
+// the service token.
+type ServiceTokenError struct {
+	Method string
+}
+
+// Error returns the message of a ServiceTokenError.
+func (e ServiceTokenError) Error() string {
+	return "api: " + e.Method + " is not available with a service token"
+}
+
 // InvalidContentType type.
 type InvalidContentType struct {
 	ContentType string
@@ -957,6 +1046,33 @@ func (e InvalidContentType) Error() string {
 	return "api: invalid content-type"
 }
 
+// StatusError is returned when VK responds with an unexpected HTTP status,
+// for example, 429 Too Many Requests after the attempts of the request are
+// spent, or 404 Not Found of a download.
+type StatusError struct {
+	StatusCode int
+
+	// RetryAfter is the delay of the Retry-After header.
+	RetryAfter time.Duration
+}
+
+// Error returns the message of a StatusError.
+func (e StatusError) Error() string {
+	return fmt.Sprintf("api: unexpected status %d", e.StatusCode)
+}
+
+// Is reports whether target is StatusError with the same status code.
+func (e StatusError) Is(target error) bool {
+	switch t := target.(type) {
+	case *StatusError:
+		return t.StatusCode == e.StatusCode
+	case StatusError:
+		return t.StatusCode == e.StatusCode
+	}
+
+	return false
+}
+
 // UploadError type.
 type UploadError struct {
 	Err      string `json:"error"`
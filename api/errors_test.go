@@ -2,6 +2,8 @@ package api_test
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/SevereCloud/vksdk/v2/api"
@@ -31,6 +33,36 @@ func TestError_Error(t *testing.T) {
 		Message: "test message",
 	}
 	assert.EqualError(t, err, "api: test message")
+
+	err.Method = "users.get"
+	assert.EqualError(t, err, "api: users.get: test message")
+
+	err.Params = url.Values{"user_ids": {"0"}, "v": {"5.131"}}
+	assert.EqualError(t, err, "api: users.get(user_ids=0&v=5.131): test message")
+}
+
+func TestError_request(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"error":{"error_code":113,"error_msg":"Invalid user id"}}`)
+	vk.Version = "5.131"
+
+	_, err := vk.Request("users.get", api.Params{
+		"user_ids":      0,
+		"access_token":  "secret",
+		"client_secret": "secret",
+	})
+	assert.EqualError(t, err, "api: users.get(client_secret=%2A%2A%2A&user_ids=0&v=5.131): Invalid user id")
+
+	var e *api.Error
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, "users.get", e.Method)
+		assert.Equal(t, url.Values{
+			"client_secret": {"***"},
+			"user_ids":      {"0"},
+			"v":             {"5.131"},
+		}, e.Params)
+	}
 }
 
 func TestError_Is(t *testing.T) {
@@ -48,6 +80,9 @@ func TestError_Is(t *testing.T) {
 	f(&api.Error{Code: api.ErrorType(1)}, api.ErrorType(2), false)
 	f(&api.Error{Code: api.ErrorType(1), Message: "123"}, &api.Error{Code: api.ErrorType(1), Message: "321"}, false)
 	f(&api.Error{Code: api.ErrorType(1)}, &streaming.Error{}, false)
+	f(&api.Error{Code: api.ErrAccess}, api.ErrAccessDenied, true)
+	f(&api.Error{Code: api.ErrAuth, Subcode: 2}, api.ErrorSubtype(2), true)
+	f(&api.Error{Code: api.ErrAuth, Subcode: 2}, api.ErrorSubtype(3), false)
 }
 
 func TestError_As(t *testing.T) {
@@ -59,6 +94,19 @@ func TestError_As(t *testing.T) {
 	if !errors.As(err, &target) && target.Code == 1 {
 		t.Error("As not working")
 	}
+
+	var code api.ErrorType
+
+	assert.True(t, errors.As(fmt.Errorf("wrap: %w", err), &code))
+	assert.Equal(t, api.ErrUnknown, code)
+
+	var subcode api.ErrorSubtype
+
+	assert.False(t, errors.As(err, &subcode))
+
+	err.Subcode = 1
+	assert.True(t, errors.As(err, &subcode))
+	assert.Equal(t, api.ErrorSubtype(1), subcode)
 }
 
 func TestInvalidContentType(t *testing.T) {
@@ -73,6 +121,15 @@ func TestExecuteErrors(t *testing.T) {
 
 	err := api.ExecuteErrors{api.ExecuteError{}}
 	assert.EqualError(t, err, "api: execute errors (1)")
+
+	err = api.ExecuteErrors{
+		{Method: "users.get", Code: 113, Msg: "Invalid user id"},
+		{Method: "wall.get", Code: 15, Msg: "Access denied"},
+	}
+	assert.EqualError(t, err[0], "api: users.get: Invalid user id")
+	assert.True(t, errors.Is(err, api.ErrAccessDenied))
+	assert.True(t, errors.Is(err[0], api.ErrParamUserID))
+	assert.False(t, errors.Is(err, api.ErrAuth))
 }
 
 func TestAdsError_Error(t *testing.T) {
@@ -1,7 +1,5 @@
 package api
 
-import "encoding/json"
-
 // ExecuteWithArgs a universal method for calling a sequence of other methods
 // while saving and filtering interim results.
 //
@@ -13,7 +11,10 @@ import "encoding/json"
 //
 // https://vk.com/dev/execute
 func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error {
-	token := vk.getToken()
+	token, err := vk.token(params)
+	if err != nil {
+		return err
+	}
 
 	reqParams := Params{
 		"code":         code,
@@ -21,12 +22,12 @@ func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error
 		"v":            vk.Version,
 	}
 
-	resp, err := vk.Handler("execute", params, reqParams)
+	resp, err := vk.do("execute", vk.baseParams(), params, reqParams)
 	if err != nil {
 		return err
 	}
 
-	jsonErr := json.Unmarshal(resp.Response, &obj)
+	jsonErr := vk.unmarshal(resp.Response, &obj)
 	if jsonErr != nil {
 		return jsonErr
 	}
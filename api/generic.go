@@ -0,0 +1,28 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+// RequestAs calls the method of VK API and decodes the response into T. It
+// allows to call methods that have no dedicated method in VK with typed
+// responses:
+//
+//	type Status struct {
+//		Text string `json:"text"`
+//	}
+//
+//	status, err := api.RequestAs[Status](vk, "status.get", api.Params{
+//		"user_id": 1,
+//	})
+//
+// It is not named Request, because Request is the type of middleware
+// requests.
+func RequestAs[T any](vk *VK, method string, params ...Params) (T, error) {
+	var response T
+
+	rawResponse, err := vk.Request(method, params...)
+	if err != nil {
+		return response, err
+	}
+
+	err = vk.unmarshal(rawResponse, &response)
+
+	return response, err
+}
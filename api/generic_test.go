@@ -0,0 +1,32 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type statusResponse struct {
+	Text string `json:"text"`
+}
+
+func TestRequestAs(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":{"text":"status"}}`)
+
+	status, err := api.RequestAs[statusResponse](vk, "status.get", api.Params{"user_id": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, statusResponse{Text: "status"}, status)
+
+	ids, err := api.RequestAs[[]int](newTestVK(t, `{"response":[1,2]}`), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids)
+
+	_, err = api.RequestAs[int](newTestVK(t, `{"error":{"error_code":5}}`), "test")
+	assert.ErrorIs(t, err, api.ErrAuth)
+
+	_, err = api.RequestAs[int](vk, "status.get")
+	assert.Error(t, err)
+}
@@ -216,9 +216,28 @@ type GroupsGetByIDResponse []object.GroupsGroup
 
 // GroupsGetByID returns information about communities by their IDs.
 //
+// If group_ids contains more than GroupsGetByIDMaxIDs IDs, they are
+// requested in several requests.
+//
 // https://vk.com/dev/groups.getById
 func (vk *VK) GroupsGetByID(params Params) (response GroupsGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getById", &response, params)
+	chunks := splitIDs(params, "group_ids", GroupsGetByIDMaxIDs)
+	if len(chunks) == 1 {
+		err = vk.RequestUnmarshal("groups.getById", &response, params)
+		return
+	}
+
+	for _, chunk := range chunks {
+		var r GroupsGetByIDResponse
+
+		err = vk.RequestUnmarshal("groups.getById", &r, chunk)
+		if err != nil {
+			return
+		}
+
+		response = append(response, r...)
+	}
+
 	return
 }
 
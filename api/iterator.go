@@ -0,0 +1,213 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SevereCloud/vksdk/v2/object"
+)
+
+// Iterator iterates over items of list methods. It requests the next page
+// with the offset and count params or, if the response contains next_from,
+// with the start_from param.
+//
+//	it := api.NewIterator(vk, "groups.getMembers", api.Params{"group_id": 1}, 1000)
+//	for it.Next(ctx) {
+//		var id int
+//		if err := it.Scan(&id); err != nil {
+//			return err
+//		}
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type Iterator struct {
+	vk       *VK
+	method   string
+	params   Params
+	pageSize int
+
+	offset   int
+	nextFrom string
+	cursor   bool
+	total    int
+	items    []json.RawMessage
+	i        int
+	done     bool
+	err      error
+}
+
+type iteratorPage struct {
+	Count    int               `json:"count"`
+	Items    []json.RawMessage `json:"items"`
+	NextFrom string            `json:"next_from"`
+}
+
+// NewIterator returns a new Iterator over items of the method. The pageSize
+// is the count param of requests.
+func NewIterator(vk *VK, method string, params Params, pageSize int) *Iterator {
+	p := make(Params, len(params))
+	for k, v := range params {
+		p[k] = v
+	}
+
+	offset := 0
+	if v, ok := p["offset"].(int); ok {
+		offset = v
+	}
+
+	return &Iterator{
+		vk:       vk,
+		method:   method,
+		params:   p,
+		pageSize: pageSize,
+		offset:   offset,
+		total:    -1,
+		i:        -1,
+	}
+}
+
+// Next advances the iterator to the next item, requesting the next page if
+// needed. It returns false when items are exhausted or an error occurs.
+func (it *Iterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.i++
+
+	for it.i >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		if err := it.fetch(ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Iterator) fetch(ctx context.Context) error {
+	params := make(Params, len(it.params)+3)
+	for k, v := range it.params {
+		params[k] = v
+	}
+
+	if it.pageSize > 0 {
+		params["count"] = it.pageSize
+	}
+
+	if it.nextFrom != "" {
+		params["start_from"] = it.nextFrom
+	} else {
+		params["offset"] = it.offset
+	}
+
+	params.WithContext(ctx)
+
+	var page iteratorPage
+
+	if err := it.vk.RequestUnmarshal(it.method, &page, params); err != nil {
+		return err
+	}
+
+	it.total = page.Count
+	it.items = page.Items
+	it.i = 0
+	it.offset += len(page.Items)
+	it.nextFrom = page.NextFrom
+
+	if page.NextFrom != "" {
+		it.cursor = true
+	}
+
+	it.done = len(page.Items) == 0 ||
+		it.cursor && page.NextFrom == "" ||
+		!it.cursor && it.offset >= page.Count
+
+	return nil
+}
+
+// Scan decodes the current item into v.
+func (it *Iterator) Scan(v interface{}) error {
+	return it.vk.unmarshal(it.items[it.i], v)
+}
+
+// Err returns the error that stopped the iteration.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Total returns the count of items from the last response or -1 before
+// the first request.
+func (it *Iterator) Total() int {
+	return it.total
+}
+
+// WallGetIterator iterates over posts of wall.get.
+type WallGetIterator struct {
+	*Iterator
+	post object.WallWallpost
+}
+
+// NewWallGetIterator returns a new WallGetIterator.
+func NewWallGetIterator(vk *VK, params Params) *WallGetIterator {
+	return &WallGetIterator{Iterator: NewIterator(vk, "wall.get", params, 100)}
+}
+
+// Next advances the iterator to the next post.
+func (it *WallGetIterator) Next(ctx context.Context) bool {
+	if !it.Iterator.Next(ctx) {
+		return false
+	}
+
+	it.post = object.WallWallpost{}
+	if it.err = it.Scan(&it.post); it.err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Post returns the current post.
+func (it *WallGetIterator) Post() object.WallWallpost {
+	return it.post
+}
+
+// MessagesGetConversationsIterator iterates over conversations of
+// messages.getConversations.
+type MessagesGetConversationsIterator struct {
+	*Iterator
+	conversation object.MessagesConversationWithMessage
+}
+
+// NewMessagesGetConversationsIterator returns a new
+// MessagesGetConversationsIterator.
+func NewMessagesGetConversationsIterator(vk *VK, params Params) *MessagesGetConversationsIterator {
+	return &MessagesGetConversationsIterator{
+		Iterator: NewIterator(vk, "messages.getConversations", params, 200),
+	}
+}
+
+// Next advances the iterator to the next conversation.
+func (it *MessagesGetConversationsIterator) Next(ctx context.Context) bool {
+	if !it.Iterator.Next(ctx) {
+		return false
+	}
+
+	it.conversation = object.MessagesConversationWithMessage{}
+	if it.err = it.Scan(&it.conversation); it.err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Conversation returns the current conversation.
+func (it *MessagesGetConversationsIterator) Conversation() object.MessagesConversationWithMessage {
+	return it.conversation
+}
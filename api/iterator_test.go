@@ -0,0 +1,149 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// newListVK returns VK with the server of the list of total items with ids
+// from 1.
+func newListVK(t *testing.T, total int) *api.VK {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/error" {
+			_, _ = w.Write([]byte(`{"error":{"error_code":15,"error_msg":"Access denied"}}`))
+			return
+		}
+
+		count, _ := strconv.Atoi(r.PostFormValue("count"))
+
+		// cursor pagination
+		if r.URL.Path == "/newsfeed.get" {
+			from, _ := strconv.Atoi(r.PostFormValue("start_from"))
+
+			var items []string
+			for i := from + 1; i <= from+count && i <= total; i++ {
+				items = append(items, fmt.Sprintf(`{"id":%d}`, i))
+			}
+
+			next := ""
+			if from+count < total {
+				next = strconv.Itoa(from + count)
+			}
+
+			_, _ = fmt.Fprintf(w, `{"response":{"items":[%s],"next_from":"%s"}}`, strings.Join(items, ","), next)
+
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.PostFormValue("offset"))
+
+		var items []string
+		for i := offset + 1; i <= offset+count && i <= total; i++ {
+			items = append(items, fmt.Sprintf(`{"id":%d,"peer":{"id":%d}}`, i, i))
+		}
+
+		_, _ = fmt.Fprintf(w, `{"response":{"count":%d,"items":[%s]}}`, total, strings.Join(items, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	return vk
+}
+
+func TestIterator(t *testing.T) {
+	t.Parallel()
+
+	vk := newListVK(t, 5)
+
+	f := func(method string, params api.Params, pageSize int, want []int) {
+		t.Helper()
+
+		it := api.NewIterator(vk, method, params, pageSize)
+
+		var ids []int
+
+		for it.Next(context.Background()) {
+			var item struct {
+				ID int `json:"id"`
+			}
+
+			assert.NoError(t, it.Scan(&item))
+
+			ids = append(ids, item.ID)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, want, ids)
+	}
+
+	f("wall.get", nil, 2, []int{1, 2, 3, 4, 5})
+	f("wall.get", nil, 5, []int{1, 2, 3, 4, 5})
+	f("wall.get", api.Params{"offset": 3}, 10, []int{4, 5})
+	f("newsfeed.get", nil, 2, []int{1, 2, 3, 4, 5})
+	f("newsfeed.get", nil, 10, []int{1, 2, 3, 4, 5})
+
+	empty := newListVK(t, 0)
+	it := api.NewIterator(empty, "wall.get", nil, 10)
+	assert.Equal(t, -1, it.Total())
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 0, it.Total())
+
+	it = api.NewIterator(vk, "error", nil, 10)
+	assert.False(t, it.Next(context.Background()))
+	assert.True(t, errors.Is(it.Err(), api.ErrAccessDenied))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it = api.NewIterator(vk, "wall.get", nil, 10)
+	assert.False(t, it.Next(ctx))
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
+
+func TestWallGetIterator(t *testing.T) {
+	t.Parallel()
+
+	it := api.NewWallGetIterator(newListVK(t, 150), api.Params{"owner_id": 1})
+
+	n := 0
+	for it.Next(context.Background()) {
+		n++
+
+		assert.Equal(t, n, it.Post().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 150, n)
+	assert.Equal(t, 150, it.Total())
+}
+
+func TestMessagesGetConversationsIterator(t *testing.T) {
+	t.Parallel()
+
+	it := api.NewMessagesGetConversationsIterator(newListVK(t, 3), nil)
+
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Conversation().Conversation.Peer.ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Len(t, ids, 3)
+}
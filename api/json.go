@@ -0,0 +1,22 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import "encoding/json"
+
+// JSONCodec encodes and decodes JSON. It is implemented by
+// jsoniter.ConfigCompatibleWithStandardLibrary from
+// github.com/json-iterator/go and sonic.ConfigStd from
+// github.com/bytedance/sonic.
+type JSONCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// unmarshal decodes the JSON with vk.JSON or, if it is nil, with
+// encoding/json.
+func (vk *VK) unmarshal(data []byte, v interface{}) error {
+	if vk.JSON != nil {
+		return vk.JSON.Unmarshal(data, v)
+	}
+
+	return json.Unmarshal(data, v)
+}
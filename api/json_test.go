@@ -0,0 +1,40 @@
+package api_test
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingCodec struct {
+	unmarshal int32
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	atomic.AddInt32(&c.unmarshal, 1)
+
+	return json.Unmarshal(data, v)
+}
+
+func TestVK_JSON(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":{"count":2}}`)
+	codec := &countingCodec{}
+	vk.JSON = codec
+
+	var resp struct {
+		Count int `json:"count"`
+	}
+
+	err := vk.RequestUnmarshal("test", &resp)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Count)
+	assert.NotZero(t, atomic.LoadInt32(&codec.unmarshal))
+}
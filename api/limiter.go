@@ -0,0 +1,360 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate of requests. It is implemented by
+// *rate.Limiter from golang.org/x/time/rate.
+type Limiter interface {
+	// Wait blocks until the request is allowed or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// TokenType is the type of an access token.
+type TokenType int
+
+// Token types.
+const (
+	TokenUnknown TokenType = iota
+	TokenUser
+	TokenGroup
+	TokenService
+)
+
+// Limit returns the number of requests per second that are allowed for
+// the token type.
+func (t TokenType) Limit() int {
+	switch t {
+	case TokenUser, TokenService:
+		return LimitUserToken
+	default:
+		return LimitGroupToken
+	}
+}
+
+// SetTokenType sets the type of tokens and the limit of requests per second
+// for the type.
+//
+//	vk := api.NewVK(userToken)
+//	vk.SetTokenType(api.TokenUser)
+func (vk *VK) SetTokenType(t TokenType) {
+	vk.tokenType = t
+	vk.Limit = t.Limit()
+}
+
+// TokenType returns the type of tokens set by SetTokenType or
+// DetectTokenType.
+func (vk *VK) TokenType() TokenType {
+	return vk.tokenType
+}
+
+// DetectTokenType detects the type of tokens with requests to VK API and
+// sets it with SetTokenType.
+func (vk *VK) DetectTokenType() (TokenType, error) {
+	t := TokenGroup
+
+	if _, err := vk.GroupsGetByID(nil); err != nil {
+		users, err := vk.UsersGet(nil)
+		if err != nil {
+			return TokenUnknown, err
+		}
+
+		// a service token has no user
+		t = TokenService
+		if len(users) > 0 {
+			t = TokenUser
+		}
+	}
+
+	vk.SetTokenType(t)
+
+	return t, nil
+}
+
+// wait blocks until the request is allowed by vk.Limiter or, if it is nil,
+// by the limit of requests per second for the token.
+func (vk *VK) wait(ctx context.Context, token string) error {
+	if vk.Limiter != nil {
+		return vk.Limiter.Wait(ctx)
+	}
+
+	if vk.Limit <= 0 {
+		return nil
+	}
+
+	vk.mux.Lock()
+	if vk.limiters == nil || vk.limit != vk.Limit {
+		vk.limiters = make(map[string]*windowLimiter)
+		vk.limit = vk.Limit
+	}
+
+	l, ok := vk.limiters[token]
+	if !ok {
+		vk.removeIdleLimiters()
+
+		l = newWindowLimiter(vk.limit)
+		vk.limiters[token] = l
+	}
+
+	l.users++
+	vk.mux.Unlock()
+
+	err := l.Wait(ctx)
+
+	vk.mux.Lock()
+	l.users--
+	vk.mux.Unlock()
+
+	return err
+}
+
+// removeIdleLimiters removes the limiters of tokens that were not used for
+// the last second, e.g. after the rotation of tokens, so that the limiters
+// are not kept for all tokens ever used.
+func (vk *VK) removeIdleLimiters() {
+	for token, l := range vk.limiters {
+		if l.users == 0 && l.idle() {
+			delete(vk.limiters, token)
+		}
+	}
+}
+
+// QueueLen returns the number of requests waiting for the rate limit of VK.
+// Requests waiting for vk.Limiter are not counted.
+func (vk *VK) QueueLen() int {
+	vk.mux.Lock()
+	defer vk.mux.Unlock()
+
+	n := 0
+
+	for _, l := range vk.limiters {
+		l.mux.Lock()
+		n += len(l.queue)
+		l.mux.Unlock()
+	}
+
+	return n
+}
+
+// Priority of a request in the queue of the rate limiter.
+type Priority int
+
+// Priorities.
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a copy of ctx with the priority of requests. When
+// requests wait for the rate limit, the requests with higher priority are
+// sent first:
+//
+//	ctx := api.WithPriority(context.Background(), api.PriorityHigh)
+//	_, err := vk.WithContext(ctx).MessagesSend(b.Params)
+//
+// The priority is used only by the rate limiter of VK, not by vk.Limiter.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// ContextPriority returns the priority of requests set by WithPriority.
+func ContextPriority(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+
+	return PriorityNormal
+}
+
+// windowLimiter allows no more than limit requests in any second.
+//
+// The waiting requests are queued by priority and, with equal priority, in
+// the order of arrival. So a goroutine that sends requests in a loop waits
+// behind the other goroutines and cannot starve them.
+type windowLimiter struct {
+	mux     sync.Mutex
+	limit   int
+	times   []time.Time // the times of the last requests
+	next    int         // the index of the oldest request
+	queue   waitQueue
+	counter uint64
+
+	// users is the number of requests that use the limiter. It is guarded
+	// by the mutex of VK.
+	users int
+}
+
+func newWindowLimiter(limit int) *windowLimiter {
+	return &windowLimiter{
+		limit: limit,
+		times: make([]time.Time, 0, limit),
+	}
+}
+
+// delay returns the time until the next request is allowed.
+func (l *windowLimiter) delay() time.Duration {
+	if len(l.times) < l.limit {
+		return 0
+	}
+
+	return time.Second - time.Since(l.times[l.next])
+}
+
+// idle reports whether the window of the limiter is empty.
+func (l *windowLimiter) idle() bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if len(l.times) == 0 {
+		return true
+	}
+
+	last := l.times[len(l.times)-1]
+	if len(l.times) == l.limit {
+		last = l.times[(l.next+l.limit-1)%l.limit]
+	}
+
+	return time.Since(last) >= time.Second
+}
+
+// take records the request.
+func (l *windowLimiter) take() {
+	if len(l.times) < l.limit {
+		l.times = append(l.times, time.Now())
+
+		return
+	}
+
+	l.times[l.next] = time.Now()
+	l.next = (l.next + 1) % l.limit
+}
+
+// wakeHead wakes the first waiter of the queue.
+func (l *windowLimiter) wakeHead() {
+	if len(l.queue) == 0 {
+		return
+	}
+
+	select {
+	case l.queue[0].wake <- struct{}{}:
+	default:
+	}
+}
+
+// Wait implements Limiter.
+func (l *windowLimiter) Wait(ctx context.Context) error {
+	l.mux.Lock()
+
+	if len(l.queue) == 0 && l.delay() <= 0 {
+		l.take()
+		l.mux.Unlock()
+
+		return nil
+	}
+
+	w := &waiter{
+		priority: ContextPriority(ctx),
+		seq:      l.counter,
+		wake:     make(chan struct{}, 1),
+	}
+	l.counter++
+
+	heap.Push(&l.queue, w)
+	l.wakeHead()
+
+	for {
+		var (
+			t     *time.Timer
+			timer <-chan time.Time
+		)
+
+		if l.queue[0] == w {
+			d := l.delay()
+			if d <= 0 {
+				heap.Pop(&l.queue)
+				l.take()
+				l.wakeHead()
+				l.mux.Unlock()
+
+				return nil
+			}
+
+			t = time.NewTimer(d)
+			timer = t.C
+		}
+
+		l.mux.Unlock()
+
+		select {
+		case <-ctx.Done():
+			if t != nil {
+				t.Stop()
+			}
+
+			l.mux.Lock()
+			heap.Remove(&l.queue, w.index)
+			l.wakeHead()
+			l.mux.Unlock()
+
+			return ctx.Err()
+		case <-timer:
+		case <-w.wake:
+		}
+
+		if t != nil {
+			t.Stop()
+		}
+
+		l.mux.Lock()
+	}
+}
+
+// waiter is a request waiting for the rate limit.
+type waiter struct {
+	priority Priority
+	seq      uint64
+	wake     chan struct{}
+	index    int
+}
+
+// waitQueue implements heap.Interface.
+type waitQueue []*waiter
+
+func (q waitQueue) Len() int { return len(q) }
+
+func (q waitQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q waitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waitQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waitQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return w
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_removeIdleLimiters(t *testing.T) {
+	t.Parallel()
+
+	vk := NewVK()
+	vk.Limit = 2
+
+	ctx := context.Background()
+
+	for _, token := range []string{"a", "b", "b", "c"} {
+		assert.NoError(t, vk.wait(ctx, token))
+	}
+
+	assert.Len(t, vk.limiters, 3)
+
+	// the windows of a and c are empty, but c is in use, b is used in the
+	// last second
+	vk.limiters["a"].times[0] = time.Now().Add(-2 * time.Second)
+	vk.limiters["c"].times[0] = time.Now().Add(-2 * time.Second)
+	vk.limiters["c"].users++
+
+	assert.NoError(t, vk.wait(ctx, "d"))
+	assert.Len(t, vk.limiters, 3)
+	assert.NotContains(t, vk.limiters, "a")
+
+	vk.limiters["b"].times[0] = time.Now().Add(-2 * time.Second)
+	vk.limiters["b"].times[1] = time.Now().Add(-2 * time.Second)
+	vk.limiters["c"].users--
+
+	assert.NoError(t, vk.wait(ctx, "e"))
+	assert.Len(t, vk.limiters, 2)
+	assert.Contains(t, vk.limiters, "d")
+	assert.Contains(t, vk.limiters, "e")
+}
@@ -0,0 +1,263 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestVK(t *testing.T, response string) *api.VK {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	return vk
+}
+
+func TestVK_Limit(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":1}`)
+	vk.Limit = 2
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vk.Request("test", nil)
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	// 2 requests at once, 2 after a second and 1 after two seconds
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(2*time.Second))
+
+	// the waiting is stopped when the context is done
+	vk.Limit = 1
+
+	_, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = vk.WithContext(ctx).Request("test", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type countLimiter int
+
+func (l *countLimiter) Wait(ctx context.Context) error {
+	*l++
+
+	return nil
+}
+
+func TestVK_Limiter(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":1}`)
+	vk.Limit = 1
+
+	var limiter countLimiter
+
+	vk.Limiter = &limiter
+
+	for i := 0; i < 3; i++ {
+		_, err := vk.Request("test", nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, countLimiter(3), limiter)
+}
+
+func TestVK_DetectTokenType(t *testing.T) {
+	t.Parallel()
+
+	f := func(responses map[string]string, want api.TokenType, wantLimit int) {
+		t.Helper()
+
+		vk := api.NewVK("")
+		vk.Handler = func(method string, params ...api.Params) (api.Response, error) {
+			if response, ok := responses[method]; ok {
+				return api.Response{Response: []byte(response)}, nil
+			}
+
+			return api.Response{}, &api.Error{Code: api.ErrParam}
+		}
+
+		got, err := vk.DetectTokenType()
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, want, vk.TokenType())
+		assert.Equal(t, wantLimit, vk.Limit)
+	}
+
+	f(map[string]string{"groups.getById": `[{"id":1}]`}, api.TokenGroup, api.LimitGroupToken)
+	f(map[string]string{"users.get": `[{"id":1}]`}, api.TokenUser, api.LimitUserToken)
+	f(map[string]string{"users.get": `[]`}, api.TokenService, api.LimitUserToken)
+
+	vk := api.NewVK("")
+	vk.Handler = func(method string, params ...api.Params) (api.Response, error) {
+		return api.Response{}, &api.Error{Code: api.ErrAuth}
+	}
+
+	_, err := vk.DetectTokenType()
+	assert.ErrorIs(t, err, api.ErrAuth)
+}
+
+func TestVK_TokenPool(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mux    sync.Mutex
+		tokens []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		tokens = append(tokens, r.PostFormValue("access_token"))
+		mux.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":1}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("a", "b", "c")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.Limit = 1
+
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		_, err := vk.Request("test", nil)
+		assert.NoError(t, err)
+	}
+
+	// each token is limited separately
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, []string{"a", "b", "c"}, tokens)
+
+	_, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, "a", tokens[3])
+}
+
+func TestVK_LimitPriority(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mux   sync.Mutex
+		order []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		order = append(order, r.PostFormValue("name"))
+		mux.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":1}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.Limit = 1
+
+	_, err := vk.Request("test", api.Params{"name": "first"})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	send := func(name string, p api.Priority) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ctx := api.WithPriority(context.Background(), p)
+			_, err := vk.WithContext(ctx).Request("test", api.Params{"name": name})
+			assert.NoError(t, err)
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// the canceled request leaves the queue
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = vk.WithContext(api.WithPriority(ctx, api.PriorityHigh)).Request("test", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	send("low", api.PriorityLow)
+	send("normal", api.PriorityNormal)
+	send("high", api.PriorityHigh)
+
+	wg.Wait()
+
+	assert.Equal(t, []string{"first", "high", "normal", "low"}, order)
+}
+
+func TestContextPriority(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, api.PriorityNormal, api.ContextPriority(context.Background()))
+	assert.Equal(t, api.PriorityLow, api.ContextPriority(
+		api.WithPriority(context.Background(), api.PriorityLow),
+	))
+}
+
+func TestVK_QueueLen(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":1}`)
+	vk.Limit = 1
+
+	_, err := vk.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, vk.QueueLen())
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, err := vk.Request("test", nil)
+		assert.NoError(t, err)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return vk.QueueLen() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	<-done
+	assert.Equal(t, 0, vk.QueueLen())
+}
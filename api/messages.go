@@ -525,6 +525,8 @@ func (vk *VK) MessagesSearchConversations(params Params) (response MessagesSearc
 //
 // For user_ids or peer_ids parameters, use MessagesSendUserIDs.
 //
+// If random_id is not passed, it is set by RandomID.
+//
 // https://vk.com/dev/messages.send
 func (vk *VK) MessagesSend(params Params) (response int, err error) {
 	reqParams := Params{
@@ -532,7 +534,7 @@ func (vk *VK) MessagesSend(params Params) (response int, err error) {
 		"peer_ids": "",
 	}
 
-	err = vk.RequestUnmarshal("messages.send", &response, params, reqParams)
+	err = vk.RequestUnmarshal("messages.send", &response, randomIDParams(params), params, reqParams)
 
 	return
 }
@@ -551,9 +553,11 @@ type MessagesSendUserIDsResponse []struct {
 //
 // 	need peer_ids;
 //
+// If random_id is not passed, it is set by RandomID.
+//
 // https://vk.com/dev/messages.send
 func (vk *VK) MessagesSendPeerIDs(params Params) (response MessagesSendUserIDsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.send", &response, params)
+	err = vk.RequestUnmarshal("messages.send", &response, randomIDParams(params), params)
 	return
 }
 
@@ -578,9 +582,11 @@ func (vk *VK) MessagesSendMessageEventAnswer(params Params) (response int, err e
 
 // MessagesSendSticker sends a message.
 //
+// If random_id is not passed, it is set by RandomID.
+//
 // https://vk.com/dev/messages.sendSticker
 func (vk *VK) MessagesSendSticker(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.sendSticker", &response, params, Params{"user_ids": ""})
+	err = vk.RequestUnmarshal("messages.sendSticker", &response, randomIDParams(params), params, Params{"user_ids": ""})
 
 	return
 }
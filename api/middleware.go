@@ -0,0 +1,139 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request is an API call passed to middlewares.
+type Request struct {
+	Method string
+
+	// Params contains all params of the call, including the version and
+	// the access token.
+	Params Params
+
+	// Header is added to the HTTP request.
+	Header http.Header
+}
+
+// Context returns the context of the request.
+func (r Request) Context() context.Context {
+	if ctx, ok := r.Params[":context"].(context.Context); ok {
+		return ctx
+	}
+
+	return context.Background()
+}
+
+// Doer does API calls.
+type Doer interface {
+	Do(req Request) (Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doer.
+type DoerFunc func(req Request) (Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req Request) (Response, error) {
+	return f(req)
+}
+
+// DoerHandler returns the handler that calls the Doer. It allows to replace
+// the transport of VK, for example, with a fake in tests.
+//
+//	vk.Handler = api.DoerHandler(doer)
+func DoerHandler(d Doer) func(method string, params ...Params) (Response, error) {
+	return func(method string, sliceParams ...Params) (Response, error) {
+		req := Request{
+			Method: method,
+			Params: Params{},
+			Header: http.Header{},
+		}
+
+		for _, params := range sliceParams {
+			for k, v := range params {
+				if h, ok := v.(http.Header); ok && k == ":header" {
+					for key, values := range h {
+						req.Header[key] = values
+					}
+
+					continue
+				}
+
+				req.Params[k] = v
+			}
+		}
+
+		return d.Do(req)
+	}
+}
+
+// Middleware wraps API calls. It may change the request, call next or
+// return a response without calling next.
+type Middleware func(req Request, next Doer) (Response, error)
+
+// Use adds middlewares around every API call. Middlewares are called in
+// the order of adding.
+//
+//	vk.Use(func(req api.Request, next api.Doer) (api.Response, error) {
+//		start := time.Now()
+//		resp, err := next.Do(req)
+//		log.Printf("%s %s", req.Method, time.Since(start))
+//
+//		return resp, err
+//	})
+//
+// Use is not safe to call concurrently with requests.
+func (vk *VK) Use(mw ...Middleware) {
+	vk.middlewares = append(vk.middlewares, mw...)
+}
+
+// do calls the method through middlewares and the handler.
+func (vk *VK) do(method string, sliceParams ...Params) (Response, error) {
+	if err := vk.checkServiceMethod(method); err != nil {
+		return Response{}, err
+	}
+
+	if len(vk.middlewares) == 0 {
+		return vk.Handler(method, sliceParams...)
+	}
+
+	params := Params{}
+
+	for _, p := range sliceParams {
+		for k, v := range p {
+			params[k] = v
+		}
+	}
+
+	return vk.next(0).Do(Request{
+		Method: method,
+		Params: params,
+		Header: http.Header{},
+	})
+}
+
+// next returns the Doer that calls the i-th middleware.
+func (vk *VK) next(i int) Doer {
+	if i == len(vk.middlewares) {
+		return DoerFunc(func(req Request) (Response, error) {
+			params := req.Params
+
+			if len(req.Header) > 0 {
+				params = make(Params, len(req.Params)+1)
+				for k, v := range req.Params {
+					params[k] = v
+				}
+
+				params[":header"] = req.Header
+			}
+
+			return vk.Handler(req.Method, params)
+		})
+	}
+
+	return DoerFunc(func(req Request) (Response, error) {
+		return vk.middlewares[i](req, vk.next(i+1))
+	})
+}
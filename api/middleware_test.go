@@ -0,0 +1,73 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_Use(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		b, _ := json.Marshal(r.Header.Get("X-Test") + " " + r.PostFormValue("sig"))
+		_, _ = w.Write([]byte(`{"response":` + string(b) + `}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("token")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	var calls []string
+
+	vk.Use(
+		func(req api.Request, next api.Doer) (api.Response, error) {
+			calls = append(calls, "first "+req.Method)
+
+			assert.Equal(t, "token", req.Params["access_token"])
+			assert.Equal(t, api.Version, req.Params["v"])
+			assert.Equal(t, 1, req.Params["user_id"])
+
+			return next.Do(req)
+		},
+		func(req api.Request, next api.Doer) (api.Response, error) {
+			calls = append(calls, "second "+req.Method)
+
+			if req.Method == "cached" {
+				return api.Response{Response: []byte(`"cached"`)}, nil
+			}
+
+			req.Header.Set("X-Test", "header")
+			req.Params["sig"] = "signed"
+
+			return next.Do(req)
+		},
+	)
+
+	var res string
+
+	assert.NoError(t, vk.RequestUnmarshal("test", &res, api.Params{"user_id": 1}))
+	assert.Equal(t, "header signed", res)
+
+	assert.NoError(t, vk.RequestUnmarshal("cached", &res, api.Params{"user_id": 1}))
+	assert.Equal(t, "cached", res)
+
+	assert.Equal(t, []string{"first test", "second test", "first cached", "second cached"}, calls)
+}
+
+func TestRequest_Context(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), struct{}{}, 1)
+
+	assert.Equal(t, ctx, api.Request{Params: api.Params{}.WithContext(ctx)}.Context())
+	assert.Equal(t, context.Background(), api.Request{}.Context())
+}
@@ -2,12 +2,14 @@
 package oauth // import "github.com/SevereCloud/vksdk/v2/api/oauth"
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2/internal"
 )
@@ -22,6 +24,10 @@ type GroupToken struct {
 type GroupTokens struct {
 	Groups    []GroupToken `json:"groups"`
 	ExpiresIn int          `json:"expires_in"`
+
+	// Expiry is the time when the tokens expire. It is zero if the tokens
+	// do not expire.
+	Expiry time.Time `json:"-"`
 }
 
 // NewGroupTokensFromJSON return group tokens.
@@ -39,6 +45,7 @@ func NewGroupTokensFromJSON(data []byte) (*GroupTokens, error) {
 
 	var t GroupTokens
 	err = json.Unmarshal(data, &t)
+	t.Expiry = expiry(t.ExpiresIn)
 
 	return &t, err
 }
@@ -79,6 +86,8 @@ func NewGroupTokensFromURL(u *url.URL) (*GroupTokens, error) {
 		}
 	}
 
+	t.Expiry = expiry(t.ExpiresIn)
+
 	return t, nil
 }
 
@@ -183,14 +192,20 @@ func (a AuthCodeFlowGroup) URL() *url.URL {
 	return u
 }
 
-func (a AuthCodeFlowGroup) buildRequest(code string) *http.Request {
+func (a AuthCodeFlowGroup) buildRequest(ctx context.Context, code string) *http.Request {
 	q := &url.Values{}
 
 	q.Set("client_id", strconv.Itoa(a.params.ClientID))
 	q.Set("client_secret", a.clientSecret)
-	q.Set("redirect_uri", a.params.RedirectURI)
 	q.Set("code", code)
 
+	// the redirect URI must match the one of the authorization dialog
+	if a.params.RedirectURI == "" {
+		q.Set("redirect_uri", DefaultRedirectURI)
+	} else {
+		q.Set("redirect_uri", a.params.RedirectURI)
+	}
+
 	uReq := &url.URL{
 		Scheme:   scheme,
 		Host:     OAuthHost,
@@ -198,26 +213,34 @@ func (a AuthCodeFlowGroup) buildRequest(code string) *http.Request {
 		RawQuery: q.Encode(),
 	}
 
-	req, _ := http.NewRequest("GET", uReq.String(), nil)
+	req, _ := http.NewRequestWithContext(ctx, "GET", uReq.String(), nil)
 	req.Header.Set("User-Agent", a.UserAgent)
 
 	return req
 }
 
-func (a AuthCodeFlowGroup) request(code string) (*http.Response, error) {
-	req := a.buildRequest(code)
+func (a AuthCodeFlowGroup) request(ctx context.Context, code string) (*http.Response, error) {
+	req := a.buildRequest(ctx, code)
 
 	return a.Client.Do(req)
 }
 
-// Token ...
+// Token exchanges the code from the redirect URL for the token.
+//
+// The code is taken from the query of the URL or, for the default redirect
+// URI, from the fragment. Errors of the redirect are returned as *Error.
 func (a AuthCodeFlowGroup) Token(u *url.URL) (*GroupTokens, error) {
 	code, err := parseCode(u)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := a.request(code)
+	return a.Exchange(context.Background(), code)
+}
+
+// Exchange exchanges the code for the token.
+func (a AuthCodeFlowGroup) Exchange(ctx context.Context, code string) (*GroupTokens, error) {
+	resp, err := a.request(ctx, code)
 	if err != nil {
 		return nil, err
 	}
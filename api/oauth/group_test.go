@@ -18,6 +18,8 @@ func TestNewGroupTokensFromJSON(t *testing.T) {
 		token, err := oauth.NewGroupTokensFromJSON(data)
 		if err != nil {
 			assert.EqualError(t, err, wantErr)
+		} else {
+			checkExpiry(t, token.ExpiresIn, &token.Expiry)
 		}
 
 		assert.Equal(t, token, wantToken)
@@ -55,6 +57,8 @@ func TestNewGroupTokensFromURL(t *testing.T) {
 		token, err := oauth.NewGroupTokensFromURL(u)
 		if err != nil {
 			assert.EqualError(t, err, wantErr)
+		} else {
+			checkExpiry(t, token.ExpiresIn, &token.Expiry)
 		}
 
 		assert.Equal(t, token, wantToken)
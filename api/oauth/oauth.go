@@ -3,6 +3,7 @@ package oauth // import "github.com/SevereCloud/vksdk/v2/api/oauth"
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2/api"
 )
@@ -58,6 +59,46 @@ const (
 	ScopeGroupManage    = 1 << 18
 )
 
+// Scope is a bit mask of access permissions. The Scope... constants are
+// untyped, so they can be used as Scope.
+type Scope int
+
+// Scopes returns the bit mask of the permissions for the Scope params.
+//
+//	p := oauth.UserParams{
+//		ClientID: 123456,
+//		Scope:    oauth.Scopes(oauth.ScopeUserFriends, oauth.ScopeUserOffline),
+//	}
+func Scopes(permissions ...Scope) int {
+	var s Scope
+	for _, p := range permissions {
+		s |= p
+	}
+
+	return int(s)
+}
+
+// Has returns true if the scope contains all the permissions.
+func (s Scope) Has(permissions ...Scope) bool {
+	for _, p := range permissions {
+		if s&p != p {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expiry returns the time when the token expires or the zero time if
+// the token does not expire.
+func expiry(expiresIn int) time.Time {
+	if expiresIn <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
+
 // CheckScope ...
 func CheckScope(scope int, permissions ...int) bool {
 	for i := 0; i < len(permissions); i++ {
@@ -80,7 +121,7 @@ const (
 )
 
 func parseCode(u *url.URL) (string, error) {
-	v, err := url.ParseQuery(u.Fragment)
+	v, err := redirectValues(u)
 
 	if errType := v.Get("error"); errType != "" {
 		err = &Error{
@@ -34,3 +34,15 @@ func TestCheckScope(t *testing.T) {
 		oauth.ScopeUserFriends, oauth.ScopeUserStatus, oauth.ScopeUserPhotos,
 	)
 }
+
+func TestScope(t *testing.T) {
+	t.Parallel()
+
+	scope := oauth.Scopes(oauth.ScopeUserFriends, oauth.ScopeUserStatus)
+	assert.Equal(t, 1026, scope)
+
+	assert.True(t, oauth.Scope(scope).Has(oauth.ScopeUserFriends))
+	assert.True(t, oauth.Scope(scope).Has(oauth.ScopeUserFriends, oauth.ScopeUserStatus))
+	assert.False(t, oauth.Scope(scope).Has(oauth.ScopeUserFriends, oauth.ScopeUserPhotos))
+	assert.Equal(t, 0, oauth.Scopes())
+}
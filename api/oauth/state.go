@@ -0,0 +1,58 @@
+package oauth // import "github.com/SevereCloud/vksdk/v2/api/oauth"
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/url"
+)
+
+// ErrInvalidState is returned when the state of the redirect does not match
+// the state of the authorization request.
+var ErrInvalidState = errors.New("oauth: invalid state")
+
+// NewState returns a random state that protects the authorization against
+// CSRF. Save it, for example, in the session of the user, pass it in params
+// and check the redirect with CheckState.
+func NewState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CheckState returns ErrInvalidState if the state of the redirect URL does not
+// match the state.
+func CheckState(u *url.URL, state string) error {
+	v, err := redirectValues(u)
+	if err != nil {
+		return err
+	}
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(v.Get("state")), []byte(state)) != 1 {
+		return ErrInvalidState
+	}
+
+	return nil
+}
+
+// redirectValues returns the params of the redirect URL. The authorization
+// code flow passes them in the query, except for the default redirect URI,
+// where they are passed in the fragment.
+func redirectValues(u *url.URL) (url.Values, error) {
+	if u.RawQuery != "" {
+		v, err := url.ParseQuery(u.RawQuery)
+		if err != nil {
+			return v, err
+		}
+
+		if v.Get("code") != "" || v.Get("error") != "" || v.Get("state") != "" {
+			return v, nil
+		}
+	}
+
+	return url.ParseQuery(u.Fragment)
+}
@@ -0,0 +1,117 @@
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api/oauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewState(t *testing.T) {
+	t.Parallel()
+
+	s1, err := oauth.NewState()
+	assert.NoError(t, err)
+	assert.Len(t, s1, 22)
+
+	s2, err := oauth.NewState()
+	assert.NoError(t, err)
+	assert.NotEqual(t, s1, s2)
+}
+
+func TestCheckState(t *testing.T) {
+	t.Parallel()
+
+	f := func(rawURL, state string, wantErr error) {
+		t.Helper()
+
+		u, _ := url.Parse(rawURL)
+		assert.Equal(t, wantErr, oauth.CheckState(u, state))
+	}
+
+	f("https://example.com/callback?code=123&state=abc", "abc", nil)
+	f("https://oauth.vk.com/blank.html#code=123&state=abc", "abc", nil)
+	f("https://example.com/callback?code=123&state=abc", "abd", oauth.ErrInvalidState)
+	f("https://example.com/callback?code=123", "abc", oauth.ErrInvalidState)
+	f("https://example.com/callback?code=123", "", oauth.ErrInvalidState)
+}
+
+// rewriteTransport sends all requests to the server.
+type rewriteTransport struct {
+	server *httptest.Server
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, _ := url.Parse(t.server.URL)
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestAuthCodeFlowUser_Exchange(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/access_token", r.URL.Path)
+		assert.Equal(t, "https://example.com/callback", r.FormValue("redirect_uri"))
+		assert.Equal(t, "secret", r.FormValue("client_secret"))
+
+		if r.FormValue("code") != "good" {
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Code is invalid or expired."}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":86400,"user_id":1}`))
+	}))
+	defer server.Close()
+
+	acf := oauth.NewAuthCodeFlowUser(oauth.UserParams{
+		ClientID:    1,
+		RedirectURI: "https://example.com/callback",
+	}, "secret")
+	acf.Client = &http.Client{Transport: rewriteTransport{server}}
+
+	token, err := acf.Exchange(context.Background(), "good")
+	assert.NoError(t, err)
+	checkExpiry(t, token.ExpiresIn, &token.Expiry)
+	assert.Equal(t, &oauth.UserToken{AccessToken: "token", ExpiresIn: 86400, UserID: 1}, token)
+
+	u, _ := url.Parse("https://example.com/callback?code=good&state=abc")
+	token, err = acf.Token(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+
+	_, err = acf.Exchange(context.Background(), "bad")
+	assert.True(t, errors.Is(err, oauth.ErrInvalidGrant), err)
+
+	u, _ = url.Parse("https://example.com/callback?error=access_denied&error_reason=user_denied")
+	_, err = acf.Token(u)
+	assert.True(t, errors.Is(err, oauth.ErrUserDenied), err)
+}
+
+func TestAuthCodeFlowGroup_Exchange(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "https://oauth.vk.com/blank.html", r.FormValue("redirect_uri"))
+
+		_, _ = w.Write([]byte(`{"groups":[{"group_id":1,"access_token":"token"}],"expires_in":0}`))
+	}))
+	defer server.Close()
+
+	acf := oauth.NewAuthCodeFlowGroup(oauth.GroupParams{
+		ClientID: 1,
+		GroupIDs: []int{1},
+	}, "secret")
+	acf.Client = &http.Client{Transport: rewriteTransport{server}}
+
+	tokens, err := acf.Exchange(context.Background(), "good")
+	assert.NoError(t, err)
+	assert.Equal(t, "token", tokens.Groups[0].AccessToken)
+}
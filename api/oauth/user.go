@@ -2,11 +2,14 @@
 package oauth // import "github.com/SevereCloud/vksdk/v2/api/oauth"
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2/internal"
 )
@@ -18,6 +21,15 @@ type UserToken struct {
 	UserID      int    `json:"user_id"`
 	Email       string `json:"email,omitempty"`
 	State       string `json:"state,omitempty"`
+
+	// Expiry is the time when the token expires. It is zero if the token
+	// does not expire (with the offline scope).
+	Expiry time.Time `json:"-"`
+}
+
+// Expired returns true if the token has expired.
+func (t UserToken) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().After(t.Expiry)
 }
 
 // NewUserTokenFromJSON ...
@@ -35,6 +47,7 @@ func NewUserTokenFromJSON(data []byte) (*UserToken, error) {
 
 	var t UserToken
 	err = json.Unmarshal(data, &t)
+	t.Expiry = expiry(t.ExpiresIn)
 
 	return &t, err
 }
@@ -62,6 +75,7 @@ func NewUserTokenFromURL(u *url.URL) (*UserToken, error) {
 		State:       v.Get("state"),
 		UserID:      userID,
 		Email:       v.Get("email"),
+		Expiry:      expiry(expiresIn),
 	}
 
 	return t, nil
@@ -157,14 +171,20 @@ func (a AuthCodeFlowUser) URL() *url.URL {
 	return u
 }
 
-func (a AuthCodeFlowUser) buildRequest(code string) *http.Request {
+func (a AuthCodeFlowUser) buildRequest(ctx context.Context, code string) *http.Request {
 	q := &url.Values{}
 
 	q.Set("client_id", strconv.Itoa(a.params.ClientID))
 	q.Set("client_secret", a.clientSecret)
-	q.Set("redirect_uri", a.params.RedirectURI)
 	q.Set("code", code)
 
+	// the redirect URI must match the one of the authorization dialog
+	if a.params.RedirectURI == "" {
+		q.Set("redirect_uri", DefaultRedirectURI)
+	} else {
+		q.Set("redirect_uri", a.params.RedirectURI)
+	}
+
 	uReq := &url.URL{
 		Scheme:   scheme,
 		Host:     OAuthHost,
@@ -172,26 +192,34 @@ func (a AuthCodeFlowUser) buildRequest(code string) *http.Request {
 		RawQuery: q.Encode(),
 	}
 
-	req, _ := http.NewRequest("GET", uReq.String(), nil)
+	req, _ := http.NewRequestWithContext(ctx, "GET", uReq.String(), nil)
 	req.Header.Set("User-Agent", a.UserAgent)
 
 	return req
 }
 
-func (a AuthCodeFlowUser) request(code string) (*http.Response, error) {
-	req := a.buildRequest(code)
+func (a AuthCodeFlowUser) request(ctx context.Context, code string) (*http.Response, error) {
+	req := a.buildRequest(ctx, code)
 
 	return a.Client.Do(req)
 }
 
-// Token ...
+// Token exchanges the code from the redirect URL for the token.
+//
+// The code is taken from the query of the URL or, for the default redirect
+// URI, from the fragment. Errors of the redirect are returned as *Error.
 func (a AuthCodeFlowUser) Token(u *url.URL) (*UserToken, error) {
 	code, err := parseCode(u)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := a.request(code)
+	return a.Exchange(context.Background(), code)
+}
+
+// Exchange exchanges the code for the token.
+func (a AuthCodeFlowUser) Exchange(ctx context.Context, code string) (*UserToken, error) {
+	resp, err := a.request(ctx, code)
 	if err != nil {
 		return nil, err
 	}
@@ -223,11 +251,21 @@ type DirectAuthParams struct {
 
 	TestRedirectURI bool
 
+	// CodeHandler is called on the need_validation error with the type of
+	// validation and the mask of the phone. The request is repeated with
+	// the returned code of two-factor authentication. The handler also
+	// enables TwoFactorSupported.
+	CodeHandler func(validation ValidationType, phoneMask string) (code string, err error)
+
+	// CaptchaHandler is called on the need_captcha error. The request is
+	// repeated with the returned text from the img.
+	CaptchaHandler func(sid, img string) (key string, err error)
+
 	Client    *http.Client
 	UserAgent string
 }
 
-func buildDirectAuthRequest(p DirectAuthParams) *http.Request {
+func buildDirectAuthRequest(ctx context.Context, p DirectAuthParams) *http.Request {
 	q := &url.Values{}
 
 	q.Set("grant_type", "password")
@@ -272,7 +310,7 @@ func buildDirectAuthRequest(p DirectAuthParams) *http.Request {
 		RawQuery: q.Encode(),
 	}
 
-	req, _ := http.NewRequest("GET", uReq.String(), nil)
+	req, _ := http.NewRequestWithContext(ctx, "GET", uReq.String(), nil)
 
 	if p.UserAgent == "" {
 		p.UserAgent = internal.UserAgent
@@ -310,7 +348,52 @@ func buildDirectAuthRequest(p DirectAuthParams) *http.Request {
 //
 // See https://vk.com/dev/auth_direct
 func DirectAuth(p DirectAuthParams) (*UserToken, error) {
-	req := buildDirectAuthRequest(p)
+	return DirectAuthContext(context.Background(), p)
+}
+
+// DirectAuthMaxAttempts is the maximum number of requests of
+// DirectAuthContext, after which the last error is returned.
+const DirectAuthMaxAttempts = 5
+
+// DirectAuthContext is DirectAuth with the context. The errors of
+// two-factor authentication and captcha are handled by CodeHandler and
+// CaptchaHandler of the params, no more than DirectAuthMaxAttempts
+// requests are made.
+//
+//	p.CodeHandler = func(validation oauth.ValidationType, phoneMask string) (string, error) {
+//		return askCode(phoneMask)
+//	}
+func DirectAuthContext(ctx context.Context, p DirectAuthParams) (*UserToken, error) {
+	if p.CodeHandler != nil {
+		p.TwoFactorSupported = true
+	}
+
+	for attempt := 1; ; attempt++ {
+		token, err := directAuth(ctx, p)
+
+		var e *Error
+		if !errors.As(err, &e) || attempt >= DirectAuthMaxAttempts {
+			return token, err
+		}
+
+		switch {
+		case e.Type == ErrNeedValidation && p.CodeHandler != nil:
+			p.Code, err = p.CodeHandler(e.ValidationType, e.PhoneMask)
+		case e.Type == ErrNeedCaptcha && p.CaptchaHandler != nil:
+			p.CaptchaSID = e.CaptchaSID
+			p.CaptchaKey, err = p.CaptchaHandler(e.CaptchaSID, e.CaptchaImg)
+		default:
+			return nil, err
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func directAuth(ctx context.Context, p DirectAuthParams) (*UserToken, error) {
+	req := buildDirectAuthRequest(ctx, p)
 
 	if p.Client == nil {
 		p.Client = http.DefaultClient
@@ -1,14 +1,40 @@
 package oauth_test
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2/api/oauth"
 	"github.com/stretchr/testify/assert"
 )
 
+// checkExpiry checks and resets the expiry of the token.
+func checkExpiry(t *testing.T, expiresIn int, expiry *time.Time) {
+	t.Helper()
+
+	if expiresIn == 0 {
+		assert.True(t, expiry.IsZero())
+	} else {
+		assert.WithinDuration(t, time.Now().Add(time.Duration(expiresIn)*time.Second), *expiry, time.Minute)
+	}
+
+	*expiry = time.Time{}
+}
+
+func TestUserToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, oauth.UserToken{}.Expired())
+	assert.False(t, oauth.UserToken{Expiry: time.Now().Add(time.Hour)}.Expired())
+	assert.True(t, oauth.UserToken{Expiry: time.Now().Add(-time.Hour)}.Expired())
+}
+
 func TestParseJSON(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +42,8 @@ func TestParseJSON(t *testing.T) {
 		token, err := oauth.NewUserTokenFromJSON(data)
 		if err != nil {
 			assert.EqualError(t, err, wantErr)
+		} else {
+			checkExpiry(t, token.ExpiresIn, &token.Expiry)
 		}
 
 		assert.Equal(t, token, wantToken)
@@ -49,6 +77,8 @@ func TestParseURL(t *testing.T) {
 		token, err := oauth.NewUserTokenFromURL(u)
 		if err != nil {
 			assert.EqualError(t, err, wantErr)
+		} else {
+			checkExpiry(t, token.ExpiresIn, &token.Expiry)
 		}
 
 		assert.Equal(t, token, wantToken)
@@ -176,3 +206,92 @@ func TestDirectAuth(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestDirectAuthContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/token", r.URL.Path)
+		assert.Equal(t, "1", r.FormValue("2fa_supported"))
+
+		switch {
+		case r.FormValue("captcha_key") != "captcha":
+			_, _ = w.Write([]byte(`{"error":"need_captcha","captcha_sid":"123","captcha_img":"https://api.vk.com/captcha.php?sid=123"}`))
+		case r.FormValue("code") != "2fa":
+			_, _ = w.Write([]byte(`{"error":"need_validation","error_description":"sms sent",` +
+				`"validation_type":"2fa_sms","phone_mask":"+7 *** *** ** 12"}`))
+		default:
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":0,"user_id":1}`))
+		}
+	}))
+	defer server.Close()
+
+	p := oauth.DirectAuthParams{
+		ClientID:     1,
+		ClientSecret: "secret",
+		Username:     "user",
+		Password:     "password",
+		Client:       &http.Client{Transport: rewriteTransport{server}},
+		CodeHandler: func(validation oauth.ValidationType, phoneMask string) (string, error) {
+			assert.Equal(t, oauth.ValidationSMS, validation)
+			assert.Equal(t, "+7 *** *** ** 12", phoneMask)
+
+			return "2fa", nil
+		},
+		CaptchaHandler: func(sid, img string) (string, error) {
+			assert.Equal(t, "123", sid)
+			return "captcha", nil
+		},
+	}
+
+	token, err := oauth.DirectAuthContext(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+
+	errHandler := errors.New("handler")
+	p.CodeHandler = func(validation oauth.ValidationType, phoneMask string) (string, error) {
+		return "", errHandler
+	}
+
+	_, err = oauth.DirectAuthContext(context.Background(), p)
+	assert.ErrorIs(t, err, errHandler)
+
+	p.CaptchaHandler = nil
+
+	_, err = oauth.DirectAuthContext(context.Background(), p)
+	assert.True(t, errors.Is(err, oauth.ErrNeedCaptcha), err)
+}
+
+func TestDirectAuthContext_maxAttempts(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		_, _ = w.Write([]byte(`{"error":"need_captcha","captcha_sid":"123","captcha_img":"https://api.vk.com/captcha.php?sid=123"}`))
+	}))
+	defer server.Close()
+
+	var handled int
+
+	p := oauth.DirectAuthParams{
+		ClientID:     1,
+		ClientSecret: "secret",
+		Username:     "user",
+		Password:     "password",
+		Client:       &http.Client{Transport: rewriteTransport{server}},
+		CaptchaHandler: func(sid, img string) (string, error) {
+			handled++
+
+			// the same wrong answer every time
+			return "wrong", nil
+		},
+	}
+
+	_, err := oauth.DirectAuthContext(context.Background(), p)
+	assert.True(t, errors.Is(err, oauth.ErrNeedCaptcha), err)
+	assert.Equal(t, int32(oauth.DirectAuthMaxAttempts), atomic.LoadInt32(&requests))
+	assert.Equal(t, oauth.DirectAuthMaxAttempts-1, handled)
+}
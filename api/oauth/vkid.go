@@ -0,0 +1,107 @@
+package oauth // import "github.com/SevereCloud/vksdk/v2/api/oauth"
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// VK ID errors.
+var (
+	ErrInvalidPayload = errors.New("oauth: invalid VK ID payload")
+	ErrPayloadExpired = errors.New("oauth: VK ID payload has expired")
+)
+
+// SilentPayloadType is the type of VK ID payload with the silent token.
+const SilentPayloadType = "silent_token"
+
+// SilentPayloadUser struct.
+type SilentPayloadUser struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Avatar    string `json:"avatar"`
+	Phone     string `json:"phone"`
+}
+
+// SilentPayload is the payload that VK ID passes to the redirect URI after
+// the authorization. The silent token must be exchanged for the access token
+// by auth.exchangeSilentAuthToken.
+//
+// See https://id.vk.com/about/business/go/docs/ru/vkid/latest/vk-id/connection/start-integration/how-auth-works
+type SilentPayload struct {
+	Type              string            `json:"type"`
+	Auth              int               `json:"auth"`
+	User              SilentPayloadUser `json:"user"`
+	Token             string            `json:"token"`
+	TTL               int               `json:"ttl"`
+	UUID              string            `json:"uuid"`
+	Hash              string            `json:"hash"`
+	LoadExternalUsers bool              `json:"loadExternalUsers"`
+
+	// Expiry is the time when the silent token expires, calculated from
+	// TTL at the moment of parsing.
+	Expiry time.Time `json:"-"`
+}
+
+// ParseSilentPayload parses the payload param of the VK ID redirect URL.
+func ParseSilentPayload(u *url.URL) (*SilentPayload, error) {
+	raw := u.Query().Get("payload")
+	if raw == "" {
+		v, err := url.ParseQuery(u.Fragment)
+		if err != nil {
+			return nil, err
+		}
+
+		raw = v.Get("payload")
+	}
+
+	if raw == "" {
+		return nil, ErrInvalidPayload
+	}
+
+	return NewSilentPayloadFromJSON([]byte(raw))
+}
+
+// NewSilentPayloadFromJSON parses the VK ID payload.
+func NewSilentPayloadFromJSON(data []byte) (*SilentPayload, error) {
+	var p SilentPayload
+
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, ErrInvalidPayload
+	}
+
+	p.Expiry = expiry(p.TTL)
+
+	return &p, nil
+}
+
+// Expired returns true if the silent token has expired.
+func (p SilentPayload) Expired() bool {
+	return !p.Expiry.IsZero() && time.Now().After(p.Expiry)
+}
+
+// Validate checks the payload before the exchange of the silent token.
+//
+// The uuid is the value passed to VK ID at the start of the authorization,
+// it protects against CSRF like the state, so NewState can be used for it.
+// Validate returns ErrInvalidState if the uuid does not match,
+// ErrPayloadExpired if the silent token has expired and ErrInvalidPayload if
+// the payload does not contain the silent token.
+func (p SilentPayload) Validate(uuid string) error {
+	if p.Type != SilentPayloadType || p.Token == "" {
+		return ErrInvalidPayload
+	}
+
+	if uuid == "" || subtle.ConstantTimeCompare([]byte(p.UUID), []byte(uuid)) != 1 {
+		return ErrInvalidState
+	}
+
+	if p.Expired() {
+		return ErrPayloadExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package oauth_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api/oauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSilentPayload(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"type":"silent_token","auth":1,"user":{"id":1,"first_name":"Ivan"},"token":"silent","ttl":600,"uuid":"uuid"}`
+
+	f := func(u *url.URL, wantErr error) {
+		t.Helper()
+
+		p, err := oauth.ParseSilentPayload(u)
+		if !assert.ErrorIs(t, err, wantErr) || err != nil {
+			return
+		}
+
+		assert.Equal(t, "silent", p.Token)
+		assert.Equal(t, "uuid", p.UUID)
+		assert.Equal(t, 1, p.User.ID)
+		assert.Equal(t, "Ivan", p.User.FirstName)
+		assert.WithinDuration(t, time.Now().Add(600*time.Second), p.Expiry, time.Minute)
+		assert.False(t, p.Expired())
+	}
+
+	f(&url.URL{RawQuery: url.Values{"payload": {payload}}.Encode()}, nil)
+	f(&url.URL{Fragment: url.Values{"payload": {payload}}.Encode()}, nil)
+	f(&url.URL{RawQuery: "payload=%7B"}, oauth.ErrInvalidPayload)
+	f(&url.URL{RawQuery: "code=1"}, oauth.ErrInvalidPayload)
+}
+
+func TestSilentPayload_Validate(t *testing.T) {
+	t.Parallel()
+
+	f := func(p oauth.SilentPayload, uuid string, wantErr error) {
+		t.Helper()
+
+		assert.Equal(t, wantErr, p.Validate(uuid))
+	}
+
+	valid := oauth.SilentPayload{
+		Type:   oauth.SilentPayloadType,
+		Token:  "silent",
+		UUID:   "uuid",
+		Expiry: time.Now().Add(time.Minute),
+	}
+
+	f(valid, "uuid", nil)
+	f(valid, "other", oauth.ErrInvalidState)
+	f(valid, "", oauth.ErrInvalidState)
+
+	p := valid
+	p.Token = ""
+	f(p, "uuid", oauth.ErrInvalidPayload)
+
+	p = valid
+	p.Type = "access_token"
+	f(p, "uuid", oauth.ErrInvalidPayload)
+
+	p = valid
+	p.Expiry = time.Now().Add(-time.Minute)
+	f(p, "uuid", oauth.ErrPayloadExpired)
+}
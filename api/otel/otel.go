@@ -0,0 +1,90 @@
+/*
+Package otel implements OpenTelemetry tracing of VK API requests.
+
+Each request gets a span, which is a child of the span in the context of
+the request. Handlers of longpoll and callback receive the context with the
+span of the event, so requests made with this context are traced as its
+children:
+
+	otel.Instrument(vk, nil)
+
+	lp.MessageNew(func(ctx context.Context, obj events.MessageNewObject) {
+		_, err := vk.WithContext(ctx).MessagesSend(b.Params)
+	})
+*/
+package otel // import "github.com/SevereCloud/vksdk/v2/api/otel"
+
+import (
+	"errors"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer.
+const InstrumentationName = "github.com/SevereCloud/vksdk/v2/api"
+
+// Attribute keys.
+const (
+	MethodKey     = attribute.Key("vk.method")
+	ErrorCodeKey  = attribute.Key("vk.error.code")
+	RetryCountKey = attribute.Key("vk.retry_count")
+)
+
+// Tracer traces requests by the Middleware method.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a new Tracer. If tp is nil, the global tracer provider
+// is used.
+func NewTracer(tp trace.TracerProvider) *Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return &Tracer{
+		tracer: tp.Tracer(InstrumentationName),
+	}
+}
+
+// Instrument adds the middleware of the tracer to the VK.
+func Instrument(vk *api.VK, tp trace.TracerProvider) *Tracer {
+	t := NewTracer(tp)
+	vk.Use(t.Middleware)
+
+	return t
+}
+
+// Middleware implements api.Middleware. The context of the span is passed
+// to the next handler, so the HTTP client can continue the trace.
+func (t *Tracer) Middleware(req api.Request, next api.Doer) (api.Response, error) {
+	ctx, span := t.tracer.Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(MethodKey.String(req.Method)),
+	)
+	defer span.End()
+
+	req.Params[":context"] = ctx
+
+	resp, err := next.Do(req)
+
+	if resp.Attempts > 1 {
+		span.SetAttributes(RetryCountKey.Int(resp.Attempts - 1))
+	}
+
+	if err != nil {
+		var code api.ErrorType
+		if errors.As(err, &code) {
+			span.SetAttributes(ErrorCodeKey.Int(int(code)))
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return resp, err
+}
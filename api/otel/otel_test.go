@@ -0,0 +1,77 @@
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/otel"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrument(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/messages.send":
+			_, _ = w.Write([]byte(`{"error":{"error_code":7,"error_msg":"Permission denied"}}`))
+		case atomic.AddInt32(&calls, 1) == 1:
+			_, _ = w.Write([]byte(`{"error":{"error_code":6,"error_msg":"Too many requests per second"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":1}`))
+		}
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.Retry = api.Retry{
+		MaxAttempts: 2,
+		Min:         time.Millisecond,
+		Max:         time.Millisecond,
+		Codes:       []api.ErrorType{api.ErrTooMany},
+	}
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	otel.Instrument(vk, tp)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "event")
+
+	_, err := vk.WithContext(ctx).Request("users.get", nil)
+	assert.NoError(t, err)
+
+	_, err = vk.Request("messages.send", nil)
+	assert.Error(t, err)
+
+	parent.End()
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 3) {
+		get, send := spans[0], spans[1]
+
+		assert.Equal(t, "users.get", get.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), get.Parent().SpanID())
+		assert.Contains(t, get.Attributes(), otel.RetryCountKey.Int(1))
+		assert.Equal(t, codes.Unset, get.Status().Code)
+
+		assert.Equal(t, "messages.send", send.Name())
+		assert.False(t, send.Parent().IsValid())
+		assert.Contains(t, send.Attributes(), otel.ErrorCodeKey.Int(7))
+		assert.Equal(t, codes.Error, send.Status().Code)
+	}
+}
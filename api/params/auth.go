@@ -68,3 +68,29 @@ func (b *AuthRestoreBuilder) LastName(v string) *AuthRestoreBuilder {
 	b.Params["last_name"] = v
 	return b
 }
+
+// AuthExchangeSilentAuthTokenBuilder builder.
+//
+// Exchanges the silent token of VK ID for the access token of the user.
+//
+// https://id.vk.com/about/business/go/docs/ru/vkid/latest/vk-id/connection/api-integration/api-description
+type AuthExchangeSilentAuthTokenBuilder struct {
+	api.Params
+}
+
+// NewAuthExchangeSilentAuthTokenBuilder func.
+func NewAuthExchangeSilentAuthTokenBuilder() *AuthExchangeSilentAuthTokenBuilder {
+	return &AuthExchangeSilentAuthTokenBuilder{api.Params{}}
+}
+
+// Token silent token from the VK ID payload.
+func (b *AuthExchangeSilentAuthTokenBuilder) Token(v string) *AuthExchangeSilentAuthTokenBuilder {
+	b.Params["token"] = v
+	return b
+}
+
+// UUID from the VK ID payload.
+func (b *AuthExchangeSilentAuthTokenBuilder) UUID(v string) *AuthExchangeSilentAuthTokenBuilder {
+	b.Params["uuid"] = v
+	return b
+}
@@ -34,3 +34,15 @@ func TestAuthRestoreBuilder(t *testing.T) {
 	assert.Equal(t, b.Params["phone"], "text")
 	assert.Equal(t, b.Params["last_name"], "text")
 }
+
+func TestAuthExchangeSilentAuthTokenBuilder(t *testing.T) {
+	t.Parallel()
+
+	b := params.NewAuthExchangeSilentAuthTokenBuilder()
+
+	b.Token("text")
+	b.UUID("text")
+
+	assert.Equal(t, b.Params["token"], "text")
+	assert.Equal(t, b.Params["uuid"], "text")
+}
@@ -0,0 +1,103 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ParamsFrom returns the params built from fields of the struct v with
+// the vk tag. It panics if v is not a struct or a pointer to a struct.
+//
+//	type WallGet struct {
+//		OwnerID  int       `vk:"owner_id"`
+//		Count    int       `vk:"count,omitempty"`
+//		Extended bool      `vk:"extended"`
+//		Fields   []string  `vk:"fields,omitempty"`
+//		Since    time.Time `vk:"start_time,omitempty"`
+//	}
+//
+//	resp, err := vk.WallGet(api.ParamsFrom(WallGet{OwnerID: -1}))
+//
+// Fields without the tag and with the "-" tag are skipped. Fields with
+// the omitempty option and nil pointers are skipped if they have zero
+// values. Slices are joined with commas, bools are converted to 0 and 1,
+// time.Time is converted to unixtime. Untagged embedded structs are
+// flattened.
+func ParamsFrom(v interface{}) Params {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		panic("api: ParamsFrom of non-struct " + reflect.TypeOf(v).String())
+	}
+
+	params := Params{}
+	addStructParams(params, rv)
+
+	return params
+}
+
+func addStructParams(params Params, rv reflect.Value) {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		value := rv.Field(i)
+
+		tag, ok := field.Tag.Lookup("vk")
+		if !ok {
+			if field.Anonymous {
+				for value.Kind() == reflect.Ptr && !value.IsNil() {
+					value = value.Elem()
+				}
+
+				if value.Kind() == reflect.Struct {
+					addStructParams(params, value)
+				}
+			}
+
+			continue
+		}
+
+		name, opts := tag, ""
+		if j := strings.IndexByte(tag, ','); j >= 0 {
+			name, opts = tag[:j], tag[j+1:]
+		}
+
+		if name == "-" || name == "" || field.PkgPath != "" {
+			continue
+		}
+
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+
+			value = value.Elem()
+		}
+
+		if opts == "omitempty" && isEmptyValue(value) {
+			continue
+		}
+
+		if t, ok := value.Interface().(time.Time); ok {
+			params[name] = t.Unix()
+
+			continue
+		}
+
+		params[name] = value.Interface()
+	}
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsFrom(t *testing.T) {
+	t.Parallel()
+
+	type Base struct {
+		Fields []string `vk:"fields,omitempty"`
+	}
+
+	type Request struct {
+		Base
+		OwnerID  int       `vk:"owner_id"`
+		Count    int       `vk:"count,omitempty"`
+		Extended bool      `vk:"extended"`
+		Filter   *bool     `vk:"filter"`
+		Since    time.Time `vk:"start_time,omitempty"`
+		Skipped  string    `vk:"-"`
+		Untagged string
+		private  string    `vk:"private"`
+	}
+
+	f := func(v interface{}, want api.Params) {
+		t.Helper()
+
+		assert.Equal(t, want, api.ParamsFrom(v))
+	}
+
+	f(Request{OwnerID: -1}, api.Params{"owner_id": -1, "extended": false})
+
+	filter := true
+
+	f(&Request{
+		Base:     Base{Fields: []string{"photo_100", "sex"}},
+		OwnerID:  1,
+		Count:    10,
+		Extended: true,
+		Filter:   &filter,
+		Since:    time.Unix(1600000000, 0),
+		Skipped:  "skipped",
+		Untagged: "untagged",
+		private:  "private",
+	}, api.Params{
+		"fields":     []string{"photo_100", "sex"},
+		"owner_id":   1,
+		"count":      10,
+		"extended":   true,
+		"filter":     true,
+		"start_time": int64(1600000000),
+	})
+
+	f(Request{Base: Base{Fields: []string{}}}, api.Params{"owner_id": 0, "extended": false})
+
+	assert.Equal(t, "photo_100,sex", api.FmtValue([]string{"photo_100", "sex"}, 0))
+	assert.Panics(t, func() { api.ParamsFrom(1) })
+}
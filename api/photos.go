@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/v2/api"
 
 import (
+	"strings"
+
 	"github.com/SevereCloud/vksdk/v2/object"
 )
 
@@ -548,6 +550,17 @@ func (vk *VK) PhotosSaveMarketPhoto(params Params) (response PhotosSaveMarketPho
 // PhotosSaveMessagesPhotoResponse struct.
 type PhotosSaveMessagesPhotoResponse []object.PhotosPhoto
 
+// ToAttachment returns the attachment of the saved photos for
+// messages.send, with the access key if it is set.
+func (resp PhotosSaveMessagesPhotoResponse) ToAttachment() string {
+	attachments := make([]string, len(resp))
+	for i, photo := range resp {
+		attachments[i] = attachment("photo", photo.OwnerID, photo.ID, photo.AccessKey)
+	}
+
+	return strings.Join(attachments, ",")
+}
+
 // PhotosSaveMessagesPhoto saves a photo after being successfully.
 //
 // https://vk.com/dev/photos.saveMessagesPhoto
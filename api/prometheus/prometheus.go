@@ -0,0 +1,126 @@
+/*
+Package prometheus implements the Prometheus collector of VK API metrics.
+
+	collector, err := prometheus.Register(prom.DefaultRegisterer, vk)
+*/
+package prometheus // import "github.com/SevereCloud/vksdk/v2/api/prometheus"
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace of metrics.
+const Namespace = "vksdk_api"
+
+// Collector implements prometheus.Collector. Requests are observed by
+// the Middleware method.
+//
+// Exposed metrics:
+//
+//	vksdk_api_requests_total{method,result}
+//	vksdk_api_request_duration_seconds{method}
+//	vksdk_api_errors_total{method,code}
+//	vksdk_api_limiter_queue
+type Collector struct {
+	requests        *prom.CounterVec
+	requestDuration *prom.HistogramVec
+	errors          *prom.CounterVec
+	queue           prom.GaugeFunc
+}
+
+// NewCollector returns a new Collector. The limiter_queue metric reports
+// the number of requests waiting for the rate limit of vk. The constant
+// labels are added to all metrics.
+func NewCollector(vk *api.VK, constLabels prom.Labels) *Collector {
+	return &Collector{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "requests_total",
+			Help:        "Total number of API requests.",
+			ConstLabels: constLabels,
+		}, []string{"method", "result"}),
+		requestDuration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace:   Namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of API requests, including waiting for the rate limit and retries.",
+			ConstLabels: constLabels,
+			Buckets:     prom.DefBuckets,
+		}, []string{"method"}),
+		errors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "errors_total",
+			Help:        "Total number of API errors by code. Errors without a code have the code 0.",
+			ConstLabels: constLabels,
+		}, []string{"method", "code"}),
+		queue: prom.NewGaugeFunc(prom.GaugeOpts{
+			Namespace:   Namespace,
+			Name:        "limiter_queue",
+			Help:        "Number of requests waiting for the rate limit.",
+			ConstLabels: constLabels,
+		}, func() float64 {
+			return float64(vk.QueueLen())
+		}),
+	}
+}
+
+// Register creates a collector for the VK, registers it and adds its
+// middleware to the VK.
+func Register(reg prom.Registerer, vk *api.VK) (*Collector, error) {
+	c := NewCollector(vk, nil)
+
+	if err := reg.Register(c); err != nil {
+		return nil, err
+	}
+
+	vk.Use(c.Middleware)
+
+	return c, nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requests.Describe(ch)
+	c.requestDuration.Describe(ch)
+	c.errors.Describe(ch)
+	c.queue.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requests.Collect(ch)
+	c.requestDuration.Collect(ch)
+	c.errors.Collect(ch)
+	c.queue.Collect(ch)
+}
+
+// Middleware implements api.Middleware.
+func (c *Collector) Middleware(req api.Request, next api.Doer) (api.Response, error) {
+	start := time.Now()
+	resp, err := next.Do(req)
+
+	c.Observe(req.Method, time.Since(start), err)
+
+	return resp, err
+}
+
+// Observe records the request of the method.
+func (c *Collector) Observe(method string, d time.Duration, err error) {
+	result := "ok"
+
+	if err != nil {
+		result = "error"
+
+		var code api.ErrorType
+
+		errors.As(err, &code)
+		c.errors.WithLabelValues(method, strconv.Itoa(int(code))).Inc()
+	}
+
+	c.requests.WithLabelValues(method, result).Inc()
+	c.requestDuration.WithLabelValues(method).Observe(d.Seconds())
+}
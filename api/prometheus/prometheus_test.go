@@ -0,0 +1,54 @@
+package prometheus_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/api/prometheus"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("users.get", []int{})
+	fake.OnError("messages.send", api.ErrPermission, "Permission denied")
+
+	vk := fake.VK()
+	reg := prom.NewRegistry()
+
+	_, err := prometheus.Register(reg, vk)
+	assert.NoError(t, err)
+
+	_, err = prometheus.Register(reg, vk)
+	assert.Error(t, err)
+
+	_, err = vk.Request("users.get", nil)
+	assert.NoError(t, err)
+
+	_, err = vk.Request("messages.send", nil)
+	assert.Error(t, err)
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP vksdk_api_errors_total Total number of API errors by code. Errors without a code have the code 0.
+# TYPE vksdk_api_errors_total counter
+vksdk_api_errors_total{code="7",method="messages.send"} 1
+# HELP vksdk_api_requests_total Total number of API requests.
+# TYPE vksdk_api_requests_total counter
+vksdk_api_requests_total{method="messages.send",result="error"} 1
+vksdk_api_requests_total{method="users.get",result="ok"} 1
+# HELP vksdk_api_limiter_queue Number of requests waiting for the rate limit.
+# TYPE vksdk_api_limiter_queue gauge
+vksdk_api_limiter_queue 0
+`), "vksdk_api_errors_total", "vksdk_api_requests_total", "vksdk_api_limiter_queue")
+	assert.NoError(t, err)
+
+	count, err := testutil.GatherAndCount(reg, "vksdk_api_request_duration_seconds")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,68 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ErrProxyScheme is returned when the scheme of the proxy URL is not
+// supported.
+var ErrProxyScheme = errors.New("api: unsupported proxy scheme")
+
+// ParseProxyURL parses the proxy URL. The http, https and socks5 schemes are
+// supported.
+func ParseProxyURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+		return u, nil
+	default:
+		return nil, ErrProxyScheme
+	}
+}
+
+// ProxyClient returns a copy of the client whose requests go through the
+// proxy. The transport of the client is cloned if it is *http.Transport,
+// otherwise http.DefaultTransport is cloned. A nil client means
+// http.DefaultClient.
+func ProxyClient(client *http.Client, proxyURL *url.URL) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base, ok := client.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport := base.Clone()
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	c := *client
+	c.Transport = transport
+
+	return &c
+}
+
+// SetProxy sets the HTTP, HTTPS or SOCKS5 proxy of requests, including
+// uploads:
+//
+//	err := vk.SetProxy("socks5://127.0.0.1:9050")
+//
+// It replaces vk.Client with a copy whose transport uses the proxy, so
+// http.DefaultClient is not modified.
+func (vk *VK) SetProxy(rawURL string) error {
+	u, err := ParseProxyURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	vk.Client = ProxyClient(vk.Client, u)
+
+	return nil
+}
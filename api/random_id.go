@@ -0,0 +1,72 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+)
+
+// RandomID returns a random random_id for messages.send. It uses
+// crypto/rand, so concurrent senders do not collide.
+//
+// MessagesSend, MessagesSendPeerIDs and MessagesSendSticker set it if
+// random_id is not passed.
+func RandomID() int {
+	var b [4]byte
+
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+
+	return int(binary.BigEndian.Uint32(b[:]) & math.MaxInt32)
+}
+
+// ContentRandomID returns random_id derived from params of the message,
+// except for the access token and the version. VK API does not send the
+// same message again if random_id has been used in the conversation
+// within an hour, so retries of the request cannot duplicate the message:
+//
+//	b := params.NewMessagesSendBuilder()
+//	b.PeerID(peerID)
+//	b.Message("Hello")
+//	b.RandomID(api.ContentRandomID(b.Params))
+func ContentRandomID(params Params) int {
+	keys := make([]string, 0, len(params))
+
+	for key := range params {
+		switch {
+		case key == "access_token", key == "v", key == "random_id":
+		case strings.HasPrefix(key, ":"):
+		default:
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	h := fnv.New32a()
+
+	for _, key := range keys {
+		_, _ = h.Write([]byte(key))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(FmtValue(params[key], 0)))
+		_, _ = h.Write([]byte{0})
+	}
+
+	return int(h.Sum32() & math.MaxInt32)
+}
+
+// randomIDParams returns params with the random random_id if it is not in
+// sliceParams. They must be passed before sliceParams.
+func randomIDParams(sliceParams ...Params) Params {
+	for _, params := range sliceParams {
+		if _, ok := params["random_id"]; ok {
+			return Params{}
+		}
+	}
+
+	return Params{"random_id": RandomID()}
+}
@@ -0,0 +1,67 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomID(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[int]bool)
+
+	for i := 0; i < 100; i++ {
+		id := api.RandomID()
+		assert.GreaterOrEqual(t, id, 0)
+		assert.False(t, seen[id])
+
+		seen[id] = true
+	}
+}
+
+func TestContentRandomID(t *testing.T) {
+	t.Parallel()
+
+	id := api.ContentRandomID(api.Params{"peer_id": 1, "message": "hello"})
+	assert.GreaterOrEqual(t, id, 0)
+
+	assert.Equal(t, id, api.ContentRandomID(api.Params{
+		"message":      "hello",
+		"peer_id":      "1",
+		"access_token": "token",
+		"random_id":    10,
+	}))
+	assert.NotEqual(t, id, api.ContentRandomID(api.Params{"peer_id": 2, "message": "hello"}))
+	assert.NotEqual(t, id, api.ContentRandomID(api.Params{"peer_id": 1, "message": "hello!"}))
+	assert.NotEqual(t, id, api.ContentRandomID(api.Params{"peer_id": 1, "message": "hello", "dont_parse_links": true}))
+}
+
+func TestVK_MessagesSend_randomID(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("messages.send", 1)
+	fake.On("messages.sendSticker", 1)
+
+	vk := fake.VK()
+
+	_, err := vk.MessagesSend(api.Params{"peer_id": 1})
+	assert.NoError(t, err)
+
+	_, err = vk.MessagesSend(api.Params{"peer_id": 1, "random_id": 0})
+	assert.NoError(t, err)
+
+	_, err = vk.MessagesSendSticker(api.Params{"peer_id": 1})
+	assert.NoError(t, err)
+
+	calls := fake.Calls("messages.send")
+	if assert.Len(t, calls, 2) {
+		assert.NotNil(t, calls[0].Params["random_id"])
+		assert.Equal(t, 0, calls[1].Params["random_id"])
+	}
+
+	assert.NotNil(t, fake.Calls("messages.sendSticker")[0].Params["random_id"])
+}
@@ -0,0 +1,84 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/internal"
+)
+
+// DefaultRetryCodes are the codes of errors that are retried by default:
+// too many requests per second. Unknown error and internal server error
+// are not retried by default, because the method could be executed, and
+// a retry of messages.send or wall.post would repeat it. They can be added
+// to Retry.Codes for idempotent methods.
+var DefaultRetryCodes = []ErrorType{ErrTooMany} // nolint:gochecknoglobals
+
+// Retry configures retries of requests that failed with retryable errors.
+//
+// The delay before the n-th retry is a random duration between zero and
+// Min * 2^(n-1), but no more than Max (exponential backoff with full jitter).
+type Retry struct {
+	// MaxAttempts is the maximum number of attempts per request.
+	// Zero or one disables retries.
+	MaxAttempts int
+	Min         time.Duration
+	Max         time.Duration
+
+	// Codes are the codes of retryable errors. If nil, DefaultRetryCodes
+	// are used.
+	Codes []ErrorType
+}
+
+// Delay returns the delay before the attempt.
+func (r Retry) Delay(attempt int) time.Duration {
+	return internal.BackoffDelay(attempt, r.Min, r.Max)
+}
+
+// retryable returns true if the error with the code is retryable.
+func (r Retry) retryable(code ErrorType) bool {
+	codes := r.Codes
+	if codes == nil {
+		codes = DefaultRetryCodes
+	}
+
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryAfter returns the delay of the Retry-After header, which contains
+// seconds or an HTTP date. It returns zero if the header is not set or
+// invalid.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(v); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// retryStatus returns true if the request with the status is retried: 429
+// Too Many Requests and 5xx with the Retry-After header.
+func retryStatus(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError && resp.Header.Get("Retry-After") != ""
+}
@@ -0,0 +1,183 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry_Delay(t *testing.T) {
+	t.Parallel()
+
+	r := api.Retry{Min: 100 * time.Millisecond, Max: time.Second}
+
+	f := func(attempt int, max time.Duration) {
+		t.Helper()
+
+		for i := 0; i < 100; i++ {
+			d := r.Delay(attempt)
+			assert.GreaterOrEqual(t, int64(d), int64(0))
+			assert.Less(t, int64(d), int64(max))
+		}
+	}
+
+	f(1, 100*time.Millisecond)
+	f(2, 200*time.Millisecond)
+	f(3, 400*time.Millisecond)
+	f(5, time.Second)
+	f(100, time.Second)
+
+	assert.Equal(t, time.Duration(0), api.Retry{}.Delay(1))
+}
+
+func TestVK_Retry(t *testing.T) {
+	t.Parallel()
+
+	f := func(code int, failures int32, retry api.Retry, wantCalls int32, wantErr error) {
+		t.Helper()
+
+		var calls int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			if atomic.AddInt32(&calls, 1) <= failures {
+				_, _ = w.Write([]byte(`{"error":{"error_code":` + strconv.Itoa(code) + `,"error_msg":"test"}}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"response":1}`))
+		}))
+		defer server.Close()
+
+		vk := api.NewVK("")
+		vk.MethodURL = server.URL + "/"
+		vk.Client = server.Client()
+		vk.Retry = retry
+
+		_, err := vk.Request("test", nil)
+		if wantErr == nil {
+			assert.NoError(t, err)
+		} else {
+			assert.True(t, errors.Is(err, wantErr), err)
+		}
+
+		assert.Equal(t, wantCalls, atomic.LoadInt32(&calls))
+	}
+
+	retry := api.Retry{MaxAttempts: 3, Min: time.Millisecond, Max: 10 * time.Millisecond}
+
+	f(int(api.ErrTooMany), 2, retry, 3, nil)
+	f(int(api.ErrTooMany), 5, retry, 3, api.ErrTooMany)
+	f(int(api.ErrAuth), 2, retry, 1, api.ErrAuth)
+	f(int(api.ErrTooMany), 2, api.Retry{}, 1, api.ErrTooMany)
+
+	// unknown and internal server errors are not retried by default
+	f(int(api.ErrServer), 2, retry, 1, api.ErrServer)
+	f(int(api.ErrUnknown), 2, retry, 1, api.ErrUnknown)
+
+	retry.Codes = []api.ErrorType{api.ErrTooMany, api.ErrUnknown, api.ErrServer}
+
+	f(int(api.ErrServer), 2, retry, 3, nil)
+	f(int(api.ErrUnknown), 2, retry, 3, nil)
+	f(int(api.ErrServer), 5, retry, 3, api.ErrServer)
+	f(int(api.ErrAuth), 1, api.Retry{MaxAttempts: 2, Codes: []api.ErrorType{api.ErrAuth}}, 2, nil)
+}
+
+func TestVK_RetryContext(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"error":{"error_code":6,"error_msg":"test"}}`)
+	vk.Retry = api.Retry{MaxAttempts: 3, Min: time.Minute, Max: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := vk.WithContext(ctx).Request("test", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestVK_RetryDeadline(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"error":{"error_code":6,"error_msg":"test"}}`)
+	vk.Retry = api.Retry{MaxAttempts: 3, Min: time.Minute, Max: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+
+	// the retry after the deadline is not waited for
+	_, err := vk.WithContext(ctx).Request("test", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestVK_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":1}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	start := time.Now()
+
+	resp, err := vk.RequestResponse("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Attempts)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestVK_Retry429(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+	vk.Retry = api.Retry{MaxAttempts: 3, Min: time.Millisecond, Max: time.Millisecond}
+
+	_, err := vk.Request("test", nil)
+	assert.ErrorIs(t, err, &api.StatusError{StatusCode: http.StatusTooManyRequests})
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// 5xx without Retry-After is not retried
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	_, err = vk.Request("test", nil)
+	assert.IsType(t, &api.InvalidContentType{}, err)
+}
@@ -0,0 +1,143 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Scope is a bit mask of access permissions. The Scope... constants of
+// the oauth package can be used as Scope.
+type Scope int
+
+// names of the permissions of user tokens.
+var userScopeNames = map[Scope]string{ // nolint:gochecknoglobals
+	1 << 0:  "notify",
+	1 << 1:  "friends",
+	1 << 2:  "photos",
+	1 << 3:  "audio",
+	1 << 4:  "video",
+	1 << 6:  "stories",
+	1 << 7:  "pages",
+	1 << 8:  "menu",
+	1 << 9:  "wallmenu",
+	1 << 10: "status",
+	1 << 11: "notes",
+	1 << 12: "messages",
+	1 << 13: "wall",
+	1 << 15: "ads",
+	1 << 16: "offline",
+	1 << 17: "docs",
+	1 << 18: "groups",
+	1 << 19: "notifications",
+	1 << 20: "stats",
+	1 << 22: "email",
+	1 << 23: "adsweb",
+	1 << 24: "leads",
+	1 << 25: "group_messages",
+	1 << 26: "exchange",
+	1 << 27: "market",
+	1 << 28: "phone",
+}
+
+// names of the permissions of community tokens.
+var groupScopeNames = map[Scope]string{ // nolint:gochecknoglobals
+	1 << 0:  "stories",
+	1 << 2:  "photos",
+	1 << 6:  "app_widget",
+	1 << 12: "messages",
+	1 << 17: "docs",
+	1 << 18: "manage",
+}
+
+// ScopeError is returned by CheckScopes when the token has not all the
+// required permissions.
+type ScopeError struct {
+	TokenType TokenType
+
+	// Missing contains the missing permissions, one bit each.
+	Missing []Scope
+}
+
+// Error returns the message of a ScopeError.
+func (e ScopeError) Error() string {
+	names := userScopeNames
+	if e.TokenType == TokenGroup {
+		names = groupScopeNames
+	}
+
+	missing := make([]string, len(e.Missing))
+
+	for i, s := range e.Missing {
+		if name, ok := names[s]; ok {
+			missing[i] = name
+		} else {
+			missing[i] = strconv.Itoa(int(s))
+		}
+	}
+
+	return "api: missing scopes: " + strings.Join(missing, ", ")
+}
+
+// CheckScopes checks that the token has all the permissions, for example,
+// at the start of a bot:
+//
+//	err := vk.CheckScopes(oauth.ScopeGroupMessages, oauth.ScopeGroupPhotos)
+//
+// The permissions of community tokens are requested with
+// groups.getTokenPermissions, of user tokens with account.getAppPermissions.
+// Service tokens have no permissions. If the type of tokens is unknown, it
+// is detected with DetectTokenType.
+//
+// If some permissions are missing, *ScopeError is returned.
+func (vk *VK) CheckScopes(scopes ...Scope) error {
+	t := vk.TokenType()
+	if t == TokenUnknown {
+		var err error
+
+		t, err = vk.DetectTokenType()
+		if err != nil {
+			return err
+		}
+	}
+
+	var granted Scope
+
+	switch t {
+	case TokenGroup:
+		resp, err := vk.GroupsGetTokenPermissions(nil)
+		if err != nil {
+			return err
+		}
+
+		granted = Scope(resp.Mask)
+	case TokenUser:
+		mask, err := vk.AccountGetAppPermissions(nil)
+		if err != nil {
+			return err
+		}
+
+		granted = Scope(mask)
+	case TokenService, TokenUnknown:
+	}
+
+	var required Scope
+	for _, s := range scopes {
+		required |= s
+	}
+
+	missing := required &^ granted
+	if missing == 0 {
+		return nil
+	}
+
+	e := &ScopeError{TokenType: t}
+
+	for bit := Scope(1); missing != 0; bit <<= 1 {
+		if missing&bit != 0 {
+			e.Missing = append(e.Missing, bit)
+			missing &^= bit
+		}
+	}
+
+	return e
+}
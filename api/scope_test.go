@@ -0,0 +1,42 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/api/oauth"
+	"github.com/SevereCloud/vksdk/v2/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_CheckScopes(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("groups.getTokenPermissions", object.GroupsTokenPermissions{
+		Mask: oauth.ScopeGroupMessages | oauth.ScopeGroupPhotos,
+	})
+	fake.On("account.getAppPermissions", oauth.ScopeUserFriends)
+
+	vk := fake.VK()
+
+	vk.SetTokenType(api.TokenGroup)
+	assert.NoError(t, vk.CheckScopes(oauth.ScopeGroupMessages, oauth.ScopeGroupPhotos))
+
+	err := vk.CheckScopes(oauth.ScopeGroupMessages, oauth.ScopeGroupDocs|oauth.ScopeGroupManage)
+	assert.EqualError(t, err, "api: missing scopes: docs, manage")
+
+	var scopeErr *api.ScopeError
+	if assert.ErrorAs(t, err, &scopeErr) {
+		assert.Equal(t, []api.Scope{oauth.ScopeGroupDocs, oauth.ScopeGroupManage}, scopeErr.Missing)
+	}
+
+	vk.SetTokenType(api.TokenUser)
+	assert.NoError(t, vk.CheckScopes(oauth.ScopeUserFriends))
+	assert.EqualError(t, vk.CheckScopes(oauth.ScopeUserWall), "api: missing scopes: wall")
+
+	vk.SetTokenType(api.TokenService)
+	assert.NoError(t, vk.CheckScopes())
+	assert.EqualError(t, vk.CheckScopes(1<<30), "api: missing scopes: 1073741824")
+}
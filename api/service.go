@@ -0,0 +1,105 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// ServiceTokenMethods are the methods and the sections, ending with a dot,
+// that are available with a service token. It can be extended for new
+// methods.
+//
+// See https://vk.com/dev/access_token?f=3.%20Service%20token
+var ServiceTokenMethods = map[string]bool{ // nolint:gochecknoglobals
+	"secure.":    true,
+	"database.":  true,
+	"streaming.": true,
+	"utils.":     true,
+	"widgets.":   true,
+
+	"apps.get":                     true,
+	"apps.getCatalog":              true,
+	"apps.getFriendsList":          true,
+	"apps.getLeaderboard":          true,
+	"apps.getScopes":               true,
+	"apps.getScore":                true,
+	"auth.exchangeSilentAuthToken": true,
+	"board.getComments":            true,
+	"board.getTopics":              true,
+	"execute":                      true,
+	"friends.get":                  true,
+	"groups.getById":               true,
+	"groups.getMembers":            true,
+	"groups.isMember":              true,
+	"likes.getList":                true,
+	"market.get":                   true,
+	"market.getById":               true,
+	"photos.get":                   true,
+	"photos.getAlbums":             true,
+	"photos.getById":               true,
+	"users.get":                    true,
+	"users.getFollowers":           true,
+	"users.getSubscriptions":       true,
+	"video.getAlbumsByVideo":       true,
+	"wall.get":                     true,
+	"wall.getById":                 true,
+	"wall.getComment":              true,
+	"wall.getComments":             true,
+	"wall.getReposts":              true,
+	"wall.search":                  true,
+}
+
+// NewServiceVK returns a new VK with the service tokens. Requests of methods
+// that are not in ServiceTokenMethods return *ServiceTokenError without
+// requests to VK API.
+func NewServiceVK(tokens ...string) *VK {
+	vk := NewVK(tokens...)
+	vk.SetTokenType(TokenService)
+
+	return vk
+}
+
+// checkServiceMethod returns *ServiceTokenError if the method is not
+// available with the service token.
+func (vk *VK) checkServiceMethod(method string) error {
+	if vk.tokenType != TokenService || ServiceTokenMethods[method] {
+		return nil
+	}
+
+	if i := strings.IndexByte(method, '.'); i >= 0 && ServiceTokenMethods[method[:i+1]] {
+		return nil
+	}
+
+	return &ServiceTokenError{Method: method}
+}
+
+// TokenStore stores tokens outside of the process, for example, in Vault
+// or KMS, instead of environment variables.
+type TokenStore interface {
+	// Load returns the token with the key.
+	Load(ctx context.Context, key string) (string, error)
+	// Save saves the token with the key.
+	Save(ctx context.Context, key, token string) error
+}
+
+// NewStoreTokenSource returns the TokenSource that loads the token with
+// the key from the store and caches it for the ttl. The zero ttl means that
+// the token is loaded once.
+//
+//	vk.TokenSource = api.NewStoreTokenSource(vault, "vk/service", time.Minute)
+func NewStoreTokenSource(store TokenStore, key string, ttl time.Duration) *RefreshTokenSource {
+	return NewRefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+		token, err := store.Load(ctx, key)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		var expiry time.Time
+		if ttl > 0 {
+			expiry = time.Now().Add(ttl)
+		}
+
+		return token, expiry, nil
+	})
+}
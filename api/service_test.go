@@ -0,0 +1,107 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewServiceVK(t *testing.T) {
+	t.Parallel()
+
+	vk := newTestVK(t, `{"response":[]}`)
+	vk.SetTokenType(api.TokenService)
+
+	f := func(method string, wantErr bool) {
+		t.Helper()
+
+		_, err := vk.Request(method, nil)
+
+		var e *api.ServiceTokenError
+		if wantErr {
+			if assert.True(t, errors.As(err, &e), err) {
+				assert.Equal(t, method, e.Method)
+				assert.EqualError(t, e, "api: "+method+" is not available with a service token")
+			}
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	f("users.get", false)
+	f("secure.sendNotification", false)
+	f("database.getCountries", false)
+	f("messages.send", true)
+	f("secure", true)
+
+	vk.SetTokenType(api.TokenUser)
+	f("messages.send", false)
+
+	assert.Equal(t, api.TokenService, api.NewServiceVK("token").TokenType())
+	assert.Equal(t, api.LimitUserToken, api.NewServiceVK("token").Limit)
+}
+
+type memoryStore map[string]string
+
+func (s memoryStore) Load(ctx context.Context, key string) (string, error) {
+	token, ok := s[key]
+	if !ok {
+		return "", errTokenTest
+	}
+
+	return token, nil
+}
+
+func (s memoryStore) Save(ctx context.Context, key, token string) error {
+	s[key] = token
+	return nil
+}
+
+func TestNewStoreTokenSource(t *testing.T) {
+	t.Parallel()
+
+	store := memoryStore{}
+	ctx := context.Background()
+
+	source := api.NewStoreTokenSource(store, "vk", time.Hour)
+
+	_, err := source.Token(ctx)
+	assert.True(t, errors.Is(err, errTokenTest))
+
+	assert.NoError(t, store.Save(ctx, "vk", "token1"))
+
+	token, err := source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	// cached
+	assert.NoError(t, store.Save(ctx, "vk", "token2"))
+
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	source.Reset()
+
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token2", token)
+
+	// expired
+	source = api.NewStoreTokenSource(store, "vk", time.Nanosecond)
+
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token2", token)
+
+	time.Sleep(time.Millisecond)
+	assert.NoError(t, store.Save(ctx, "vk", "token3"))
+
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token3", token)
+}
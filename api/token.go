@@ -0,0 +1,112 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenSource returns access tokens. It allows long-lived services to
+// refresh or replace tokens without recreating VK.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary functions as
+// TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// RefreshTokenSource caches the token and refreshes it when it expires.
+//
+//	vk.TokenSource = api.NewRefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+//		resp, err := adminVK.WithContext(ctx).AuthRefreshToken(api.Params{"receipt": receipt})
+//		return resp.Token, time.Now().Add(time.Hour), err
+//	})
+type RefreshTokenSource struct {
+	refresh func(ctx context.Context) (token string, expiry time.Time, err error)
+
+	mux    sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewRefreshTokenSource returns a new RefreshTokenSource. The refresh
+// returns a new token and the time when it expires. The zero expiry means
+// that the token does not expire.
+func NewRefreshTokenSource(
+	refresh func(ctx context.Context) (token string, expiry time.Time, err error),
+) *RefreshTokenSource {
+	return &RefreshTokenSource{refresh: refresh}
+}
+
+// Token implements TokenSource.
+func (s *RefreshTokenSource) Token(ctx context.Context) (string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	token, expiry, err := s.refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.expiry = token, expiry
+
+	return token, nil
+}
+
+// Reset drops the cached token, so the next call of Token refreshes it.
+func (s *RefreshTokenSource) Reset() {
+	s.mux.Lock()
+	s.token = ""
+	s.mux.Unlock()
+}
+
+// token returns the token of the request from the TokenSource or the next
+// token of vk.
+func (vk *VK) token(sliceParams ...Params) (string, error) {
+	if vk.TokenSource == nil {
+		return vk.getToken(), nil
+	}
+
+	ctx := vk.context()
+
+	for _, params := range sliceParams {
+		if c, ok := params[":context"].(context.Context); ok {
+			ctx = c
+		}
+	}
+
+	return vk.TokenSource.Token(ctx)
+}
+
+// SetToken replaces the tokens of vk with the token. It is safe to call
+// concurrently with requests, so a job that rotates secrets can change the
+// token without recreating vk and longpoll that uses it:
+//
+//	vk.SetToken(newToken)
+//
+// Copies of vk made before, e.g. by WithContext, keep the old tokens. The
+// TokenSource, if it is set, is used instead of the tokens.
+func (vk *VK) SetToken(token string) {
+	vk.setTokens([]string{token})
+}
+
+// tokens returns the tokens set by NewVK or SetToken.
+func (vk *VK) tokens() []string {
+	tokens, _ := vk.accessTokens.Load().([]string)
+
+	return tokens
+}
+
+func (vk *VK) setTokens(tokens []string) {
+	vk.accessTokens.Store(tokens)
+}
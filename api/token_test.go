@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_TokenSource(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":"` + r.PostFormValue("access_token") + `"}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK()
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	refreshes := 0
+	expiry := time.Now().Add(time.Hour)
+	fail := false
+
+	source := api.NewRefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+		if fail {
+			return "", time.Time{}, errTokenTest
+		}
+
+		refreshes++
+
+		return "token" + string(rune('0'+refreshes)), expiry, nil
+	})
+	vk.TokenSource = source
+
+	f := func(want string) {
+		t.Helper()
+
+		var token string
+
+		assert.NoError(t, vk.RequestUnmarshal("test", &token, nil))
+		assert.Equal(t, want, token)
+	}
+
+	f("token1")
+	f("token1")
+
+	source.Reset()
+	f("token2")
+
+	var res interface{}
+
+	assert.NoError(t, vk.Execute(`return 1;`, &res))
+	assert.Equal(t, "token2", res)
+
+	// expired
+	expiry = time.Now().Add(-time.Hour)
+
+	source.Reset()
+	f("token3")
+	f("token4")
+
+	// the error of the source
+	fail = true
+
+	_, err := vk.Request("test", nil)
+	assert.True(t, errors.Is(err, errTokenTest))
+
+	// the context of the request is passed to the source
+	ctx := context.WithValue(context.Background(), struct{}{}, "token")
+	vk.TokenSource = api.TokenSourceFunc(func(ctx context.Context) (string, error) {
+		return ctx.Value(struct{}{}).(string), nil
+	})
+
+	var token string
+
+	assert.NoError(t, vk.WithContext(ctx).RequestUnmarshal("test", &token, nil))
+	assert.Equal(t, "token", token)
+}
+
+var errTokenTest = errors.New("token test")
+
+func TestVK_SetToken(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("users.get", []int{})
+
+	vk := fake.VK("old1", "old2")
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vk.Request("users.get", nil)
+			assert.NoError(t, err)
+		}()
+	}
+
+	vk.SetToken("new")
+	wg.Wait()
+
+	_, err := vk.Request("users.get", nil)
+	assert.NoError(t, err)
+
+	calls := fake.Calls("users.get")
+	assert.Equal(t, "new", calls[len(calls)-1].Params["access_token"])
+}
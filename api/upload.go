@@ -3,9 +3,15 @@ package api // import "github.com/SevereCloud/vksdk/v2/api"
 import (
 	"bytes"
 	"encoding/json"
+	"image"
+	_ "image/gif"  // decoding of the size of the cover
+	_ "image/jpeg" // decoding of the size of the cover
+	_ "image/png"  // decoding of the size of the cover
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net/http"
+	"strconv"
 
 	"github.com/SevereCloud/vksdk/v2/object"
 )
@@ -28,7 +34,7 @@ func (vk *VK) UploadFile(url string, file io.Reader, fieldname, filename string)
 	contentType := writer.FormDataContentType()
 	_ = writer.Close()
 
-	resp, err := vk.Client.Post(url, contentType, body)
+	resp, err := vk.post(url, contentType, body)
 	if err != nil {
 		return
 	}
@@ -39,6 +45,18 @@ func (vk *VK) UploadFile(url string, file io.Reader, fieldname, filename string)
 	return
 }
 
+// post sends the POST request to the upload server with the context of vk.
+func (vk *VK) post(url, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(vk.context(), http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return vk.Client.Do(req)
+}
+
 // uploadPhoto uploading Photos into Album.
 //
 // Supported formats: JPG, PNG, GIF.
@@ -58,7 +76,7 @@ func (vk *VK) uploadPhoto(params Params, file io.Reader) (response PhotosSaveRes
 
 	var handler object.PhotosPhotoUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -123,7 +141,7 @@ func (vk *VK) uploadWallPhoto(params Params, file io.Reader) (response PhotosSav
 
 	var handler object.PhotosWallUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -208,7 +226,7 @@ func (vk *VK) uploadOwnerPhoto(params Params, squareCrop string, file io.Reader)
 
 	_ = writer.Close()
 
-	resp, err := vk.Client.Post(uploadServer.UploadURL, contentType, body)
+	resp, err := vk.post(uploadServer.UploadURL, contentType, body)
 	if err != nil {
 		return
 	}
@@ -221,7 +239,7 @@ func (vk *VK) uploadOwnerPhoto(params Params, squareCrop string, file io.Reader)
 
 	var handler object.PhotosOwnerUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -290,7 +308,7 @@ func (vk *VK) UploadMessagesPhoto(peerID int, file io.Reader) (response PhotosSa
 
 	var handler object.PhotosMessageUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -304,6 +322,37 @@ func (vk *VK) UploadMessagesPhoto(peerID int, file io.Reader) (response PhotosSa
 	return
 }
 
+// UploadMessagesPhotoAttachment uploads the photo into a private message
+// like UploadMessagesPhoto and returns the attachment for messages.send:
+//
+//	attachment, err := vk.UploadMessagesPhotoAttachment(peerID, file)
+//	if err != nil {
+//		return err
+//	}
+//
+//	b := params.NewMessagesSendBuilder()
+//	b.PeerID(peerID)
+//	b.Attachment(attachment)
+func (vk *VK) UploadMessagesPhotoAttachment(peerID int, file io.Reader) (string, error) {
+	response, err := vk.UploadMessagesPhoto(peerID, file)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ToAttachment(), nil
+}
+
+// attachment returns the attachment of the object in the format
+// {type}{owner_id}_{id}_{access_key}.
+func attachment(typ string, ownerID, id int, accessKey string) string {
+	s := typ + strconv.Itoa(ownerID) + "_" + strconv.Itoa(id)
+	if accessKey != "" {
+		s += "_" + accessKey
+	}
+
+	return s
+}
+
 // uploadChatPhoto uploading a Main Photo to a Group Chat.
 //
 // Supported formats: JPG, PNG, GIF.
@@ -324,7 +373,7 @@ func (vk *VK) uploadChatPhoto(params Params, file io.Reader) (response MessagesS
 
 	var handler object.PhotosChatUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -392,7 +441,7 @@ func (vk *VK) uploadMarketPhoto(params Params, file io.Reader) (response PhotosS
 
 	var handler object.PhotosMarketUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -475,7 +524,7 @@ func (vk *VK) UploadMarketAlbumPhoto(groupID int, file io.Reader) (
 
 	var handler object.PhotosMarketAlbumUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -504,7 +553,7 @@ func (vk *VK) UploadVideo(params Params, file io.Reader) (response VideoSaveResp
 
 	var videoUploadError UploadError
 
-	err = json.Unmarshal(bodyContent, &videoUploadError)
+	err = vk.unmarshal(bodyContent, &videoUploadError)
 	if err != nil {
 		return
 	}
@@ -529,7 +578,7 @@ func (vk *VK) uploadDoc(url, title, tags string, file io.Reader) (response DocsS
 
 	var docUploadError UploadError
 
-	err = json.Unmarshal(bodyContent, &docUploadError)
+	err = vk.unmarshal(bodyContent, &docUploadError)
 	if err != nil {
 		return
 	}
@@ -541,7 +590,7 @@ func (vk *VK) uploadDoc(url, title, tags string, file io.Reader) (response DocsS
 
 	var handler object.DocsDocUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -648,6 +697,77 @@ func (vk *VK) UploadMessagesDoc(peerID int, typeDoc, title, tags string, file io
 	return
 }
 
+// UploadMessagesDocAttachment uploads the document into a private message
+// like UploadMessagesDoc and returns the attachment for messages.send. The
+// typeDoc is DocTypeDoc, DocTypeAudioMessage or DocTypeGraffiti:
+//
+//	attachment, err := vk.UploadMessagesDocAttachment(peerID, api.DocTypeDoc, "report.pdf", "", file)
+func (vk *VK) UploadMessagesDocAttachment(peerID int, typeDoc, title, tags string, file io.Reader) (string, error) {
+	response, err := vk.UploadMessagesDoc(peerID, typeDoc, title, tags, file)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ToAttachment(), nil
+}
+
+// AudioMessageTranscoder converts the audio into ogg/opus before the upload
+// of the voice message, for example, with ffmpeg. If the returned reader
+// implements io.Closer, it is closed after the upload.
+type AudioMessageTranscoder func(audio io.Reader) (io.Reader, error)
+
+// UploadAudioMessage uploads the voice message into a private message and
+// returns the attachment for messages.send. The audio must be in ogg/opus
+// format, otherwise transcode, if not nil, converts it:
+//
+//	attachment, err := vk.UploadAudioMessage(peerID, file, nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	b := params.NewMessagesSendBuilder()
+//	b.PeerID(peerID)
+//	b.Attachment(attachment)
+func (vk *VK) UploadAudioMessage(peerID int, audio io.Reader, transcode AudioMessageTranscoder) (string, error) {
+	if transcode != nil {
+		transcoded, err := transcode(audio)
+		if err != nil {
+			return "", err
+		}
+
+		if closer, ok := transcoded.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		audio = transcoded
+	}
+
+	return vk.UploadMessagesDocAttachment(peerID, DocTypeAudioMessage, "voice.ogg", "", audio)
+}
+
+// UploadWallDocAttachment uploads the document on the wall like
+// UploadWallDoc and returns the attachment for wall.post.
+func (vk *VK) UploadWallDocAttachment(title, tags string, file io.Reader) (string, error) {
+	response, err := vk.UploadWallDoc(title, tags, file)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ToAttachment(), nil
+}
+
+// UploadGroupWallDocAttachment uploads the document on the wall of the
+// community like UploadGroupWallDoc and returns the attachment for
+// wall.post.
+func (vk *VK) UploadGroupWallDocAttachment(groupID int, title, tags string, file io.Reader) (string, error) {
+	response, err := vk.UploadGroupWallDoc(groupID, title, tags, file)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ToAttachment(), nil
+}
+
 // UploadOwnerCoverPhoto uploading a Main Photo to a Group Chat.
 //
 // Supported formats: JPG, PNG, GIF.
@@ -676,7 +796,7 @@ func (vk *VK) UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2 int, f
 
 	var handler object.PhotosOwnerUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -687,6 +807,54 @@ func (vk *VK) UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2 int, f
 	})
 }
 
+// Recommended size of the cover of the community, which sets the aspect
+// ratio of the crop of UploadGroupCover.
+const (
+	coverWidth  = 1590
+	coverHeight = 400
+)
+
+// UploadGroupCover uploads the cover of the community like
+// UploadOwnerCoverPhoto. The crop is computed from the size of the image:
+// the largest area with the aspect ratio of the recommended size 1590x400px
+// in the center of the image.
+//
+// Supported formats: JPG, PNG, GIF.
+//
+// Limits: minimum photo size 795x200px, width+height not more than 14000px,
+// file size up to 50 MB.
+func (vk *VK) UploadGroupCover(groupID int, file io.Reader) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
+	body, err := ioutil.ReadAll(file)
+	if err != nil {
+		return
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	cropX, cropY, cropX2, cropY2 := coverCrop(config.Width, config.Height)
+
+	return vk.UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2, bytes.NewReader(body))
+}
+
+// coverCrop returns the crop of the cover of the image.
+func coverCrop(width, height int) (x, y, x2, y2 int) {
+	if width*coverHeight > height*coverWidth {
+		// the image is wider than the cover
+		w := height * coverWidth / coverHeight
+		x = (width - w) / 2
+
+		return x, 0, x + w, height
+	}
+
+	h := width * coverHeight / coverWidth
+	y = (height - h) / 2
+
+	return 0, y, width, y + h
+}
+
 // UploadStories struct.
 type UploadStories struct {
 	UploadResult string `json:"upload_result"`
@@ -727,7 +895,7 @@ func (vk *VK) UploadStoriesPhoto(params Params, file io.Reader) (response Storie
 
 	var handler rawUploadStoriesPhoto
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -764,7 +932,7 @@ func (vk *VK) UploadStoriesVideo(params Params, file io.Reader) (response Storie
 
 	var handler rawUploadStoriesVideo
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -780,6 +948,92 @@ func (vk *VK) UploadStoriesVideo(params Params, file io.Reader) (response Storie
 	return response, err
 }
 
+// StoryOptions are the settings of the story of PublishStoryPhoto and
+// PublishStoryVideo.
+type StoryOptions struct {
+	// GroupID is the ID of the community to publish the story, zero for
+	// the current user.
+	GroupID int
+
+	// UserIDs are the IDs of users who can see the story.
+	UserIDs []int
+
+	// ReplyToStory is the story to reply with the current one, in the
+	// format {owner_id}_{story_id}.
+	ReplyToStory string
+
+	// LinkText and LinkURL set the link of the story. The text is available
+	// for stories of communities only, the URL must be on https://vk.com.
+	LinkText string
+	LinkURL  string
+
+	// ClickableStickers are the stickers of the story, see
+	// object.NewClickableStickers.
+	ClickableStickers *object.StoriesClickableStickers
+}
+
+// params returns the params of stories.getPhotoUploadServer and
+// stories.getVideoUploadServer. The story is always added to the news.
+func (opts StoryOptions) params() Params {
+	params := Params{
+		"add_to_news": true,
+	}
+
+	if opts.GroupID != 0 {
+		params["group_id"] = opts.GroupID
+	}
+
+	if len(opts.UserIDs) > 0 {
+		params["user_ids"] = opts.UserIDs
+	}
+
+	if opts.ReplyToStory != "" {
+		params["reply_to_story"] = opts.ReplyToStory
+	}
+
+	if opts.LinkText != "" {
+		params["link_text"] = opts.LinkText
+	}
+
+	if opts.LinkURL != "" {
+		params["link_url"] = opts.LinkURL
+	}
+
+	if opts.ClickableStickers != nil {
+		params["clickable_stickers"] = opts.ClickableStickers.ToJSON()
+	}
+
+	return params
+}
+
+// PublishStoryPhoto uploads the photo and publishes the story with the link
+// and the stickers of opts in one call:
+//
+//	stickers := object.NewClickableStickers(1080, 1920)
+//	stickers.AddHashtag("#vksdk", area)
+//
+//	response, err := vk.PublishStoryPhoto(file, api.StoryOptions{
+//		GroupID:           groupID,
+//		LinkText:          "more",
+//		LinkURL:           "https://vk.com/dev",
+//		ClickableStickers: stickers,
+//	})
+//
+// Supported formats: JPG, PNG, GIF.
+// Limits: sum of with and height no more than 14000px, file size no
+// more than 10 MB.
+func (vk *VK) PublishStoryPhoto(file io.Reader, opts StoryOptions) (StoriesSaveResponse, error) {
+	return vk.UploadStoriesPhoto(opts.params(), file)
+}
+
+// PublishStoryVideo uploads the video and publishes the story with the
+// link and the stickers of opts in one call like PublishStoryPhoto.
+//
+// Video format: h264 video, aac audio, maximum 720х1280, 30fps.
+func (vk *VK) PublishStoryVideo(file io.Reader, opts StoryOptions) (StoriesSaveResponse, error) {
+	return vk.UploadStoriesVideo(opts.params(), file)
+}
+
 // uploadPollsPhoto uploading a Poll Photo.
 //
 // Supported formats: JPG, PNG, GIF.
@@ -799,7 +1053,7 @@ func (vk *VK) uploadPollsPhoto(params Params, file io.Reader) (response PollsSav
 
 	var handler object.PollsPhotoUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -853,7 +1107,7 @@ func (vk *VK) UploadPrettyCardsPhoto(file io.Reader) (response string, err error
 
 	var handler uploadPrettyCardsPhotoHandler
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -888,7 +1142,7 @@ func (vk *VK) UploadLeadFormsPhoto(file io.Reader) (response string, err error)
 
 	var handler uploadLeadFormsPhotoHandler
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -918,7 +1172,7 @@ func (vk *VK) UploadAppImage(imageType string, file io.Reader) (response object.
 
 	var handler object.AppWidgetsAppImageUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -947,7 +1201,7 @@ func (vk *VK) UploadGroupImage(imageType string, file io.Reader) (response objec
 
 	var handler object.AppWidgetsGroupImageUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -977,7 +1231,7 @@ func (vk *VK) UploadMarusiaPicture(file io.Reader) (response MarusiaSavePictureR
 
 	var handler object.MarusiaPictureUploadResponse
 
-	err = json.Unmarshal(bodyContent, &handler)
+	err = vk.unmarshal(bodyContent, &handler)
 	if err != nil {
 		return
 	}
@@ -2,11 +2,17 @@ package api_test
 
 import (
 	"bytes"
+	"errors"
+	"image"
+	"image/png"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/object"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -606,3 +612,266 @@ func TestVK_Upload_Error(t *testing.T) {
 	_, _ = vk.UploadMarusiaPicture(new(bytes.Buffer))
 	_, _ = vk.UploadMarusiaAudio(new(bytes.Buffer))
 }
+
+// newUploadServer returns the URL of the upload server that responds with
+// the response.
+func newUploadServer(t *testing.T, response string) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := r.FormFile("photo"); err != nil {
+			if _, _, err := r.FormFile("file"); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestVK_UploadMessagesPhotoAttachment(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("photos.getMessagesUploadServer", api.PhotosGetMessagesUploadServerResponse{
+		UploadURL: newUploadServer(t, `{"server":1,"photo":"[]","hash":"abc"}`),
+	})
+	fake.On("photos.saveMessagesPhoto", []object.PhotosPhoto{
+		{ID: 2, OwnerID: -1, AccessKey: "key"},
+	})
+
+	vk := fake.VK()
+
+	attachment, err := vk.UploadMessagesPhotoAttachment(2000000001, bytes.NewBufferString("photo"))
+	assert.NoError(t, err)
+	assert.Equal(t, "photo-1_2_key", attachment)
+
+	calls := fake.Calls("photos.saveMessagesPhoto")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "abc", calls[0].Params["hash"])
+	}
+
+	assert.Equal(t, "photo1_2,photo1_3_key", api.PhotosSaveMessagesPhotoResponse{
+		{ID: 2, OwnerID: 1},
+		{ID: 3, OwnerID: 1, AccessKey: "key"},
+	}.ToAttachment())
+}
+
+func TestVK_UploadMessagesDocAttachment(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("docs.getMessagesUploadServer", api.DocsGetMessagesUploadServerResponse{
+		UploadURL: newUploadServer(t, `{"file":"abc"}`),
+	})
+	fake.On("docs.save", api.DocsSaveResponse{
+		Type: api.DocTypeAudioMessage,
+		AudioMessage: object.MessagesAudioMessage{
+			ID:        2,
+			OwnerID:   1,
+			AccessKey: "key",
+		},
+	})
+
+	vk := fake.VK()
+
+	attachment, err := vk.UploadMessagesDocAttachment(
+		2000000001, api.DocTypeAudioMessage, "voice.ogg", "", bytes.NewBufferString("voice"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "doc1_2_key", attachment)
+
+	calls := fake.Calls("docs.getMessagesUploadServer")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, api.DocTypeAudioMessage, calls[0].Params["type"])
+	}
+
+	calls = fake.Calls("docs.save")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "abc", calls[0].Params["file"])
+	}
+}
+
+func TestVK_UploadWallDocAttachment(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("docs.getWallUploadServer", api.DocsGetWallUploadServerResponse{
+		UploadURL: newUploadServer(t, `{"error":"no file"}`),
+	})
+
+	vk := fake.VK()
+
+	_, err := vk.UploadGroupWallDocAttachment(1, "doc.txt", "", bytes.NewBufferString("doc"))
+	assert.Error(t, err)
+	assert.Empty(t, fake.Calls("docs.save"))
+}
+
+func TestDocsSaveResponse_ToAttachment(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "doc1_2", api.DocsSaveResponse{
+		Type: api.DocTypeDoc,
+		Doc:  object.DocsDoc{ID: 2, OwnerID: 1},
+	}.ToAttachment())
+	assert.Equal(t, "doc-1_3_key", api.DocsSaveResponse{
+		Type:     api.DocTypeGraffiti,
+		Graffiti: object.MessagesGraffiti{ID: 3, OwnerID: -1, AccessKey: "key"},
+	}.ToAttachment())
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestVK_UploadAudioMessage(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("docs.getMessagesUploadServer", api.DocsGetMessagesUploadServerResponse{
+		UploadURL: newUploadServer(t, `{"file":"abc"}`),
+	})
+	fake.On("docs.save", api.DocsSaveResponse{
+		Type:         api.DocTypeAudioMessage,
+		AudioMessage: object.MessagesAudioMessage{ID: 2, OwnerID: 1},
+	})
+
+	vk := fake.VK()
+
+	attachment, err := vk.UploadAudioMessage(1, bytes.NewBufferString("opus"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "doc1_2", attachment)
+
+	var transcoded *closeRecorder
+
+	attachment, err = vk.UploadAudioMessage(1, bytes.NewBufferString("mp3"), func(audio io.Reader) (io.Reader, error) {
+		transcoded = &closeRecorder{Reader: audio}
+		return transcoded, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "doc1_2", attachment)
+	assert.True(t, transcoded.closed)
+
+	calls := fake.Calls("docs.getMessagesUploadServer")
+	if assert.Len(t, calls, 2) {
+		assert.Equal(t, api.DocTypeAudioMessage, calls[0].Params["type"])
+	}
+
+	errTranscode := errors.New("transcode")
+
+	_, err = vk.UploadAudioMessage(1, bytes.NewBufferString("mp3"), func(io.Reader) (io.Reader, error) {
+		return nil, errTranscode
+	})
+	assert.ErrorIs(t, err, errTranscode)
+	assert.Len(t, fake.Calls("docs.getMessagesUploadServer"), 2)
+}
+
+func TestVK_PublishStoryPhoto(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("stories.getPhotoUploadServer", api.StoriesGetPhotoUploadServerResponse{
+		UploadURL: newUploadServer(t, `{"response":{"upload_result":"result","_sig":"sig"}}`),
+	})
+	fake.On("stories.save", api.StoriesSaveResponse{
+		Count: 1,
+		Items: []object.StoriesStory{{ID: 2, OwnerID: -1}},
+	})
+
+	vk := fake.VK()
+
+	stickers := object.NewClickableStickers(1080, 1920)
+	stickers.AddHashtag("#vksdk", []object.StoriesClickablePoint{{X: 1, Y: 1}})
+
+	response, err := vk.PublishStoryPhoto(bytes.NewBufferString("photo"), api.StoryOptions{
+		GroupID:           1,
+		LinkText:          "more",
+		LinkURL:           "https://vk.com/dev",
+		ClickableStickers: stickers,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, response.Count)
+
+	calls := fake.Calls("stories.getPhotoUploadServer")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, true, calls[0].Params["add_to_news"])
+		assert.Equal(t, 1, calls[0].Params["group_id"])
+		assert.Equal(t, "more", calls[0].Params["link_text"])
+		assert.Equal(t, "https://vk.com/dev", calls[0].Params["link_url"])
+		assert.Equal(t, stickers.ToJSON(), calls[0].Params["clickable_stickers"])
+		assert.NotContains(t, calls[0].Params, "user_ids")
+	}
+
+	calls = fake.Calls("stories.save")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "result", calls[0].Params["upload_results"])
+	}
+}
+
+func TestVK_UploadGroupCover(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                         string
+		width, height                int
+		cropX, cropY, cropX2, cropY2 int
+	}{
+		{"recommended", 1590, 400, 0, 0, 1590, 400},
+		{"wide", 2000, 400, 205, 0, 1795, 400},
+		{"tall", 3180, 1000, 0, 100, 3180, 900},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := apitest.New()
+			fake.On("photos.getOwnerCoverPhotoUploadServer", api.PhotosGetOwnerCoverPhotoUploadServerResponse{
+				UploadURL: newUploadServer(t, `{"photo":"photo","hash":"abc"}`),
+			})
+			fake.On("photos.saveOwnerCoverPhoto", api.PhotosSaveOwnerCoverPhotoResponse{})
+
+			var file bytes.Buffer
+
+			err := png.Encode(&file, image.NewGray(image.Rect(0, 0, tt.width, tt.height)))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, err = fake.VK().UploadGroupCover(1, &file)
+			assert.NoError(t, err)
+
+			calls := fake.Calls("photos.getOwnerCoverPhotoUploadServer")
+			if assert.Len(t, calls, 1) {
+				assert.Equal(t, 1, calls[0].Params["group_id"])
+				assert.Equal(t, tt.cropX, calls[0].Params["crop_x"])
+				assert.Equal(t, tt.cropY, calls[0].Params["crop_y"])
+				assert.Equal(t, tt.cropX2, calls[0].Params["crop_x2"])
+				assert.Equal(t, tt.cropY2, calls[0].Params["crop_y2"])
+			}
+
+			assert.Len(t, fake.Calls("photos.saveOwnerCoverPhoto"), 1)
+		})
+	}
+}
+
+func TestVK_UploadGroupCover_invalidImage(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+
+	_, err := fake.VK().UploadGroupCover(1, bytes.NewBufferString("cover"))
+	assert.ErrorIs(t, err, image.ErrFormat)
+	assert.Empty(t, fake.Calls("photos.getOwnerCoverPhotoUploadServer"))
+}
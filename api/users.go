@@ -9,9 +9,28 @@ type UsersGetResponse []object.UsersUser
 
 // UsersGet returns detailed information on users.
 //
+// If user_ids contains more than UsersGetMaxIDs IDs, they are requested in
+// several requests.
+//
 // https://vk.com/dev/users.get
 func (vk *VK) UsersGet(params Params) (response UsersGetResponse, err error) {
-	err = vk.RequestUnmarshal("users.get", &response, params)
+	chunks := splitIDs(params, "user_ids", UsersGetMaxIDs)
+	if len(chunks) == 1 {
+		err = vk.RequestUnmarshal("users.get", &response, params)
+		return
+	}
+
+	for _, chunk := range chunks {
+		var r UsersGetResponse
+
+		err = vk.RequestUnmarshal("users.get", &r, chunk)
+		if err != nil {
+			return
+		}
+
+		response = append(response, r...)
+	}
+
 	return
 }
 
@@ -0,0 +1,213 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default settings of VideoUploadOptions.
+const (
+	DefaultVideoChunkSize    = 5 << 20
+	DefaultVideoChunkRetries = 3
+)
+
+// VideoUpload is the state of the chunked upload of the video. It can be
+// kept to resume the upload after an error.
+type VideoUpload struct {
+	// Video is the response of video.save with the upload URL.
+	Video VideoSaveResponse
+
+	// SessionID identifies the upload on the upload server.
+	SessionID string
+
+	// Offset is the number of uploaded bytes.
+	Offset int64
+}
+
+// VideoUploadOptions configures UploadVideoChunked.
+type VideoUploadOptions struct {
+	// ChunkSize is the size of a chunk in bytes. If zero,
+	// DefaultVideoChunkSize is used.
+	ChunkSize int64
+
+	// Retries is the number of retries of a failed chunk. If zero,
+	// DefaultVideoChunkRetries is used, a negative value disables retries.
+	Retries int
+
+	// Progress, if set, is called after each uploaded chunk with the number
+	// of uploaded bytes and the size of the file.
+	Progress func(uploaded, total int64)
+}
+
+// NewVideoUpload calls video.save and returns the state of the chunked
+// upload for UploadVideoChunked.
+func (vk *VK) NewVideoUpload(params Params) (*VideoUpload, error) {
+	video, err := vk.VideoSave(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var b [16]byte
+
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+
+	return &VideoUpload{
+		Video:     video,
+		SessionID: hex.EncodeToString(b[:]),
+	}, nil
+}
+
+// UploadVideoChunked uploads large video files in chunks, starting from
+// upload.Offset. A failed chunk is retried with the delay of vk.Retry. If
+// the upload still fails, upload keeps the offset of the uploaded part and
+// the call can be repeated to resume it:
+//
+//	f, err := os.Open("video.mp4")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//
+//	info, err := f.Stat()
+//	if err != nil {
+//		return err
+//	}
+//
+//	upload, err := vk.NewVideoUpload(api.Params{"name": "Video"})
+//	if err != nil {
+//		return err
+//	}
+//
+//	err = vk.UploadVideoChunked(upload, f, info.Size(), api.VideoUploadOptions{
+//		Progress: func(uploaded, total int64) {
+//			log.Printf("%d/%d", uploaded, total)
+//		},
+//	})
+//
+// Supported formats: AVI, MP4, 3GP, MPEG, MOV, FLV, WMV.
+func (vk *VK) UploadVideoChunked(upload *VideoUpload, file io.ReaderAt, size int64, opts VideoUploadOptions) error {
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultVideoChunkSize
+	}
+
+	retries := opts.Retries
+	if retries == 0 {
+		retries = DefaultVideoChunkRetries
+	}
+
+	for upload.Offset < size {
+		end := upload.Offset + chunkSize
+		if end > size {
+			end = size
+		}
+
+		err := vk.uploadVideoChunk(upload, file, end, size)
+
+		for attempt := 1; retryableChunk(err) && attempt <= retries; attempt++ {
+			delay := vk.Retry.Delay(attempt)
+
+			var statusErr *StatusError
+			if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+				delay = statusErr.RetryAfter
+			}
+
+			if sleepErr := sleep(vk.context(), delay); sleepErr != nil {
+				return sleepErr
+			}
+
+			err = vk.uploadVideoChunk(upload, file, end, size)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		upload.Offset = end
+
+		if opts.Progress != nil {
+			opts.Progress(upload.Offset, size)
+		}
+	}
+
+	return nil
+}
+
+// retryableChunk returns true if the upload of the chunk failed and can be
+// retried. Errors of the context and the upload server are not retried.
+func retryableChunk(err error) bool {
+	var uploadErr *UploadError
+
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.As(err, &uploadErr):
+		return false
+	}
+
+	return true
+}
+
+// uploadVideoChunk uploads the bytes of the file from upload.Offset to end.
+func (vk *VK) uploadVideoChunk(upload *VideoUpload, file io.ReaderAt, end, size int64) error {
+	body := io.NewSectionReader(file, upload.Offset, end-upload.Offset)
+
+	req, err := http.NewRequestWithContext(vk.context(), http.MethodPost, upload.Video.UploadURL, body)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = end - upload.Offset
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Disposition", `attachment; filename="video.mp4"`)
+	req.Header.Set("Content-Range", "bytes "+strconv.FormatInt(upload.Offset, 10)+"-"+
+		strconv.FormatInt(end-1, 10)+"/"+strconv.FormatInt(size, 10))
+	req.Header.Set("Session-ID", upload.SessionID)
+
+	resp, err := vk.Client.Do(req)
+	if err != nil {
+		return contextError(vk.context(), err)
+	}
+	defer resp.Body.Close()
+
+	bodyContent, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return contextError(vk.context(), err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		// the chunk is received, the upload is not completed
+		return nil
+	case http.StatusOK:
+	default:
+		return &StatusError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: retryAfter(resp.Header, time.Now()),
+		}
+	}
+
+	var videoUploadError UploadError
+
+	err = vk.unmarshal(bodyContent, &videoUploadError)
+	if err != nil {
+		return err
+	}
+
+	if videoUploadError.Code != 0 {
+		return &videoUploadError
+	}
+
+	return nil
+}
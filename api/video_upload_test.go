@@ -0,0 +1,166 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_UploadVideoChunked(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		ranges   []string
+		sessions = map[string]bool{}
+		received bytes.Buffer
+		failed   bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		chunk, _ := ioutil.ReadAll(r.Body)
+		contentRange := r.Header.Get("Content-Range")
+
+		// the second chunk fails once
+		if contentRange == "bytes 4-7/10" && !failed {
+			failed = true
+
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		ranges = append(ranges, contentRange)
+		sessions[r.Header.Get("Session-ID")] = true
+		received.Write(chunk)
+
+		if contentRange != "bytes 8-9/10" {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"size":10,"video_id":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	fake := apitest.New()
+	fake.On("video.save", api.VideoSaveResponse{
+		UploadURL: server.URL,
+		VideoID:   1,
+	})
+
+	vk := fake.VK()
+	vk.Retry.Min = time.Millisecond
+
+	upload, err := vk.NewVideoUpload(api.Params{"name": "Video"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NotEmpty(t, upload.SessionID)
+
+	var progress []int64
+
+	err = vk.UploadVideoChunked(upload, bytes.NewReader([]byte("0123456789")), 10, api.VideoUploadOptions{
+		ChunkSize: 4,
+		Progress: func(uploaded, total int64) {
+			assert.Equal(t, int64(10), total)
+
+			progress = append(progress, uploaded)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), upload.Offset)
+	assert.Equal(t, []int64{4, 8, 10}, progress)
+	assert.Equal(t, []string{"bytes 0-3/10", "bytes 4-7/10", "bytes 8-9/10"}, ranges)
+	assert.Equal(t, map[string]bool{upload.SessionID: true}, sessions)
+	assert.Equal(t, "0123456789", received.String())
+}
+
+func TestVK_UploadVideoChunked_resume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+
+		// the server is unavailable after the first chunk
+		if calls > 1 && calls < 4 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		if r.Header.Get("Content-Range") != "bytes 5-9/10" {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"size":10,"video_id":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	vk := api.NewVK("")
+	upload := &api.VideoUpload{
+		Video:     api.VideoSaveResponse{UploadURL: server.URL},
+		SessionID: "session",
+	}
+	file := bytes.NewReader([]byte("0123456789"))
+	opts := api.VideoUploadOptions{
+		ChunkSize: 5,
+		Retries:   -1,
+	}
+
+	err := vk.UploadVideoChunked(upload, file, 10, opts)
+	assert.ErrorIs(t, err, &api.StatusError{StatusCode: http.StatusBadGateway})
+	assert.Equal(t, int64(5), upload.Offset)
+
+	err = vk.UploadVideoChunked(upload, file, 10, opts)
+	assert.ErrorIs(t, err, &api.StatusError{StatusCode: http.StatusBadGateway})
+	assert.Equal(t, int64(5), upload.Offset)
+
+	err = vk.UploadVideoChunked(upload, file, 10, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), upload.Offset)
+}
+
+func TestVK_UploadVideoChunked_uploadError(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		_, _ = w.Write([]byte(`{"error":"invalid file","error_code":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	vk := api.NewVK("")
+	upload := &api.VideoUpload{
+		Video: api.VideoSaveResponse{UploadURL: server.URL},
+	}
+
+	err := vk.UploadVideoChunked(upload, bytes.NewReader([]byte("video")), 5, api.VideoUploadOptions{})
+
+	var uploadErr *api.UploadError
+	assert.ErrorAs(t, err, &uploadErr)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, int64(0), upload.Offset)
+}
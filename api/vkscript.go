@@ -0,0 +1,256 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidScript is returned when the script contains an invalid method,
+// name or value.
+var ErrInvalidScript = errors.New("api: invalid script")
+
+// Var is an expression of VKScript, for example, the result of a call.
+type Var string
+
+// Field returns the field of the object.
+func (v Var) Field(name string) Var {
+	if v == "" || !isIdent(name) {
+		return ""
+	}
+
+	return v + "." + Var(name)
+}
+
+// Index returns the element of the array.
+func (v Var) Index(i int) Var {
+	if v == "" {
+		return ""
+	}
+
+	return v + "[" + Var(strconv.Itoa(i)) + "]"
+}
+
+// Pluck returns the array of the field of the objects of the array, the @.
+// operator of VKScript.
+func (v Var) Pluck(name string) Var {
+	if v == "" || !isIdent(name) {
+		return ""
+	}
+
+	return v + "@." + Var(name)
+}
+
+// Script builds the code of execute. Values of params are escaped, so
+// VKScript is not concatenated by hand:
+//
+//	s := api.NewScript()
+//	users := s.Call("users.get", api.Params{"user_ids": userIDs})
+//	friends := s.Call("friends.get", api.Params{"user_id": users.Index(0).Field("id")})
+//	s.Return(map[string]api.Var{"users": users, "friends": friends})
+//
+//	var resp struct {
+//		Users   []object.UsersUser     `json:"users"`
+//		Friends api.FriendsGetResponse `json:"friends"`
+//	}
+//
+//	err := vk.ExecuteScript(s, &resp)
+type Script struct {
+	code strings.Builder
+	args Params
+	vars int
+	err  error
+}
+
+// NewScript returns a new Script.
+func NewScript() *Script {
+	return &Script{
+		args: Params{},
+	}
+}
+
+// Arg passes the value in the params of execute and returns the expression
+// Args.name. Long values, for example, texts of messages, are better passed
+// as args.
+func (s *Script) Arg(name string, value interface{}) Var {
+	if !isIdent(name) {
+		s.fail()
+		return ""
+	}
+
+	s.args[name] = value
+
+	return "Args." + Var(name)
+}
+
+// Call adds the call of the method and returns the variable with its
+// result. Values of params can be Var.
+func (s *Script) Call(method string, params Params) Var {
+	if !batchable(method) {
+		s.fail()
+		return ""
+	}
+
+	obj, err := scriptObject(params)
+	if err != nil {
+		s.fail()
+		return ""
+	}
+
+	v := Var("r" + strconv.Itoa(s.vars))
+	s.vars++
+
+	s.code.WriteString("var " + string(v) + "=API." + method + "(" + obj + ");")
+
+	return v
+}
+
+// Return adds the return statement. The value can be Var, []Var or
+// map[string]Var, which is returned as an object.
+func (s *Script) Return(value interface{}) {
+	var expr string
+
+	switch v := value.(type) {
+	case Var:
+		expr = string(v)
+	case []Var:
+		items := make([]string, len(v))
+		for i := range v {
+			if v[i] == "" {
+				s.fail()
+				return
+			}
+
+			items[i] = string(v[i])
+		}
+
+		expr = "[" + strings.Join(items, ",") + "]"
+	case map[string]Var:
+		params := make(Params, len(v))
+		for key, item := range v {
+			params[key] = item
+		}
+
+		var err error
+
+		expr, err = scriptObject(params)
+		if err != nil {
+			s.fail()
+			return
+		}
+	default:
+		s.fail()
+		return
+	}
+
+	if expr == "" {
+		s.fail()
+		return
+	}
+
+	s.code.WriteString("return " + expr + ";")
+}
+
+// Code returns the code of the script.
+func (s *Script) Code() (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+
+	return s.code.String(), nil
+}
+
+// Args returns the params of execute that are set by Arg.
+func (s *Script) Args() Params {
+	return s.args
+}
+
+func (s *Script) fail() {
+	s.err = ErrInvalidScript
+}
+
+// ExecuteScript executes the script.
+func (vk *VK) ExecuteScript(s *Script, obj interface{}) error {
+	code, err := s.Code()
+	if err != nil {
+		return err
+	}
+
+	return vk.ExecuteWithArgs(code, s.Args(), obj)
+}
+
+// scriptObject returns the VKScript object of params. Keys are sorted, so
+// the code is the same for the same params.
+func scriptObject(params Params) (string, error) {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var obj strings.Builder
+
+	obj.WriteByte('{')
+
+	for i, key := range keys {
+		if i > 0 {
+			obj.WriteByte(',')
+		}
+
+		k, err := scriptString(key)
+		if err != nil {
+			return "", err
+		}
+
+		obj.WriteString(k + ":")
+
+		if v, ok := params[key].(Var); ok {
+			if v == "" {
+				return "", ErrInvalidScript
+			}
+
+			obj.WriteString(string(v))
+
+			continue
+		}
+
+		value, err := scriptString(FmtValue(params[key], 0))
+		if err != nil {
+			return "", err
+		}
+
+		obj.WriteString(value)
+	}
+
+	obj.WriteByte('}')
+
+	return obj.String(), nil
+}
+
+// scriptString returns the escaped string literal.
+func scriptString(s string) (string, error) {
+	b, err := json.Marshal(s)
+
+	return string(b), err
+}
+
+// isIdent returns true if the name is a valid identifier.
+func isIdent(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
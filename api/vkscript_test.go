@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScript(t *testing.T) {
+	t.Parallel()
+
+	s := api.NewScript()
+	text := s.Arg("text", "hello")
+	users := s.Call("users.get", api.Params{"user_ids": []int{1, 2}, "fields": "city"})
+	wall := s.Call("wall.get", api.Params{
+		"owner_id": users.Index(0).Field("id"),
+		"query":    `"}); API.account.ban({"owner_id":1`,
+		"text":     text,
+	})
+	s.Return(map[string]api.Var{"ids": users.Pluck("id"), "wall": wall})
+
+	code, err := s.Code()
+	assert.NoError(t, err)
+	assert.Equal(t, `var r0=API.users.get({"fields":"city","user_ids":"1,2"});`+
+		`var r1=API.wall.get({"owner_id":r0[0].id,"query":"\"}); API.account.ban({\"owner_id\":1","text":Args.text});`+
+		`return {"ids":r0@.id,"wall":r1};`, code)
+	assert.Equal(t, api.Params{"text": "hello"}, s.Args())
+
+	s = api.NewScript()
+	s.Return([]api.Var{s.Call("users.get", nil), s.Call("groups.getById", nil)})
+
+	code, err = s.Code()
+	assert.NoError(t, err)
+	assert.Equal(t, `var r0=API.users.get({});var r1=API.groups.getById({});return [r0,r1];`, code)
+}
+
+func TestScript_invalid(t *testing.T) {
+	t.Parallel()
+
+	f := func(build func(s *api.Script)) {
+		t.Helper()
+
+		s := api.NewScript()
+		build(s)
+
+		_, err := s.Code()
+		assert.ErrorIs(t, err, api.ErrInvalidScript)
+	}
+
+	f(func(s *api.Script) { s.Call("users.get();API.account.ban", nil) })
+	f(func(s *api.Script) { s.Arg("a.b", 1) })
+	f(func(s *api.Script) { s.Return(s.Call("users.get", nil).Field("id;")) })
+	f(func(s *api.Script) { s.Return([]api.Var{s.Call("users.get", nil).Pluck("")}) })
+	f(func(s *api.Script) { s.Call("users.get", api.Params{"user_ids": api.Var("")}) })
+	f(func(s *api.Script) { s.Return("r0") })
+}
+
+func TestVK_ExecuteScript(t *testing.T) {
+	t.Parallel()
+
+	var code, text string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code = r.PostFormValue("code")
+		text = r.PostFormValue("text")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":[1]}`))
+	}))
+	defer server.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = server.URL + "/"
+	vk.Client = server.Client()
+
+	s := api.NewScript()
+	s.Return(s.Call("users.get", api.Params{"user_ids": s.Arg("text", "1")}).Pluck("id"))
+
+	var resp []int
+
+	assert.NoError(t, vk.ExecuteScript(s, &resp))
+	assert.Equal(t, []int{1}, resp)
+	assert.Equal(t, `var r0=API.users.get({"user_ids":Args.text});return r0@.id;`, code)
+	assert.Equal(t, "1", text)
+
+	invalid := api.NewScript()
+	invalid.Return(nil)
+
+	assert.ErrorIs(t, vk.ExecuteScript(invalid, &resp), api.ErrInvalidScript)
+}
@@ -0,0 +1,87 @@
+package api // import "github.com/SevereCloud/vksdk/v2/api"
+
+import "reflect"
+
+// Option changes the copy of VK returned by With.
+type Option func(*VK)
+
+// WithTokens sets the tokens of requests. The TokenSource is removed.
+func WithTokens(tokens ...string) Option {
+	return func(vk *VK) {
+		if len(tokens) == 0 {
+			tokens = []string{""}
+		}
+
+		vk.setTokens(tokens)
+		vk.TokenSource = nil
+	}
+}
+
+// WithTokenSource sets the source of tokens.
+func WithTokenSource(ts TokenSource) Option {
+	return func(vk *VK) {
+		vk.TokenSource = ts
+	}
+}
+
+// WithVersion sets the version of VK API.
+func WithVersion(v string) Option {
+	return func(vk *VK) {
+		vk.Version = v
+	}
+}
+
+// WithLimit sets the limit of requests per second for each token.
+func WithLimit(limit int) Option {
+	return func(vk *VK) {
+		vk.Limit = limit
+	}
+}
+
+// WithTokenType sets the type of tokens and the limit of requests per
+// second for the type.
+func WithTokenType(t TokenType) Option {
+	return func(vk *VK) {
+		vk.SetTokenType(t)
+	}
+}
+
+// WithLimiter sets the limiter of requests.
+func WithLimiter(l Limiter) Option {
+	return func(vk *VK) {
+		vk.Limiter = l
+	}
+}
+
+// With returns a shallow copy of vk changed by the options. It allows to
+// act as a community and as its admin in one process:
+//
+//	group := api.NewVK(groupToken)
+//	admin := group.With(api.WithTokens(userToken), api.WithTokenType(api.TokenUser))
+//
+// The copy shares the HTTP client, the middlewares and other settings with
+// vk. If vk uses DefaultHandler, the copy uses its own DefaultHandler with
+// its own rate limiter, otherwise it shares the handler with vk.
+func (vk *VK) With(opts ...Option) *VK {
+	c := vk.clone()
+
+	if isDefaultHandler(vk.Handler) {
+		c.Handler = c.DefaultHandler
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// isDefaultHandler reports whether the handler is DefaultHandler of some
+// VK. Method values of the same method share the code.
+func isDefaultHandler(h func(method string, params ...Params) (Response, error)) bool {
+	if h == nil {
+		return false
+	}
+
+	return reflect.ValueOf(h).Pointer() == reflect.ValueOf((&VK{}).DefaultHandler).Pointer()
+}
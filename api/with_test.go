@@ -0,0 +1,60 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_With(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":"` + r.FormValue("access_token") + " " + r.FormValue("v") + `"}`))
+	}))
+	defer server.Close()
+
+	group := api.NewVK("group")
+	group.MethodURL = server.URL + "/"
+	group.Client = server.Client()
+
+	admin := group.With(
+		api.WithTokens("user"),
+		api.WithVersion("5.100"),
+		api.WithTokenType(api.TokenUser),
+	)
+
+	resp, err := admin.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"user 5.100"`, string(resp))
+	assert.Equal(t, api.LimitUserToken, admin.Limit)
+	assert.Same(t, group.Client, admin.Client)
+
+	resp, err = group.Request("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `"group `+api.Version+`"`, string(resp))
+	assert.Equal(t, api.LimitGroupToken, group.Limit)
+}
+
+func TestVK_With_handler(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("users.get", []int{})
+
+	vk := fake.VK()
+
+	// the custom handler is shared
+	_, err := vk.With(api.WithTokens("other"), api.WithLimit(1)).Request("users.get", nil)
+	assert.NoError(t, err)
+
+	calls := fake.Calls("users.get")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "other", calls[0].Params["access_token"])
+	}
+}
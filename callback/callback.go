@@ -80,7 +80,9 @@ func (cb *Callback) HandleFunc(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.Background()
+	// the values of the request, e.g. the span of the tracing middleware,
+	// are passed to handlers, which may outlive the request
+	var ctx context.Context = detachedContext{r.Context()}
 
 	retryCounter, _ := strconv.Atoi(r.Header.Get("X-Retry-Counter"))
 	ctx = context.WithValue(ctx, internal.CallbackRetryCounterKey, retryCounter)
@@ -2,12 +2,14 @@ package callback_test
 
 import (
 	"bytes"
+	"context"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/SevereCloud/vksdk/v2/callback"
+	"github.com/SevereCloud/vksdk/v2/events"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -243,3 +245,38 @@ func TestCallback_ErrorLog(t *testing.T) {
 	handler.ServeHTTP(rr, req)
 	assert.Equal(t, "callback: EOF\n", buf.String())
 }
+
+type testContextKey struct{}
+
+func TestCallback_HandleFunc_context(t *testing.T) {
+	t.Parallel()
+
+	cb := callback.NewCallback()
+
+	var (
+		value  interface{}
+		handed context.Context
+	)
+
+	cb.OnEvent("test", func(ctx context.Context, _ events.GroupEvent) {
+		value = ctx.Value(testContextKey{})
+		handed = ctx
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, testContextKey{}, "span")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "/callback", bytes.NewBufferString(`{"type":"test","object":{}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(cb.HandleFunc).ServeHTTP(rr, req)
+	cancel()
+
+	assert.Equal(t, "ok", rr.Body.String())
+	assert.Equal(t, "span", value)
+	// handlers may outlive the request
+	assert.NoError(t, handed.Err())
+}
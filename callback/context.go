@@ -35,3 +35,25 @@ func RetryAfter(ctx context.Context, code int, date time.Time) {
 func Remove(ctx context.Context) {
 	ctx.Value(internal.CallbackRemove).(func())()
 }
+
+// detachedContext has the values of the parent context, but is never
+// canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -2,16 +2,41 @@ package events // import "github.com/SevereCloud/vksdk/v2/events"
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/SevereCloud/vksdk/v2/internal"
 )
 
-// GroupIDFromContext returns the GroupID from context.
+// GroupIDFromContext returns the GroupID from context. It returns zero if
+// the context has no GroupID.
 func GroupIDFromContext(ctx context.Context) int {
-	return ctx.Value(internal.GroupIDKey).(int)
+	groupID, _ := ctx.Value(internal.GroupIDKey).(int)
+
+	return groupID
 }
 
-// EventIDFromContext returns the EventID from context.
+// EventIDFromContext returns the EventID from context. It returns an empty
+// string if the context has no EventID.
 func EventIDFromContext(ctx context.Context) string {
-	return ctx.Value(internal.EventIDKey).(string)
+	eventID, _ := ctx.Value(internal.EventIDKey).(string)
+
+	return eventID
+}
+
+// TsFromContext returns the ts of the Bots Long Poll API response with the
+// event from context. It returns an empty string if the event was not
+// received by the longpoll.
+func TsFromContext(ctx context.Context) string {
+	ts, _ := ctx.Value(internal.LongPollTsKey).(string)
+
+	return ts
+}
+
+// RawObjectFromContext returns the untouched JSON of the event object from
+// context, e.g. to verify a signature of the payload or to decode fields
+// that the SDK does not model.
+func RawObjectFromContext(ctx context.Context) json.RawMessage {
+	object, _ := ctx.Value(internal.EventObjectKey).(json.RawMessage)
+
+	return object
 }
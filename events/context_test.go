@@ -15,6 +15,7 @@ func TestGroupIDFromContext(t *testing.T) {
 	const groupID = 123
 	ctx := context.WithValue(context.Background(), internal.GroupIDKey, groupID)
 	assert.Equal(t, groupID, events.GroupIDFromContext(ctx))
+	assert.Equal(t, 0, events.GroupIDFromContext(context.Background()))
 }
 
 func TestEventIDFromContext(t *testing.T) {
@@ -23,4 +24,42 @@ func TestEventIDFromContext(t *testing.T) {
 	const eventID = "123"
 	ctx := context.WithValue(context.Background(), internal.EventIDKey, eventID)
 	assert.Equal(t, eventID, events.EventIDFromContext(ctx))
+	assert.Equal(t, "", events.EventIDFromContext(context.Background()))
+}
+
+func TestTsFromContext(t *testing.T) {
+	t.Parallel()
+
+	const ts = "123"
+	ctx := context.WithValue(context.Background(), internal.LongPollTsKey, ts)
+	assert.Equal(t, ts, events.TsFromContext(ctx))
+	assert.Equal(t, "", events.TsFromContext(context.Background()))
+}
+
+func TestRawObjectFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, events.RawObjectFromContext(context.Background()))
+
+	fl := events.NewFuncList()
+
+	var raw []byte
+
+	fl.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			assert.Equal(t, e.Object, events.RawObjectFromContext(ctx))
+
+			return next(ctx, e)
+		}
+	})
+	fl.MessageNew(func(ctx context.Context, _ events.MessageNewObject) {
+		raw = events.RawObjectFromContext(ctx)
+	})
+
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte(`{"message":{"text":"hello"}}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"message":{"text":"hello"}}`, string(raw))
 }
@@ -0,0 +1,109 @@
+package events // import "github.com/SevereCloud/vksdk/v2/events"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// DefaultCacheSize is the size of the event cache used by Deduplicate
+// by default.
+const DefaultCacheSize = 1000
+
+// EventCache remembers IDs of handled events.
+type EventCache interface {
+	// Add adds the event ID and reports whether it was already added.
+	Add(eventID string) (exists bool)
+	// Remove removes the event ID.
+	Remove(eventID string)
+}
+
+// LRUCache is an in-memory EventCache that remembers the last added IDs.
+type LRUCache struct {
+	size  int
+	list  *list.List
+	items map[string]*list.Element
+	mux   sync.Mutex
+}
+
+// NewLRUCache returns a new LRUCache of the size.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:  size,
+		list:  list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// Add adds the event ID and reports whether it was already added.
+func (c *LRUCache) Add(eventID string) bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e, ok := c.items[eventID]; ok {
+		c.list.MoveToFront(e)
+		return true
+	}
+
+	c.items[eventID] = c.list.PushFront(eventID)
+
+	if c.list.Len() > c.size {
+		oldest := c.list.Back()
+		c.list.Remove(oldest)
+		delete(c.items, oldest.Value.(string))
+	}
+
+	return false
+}
+
+// Remove removes the event ID.
+func (c *LRUCache) Remove(eventID string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e, ok := c.items[eventID]; ok {
+		c.list.Remove(e)
+		delete(c.items, eventID)
+	}
+}
+
+// Len returns the number of IDs in the cache.
+func (c *LRUCache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.list.Len()
+}
+
+// Deduplicate returns a middleware that skips events with an already handled
+// event_id, so handlers see each redelivered event once.
+//
+// If the handling returns an error, the event ID is removed from the cache,
+// so the event can be handled again. If cache is nil, LRUCache of
+// DefaultCacheSize is used.
+//
+//	lp.Use(events.Deduplicate(nil))
+func Deduplicate(cache EventCache) func(Handler) Handler {
+	if cache == nil {
+		cache = NewLRUCache(DefaultCacheSize)
+	}
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, e GroupEvent) error {
+			if e.EventID == "" {
+				return next(ctx, e)
+			}
+
+			if cache.Add(e.EventID) {
+				return nil
+			}
+
+			err := next(ctx, e)
+			if err != nil {
+				cache.Remove(e.EventID)
+			}
+
+			return err
+		}
+	}
+}
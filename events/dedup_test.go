@@ -0,0 +1,74 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Parallel()
+
+	c := events.NewLRUCache(2)
+
+	assert.False(t, c.Add("1"))
+	assert.False(t, c.Add("2"))
+	assert.True(t, c.Add("1"))
+	assert.False(t, c.Add("3")) // evicts "2"
+	assert.Equal(t, 2, c.Len())
+	assert.False(t, c.Add("2"))
+	assert.True(t, c.Add("3"))
+
+	c.Remove("3")
+	c.Remove("unknown")
+	assert.False(t, c.Add("3"))
+}
+
+func TestDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler")
+
+	var (
+		handled []string
+		fail    bool
+	)
+
+	fl := events.NewFuncList()
+	fl.Use(events.Deduplicate(nil))
+	fl.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			if fail {
+				return errHandler
+			}
+
+			handled = append(handled, e.EventID)
+
+			return next(ctx, e)
+		}
+	})
+
+	f := func(eventID string, wantErr error) {
+		t.Helper()
+
+		err := fl.Handler(context.Background(), events.GroupEvent{Type: "test", EventID: eventID})
+		assert.Equal(t, wantErr, err)
+	}
+
+	f("1", nil)
+	f("1", nil)
+	f("", nil)
+	f("", nil)
+
+	fail = true
+	f("2", errHandler)
+
+	fail = false
+	f("2", nil)
+	f("2", nil)
+
+	assert.Equal(t, []string{"1", "", "", "2"}, handled)
+}
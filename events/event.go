@@ -0,0 +1,289 @@
+package events // import "github.com/SevereCloud/vksdk/v2/events"
+
+// Event is implemented by objects of events.
+//
+// A type that implements Event can be handled with On, even if FuncList has
+// no dedicated method for it.
+type Event interface {
+	EventType() EventType
+}
+
+// EventType returns EventMessageNew.
+func (MessageNewObject) EventType() EventType {
+	return EventMessageNew
+}
+
+// EventType returns EventMessageReply.
+func (MessageReplyObject) EventType() EventType {
+	return EventMessageReply
+}
+
+// EventType returns EventMessageEdit.
+func (MessageEditObject) EventType() EventType {
+	return EventMessageEdit
+}
+
+// EventType returns EventMessageAllow.
+func (MessageAllowObject) EventType() EventType {
+	return EventMessageAllow
+}
+
+// EventType returns EventMessageDeny.
+func (MessageDenyObject) EventType() EventType {
+	return EventMessageDeny
+}
+
+// EventType returns EventMessageTypingState.
+func (MessageTypingStateObject) EventType() EventType {
+	return EventMessageTypingState
+}
+
+// EventType returns EventMessageEvent.
+func (MessageEventObject) EventType() EventType {
+	return EventMessageEvent
+}
+
+// EventType returns EventMessageReactionEvent.
+func (MessageReactionEventObject) EventType() EventType {
+	return EventMessageReactionEvent
+}
+
+// EventType returns EventPhotoNew.
+func (PhotoNewObject) EventType() EventType {
+	return EventPhotoNew
+}
+
+// EventType returns EventPhotoCommentNew.
+func (PhotoCommentNewObject) EventType() EventType {
+	return EventPhotoCommentNew
+}
+
+// EventType returns EventPhotoCommentEdit.
+func (PhotoCommentEditObject) EventType() EventType {
+	return EventPhotoCommentEdit
+}
+
+// EventType returns EventPhotoCommentRestore.
+func (PhotoCommentRestoreObject) EventType() EventType {
+	return EventPhotoCommentRestore
+}
+
+// EventType returns EventPhotoCommentDelete.
+func (PhotoCommentDeleteObject) EventType() EventType {
+	return EventPhotoCommentDelete
+}
+
+// EventType returns EventAudioNew.
+func (AudioNewObject) EventType() EventType {
+	return EventAudioNew
+}
+
+// EventType returns EventVideoNew.
+func (VideoNewObject) EventType() EventType {
+	return EventVideoNew
+}
+
+// EventType returns EventVideoCommentNew.
+func (VideoCommentNewObject) EventType() EventType {
+	return EventVideoCommentNew
+}
+
+// EventType returns EventVideoCommentEdit.
+func (VideoCommentEditObject) EventType() EventType {
+	return EventVideoCommentEdit
+}
+
+// EventType returns EventVideoCommentRestore.
+func (VideoCommentRestoreObject) EventType() EventType {
+	return EventVideoCommentRestore
+}
+
+// EventType returns EventVideoCommentDelete.
+func (VideoCommentDeleteObject) EventType() EventType {
+	return EventVideoCommentDelete
+}
+
+// EventType returns EventWallPostNew.
+func (WallPostNewObject) EventType() EventType {
+	return EventWallPostNew
+}
+
+// EventType returns EventWallRepost.
+func (WallRepostObject) EventType() EventType {
+	return EventWallRepost
+}
+
+// EventType returns EventWallReplyNew.
+func (WallReplyNewObject) EventType() EventType {
+	return EventWallReplyNew
+}
+
+// EventType returns EventWallReplyEdit.
+func (WallReplyEditObject) EventType() EventType {
+	return EventWallReplyEdit
+}
+
+// EventType returns EventWallReplyRestore.
+func (WallReplyRestoreObject) EventType() EventType {
+	return EventWallReplyRestore
+}
+
+// EventType returns EventWallReplyDelete.
+func (WallReplyDeleteObject) EventType() EventType {
+	return EventWallReplyDelete
+}
+
+// EventType returns EventBoardPostNew.
+func (BoardPostNewObject) EventType() EventType {
+	return EventBoardPostNew
+}
+
+// EventType returns EventBoardPostEdit.
+func (BoardPostEditObject) EventType() EventType {
+	return EventBoardPostEdit
+}
+
+// EventType returns EventBoardPostRestore.
+func (BoardPostRestoreObject) EventType() EventType {
+	return EventBoardPostRestore
+}
+
+// EventType returns EventBoardPostDelete.
+func (BoardPostDeleteObject) EventType() EventType {
+	return EventBoardPostDelete
+}
+
+// EventType returns EventMarketCommentNew.
+func (MarketCommentNewObject) EventType() EventType {
+	return EventMarketCommentNew
+}
+
+// EventType returns EventMarketCommentEdit.
+func (MarketCommentEditObject) EventType() EventType {
+	return EventMarketCommentEdit
+}
+
+// EventType returns EventMarketCommentRestore.
+func (MarketCommentRestoreObject) EventType() EventType {
+	return EventMarketCommentRestore
+}
+
+// EventType returns EventMarketCommentDelete.
+func (MarketCommentDeleteObject) EventType() EventType {
+	return EventMarketCommentDelete
+}
+
+// EventType returns EventMarketOrderNew.
+func (MarketOrderNewObject) EventType() EventType {
+	return EventMarketOrderNew
+}
+
+// EventType returns EventMarketOrderEdit.
+func (MarketOrderEditObject) EventType() EventType {
+	return EventMarketOrderEdit
+}
+
+// EventType returns EventGroupLeave.
+func (GroupLeaveObject) EventType() EventType {
+	return EventGroupLeave
+}
+
+// EventType returns EventGroupJoin.
+func (GroupJoinObject) EventType() EventType {
+	return EventGroupJoin
+}
+
+// EventType returns EventUserBlock.
+func (UserBlockObject) EventType() EventType {
+	return EventUserBlock
+}
+
+// EventType returns EventUserUnblock.
+func (UserUnblockObject) EventType() EventType {
+	return EventUserUnblock
+}
+
+// EventType returns EventPollVoteNew.
+func (PollVoteNewObject) EventType() EventType {
+	return EventPollVoteNew
+}
+
+// EventType returns EventGroupOfficersEdit.
+func (GroupOfficersEditObject) EventType() EventType {
+	return EventGroupOfficersEdit
+}
+
+// EventType returns EventGroupChangeSettings.
+func (GroupChangeSettingsObject) EventType() EventType {
+	return EventGroupChangeSettings
+}
+
+// EventType returns EventGroupChangePhoto.
+func (GroupChangePhotoObject) EventType() EventType {
+	return EventGroupChangePhoto
+}
+
+// EventType returns EventVkpayTransaction.
+func (VkpayTransactionObject) EventType() EventType {
+	return EventVkpayTransaction
+}
+
+// EventType returns EventLeadFormsNew.
+func (LeadFormsNewObject) EventType() EventType {
+	return EventLeadFormsNew
+}
+
+// EventType returns EventAppPayload.
+func (AppPayloadObject) EventType() EventType {
+	return EventAppPayload
+}
+
+// EventType returns EventMessageRead.
+func (MessageReadObject) EventType() EventType {
+	return EventMessageRead
+}
+
+// EventType returns EventLikeAdd.
+func (LikeAddObject) EventType() EventType {
+	return EventLikeAdd
+}
+
+// EventType returns EventLikeRemove.
+func (LikeRemoveObject) EventType() EventType {
+	return EventLikeRemove
+}
+
+// EventType returns EventDonutSubscriptionCreate.
+func (DonutSubscriptionCreateObject) EventType() EventType {
+	return EventDonutSubscriptionCreate
+}
+
+// EventType returns EventDonutSubscriptionProlonged.
+func (DonutSubscriptionProlongedObject) EventType() EventType {
+	return EventDonutSubscriptionProlonged
+}
+
+// EventType returns EventDonutSubscriptionExpired.
+func (DonutSubscriptionExpiredObject) EventType() EventType {
+	return EventDonutSubscriptionExpired
+}
+
+// EventType returns EventDonutSubscriptionCancelled.
+func (DonutSubscriptionCancelledObject) EventType() EventType {
+	return EventDonutSubscriptionCancelled
+}
+
+// EventType returns EventDonutSubscriptionPriceChanged.
+func (DonutSubscriptionPriceChangedObject) EventType() EventType {
+	return EventDonutSubscriptionPriceChanged
+}
+
+// EventType returns EventDonutMoneyWithdraw.
+func (DonutMoneyWithdrawObject) EventType() EventType {
+	return EventDonutMoneyWithdraw
+}
+
+// EventType returns EventDonutMoneyWithdrawError.
+func (DonutMoneyWithdrawErrorObject) EventType() EventType {
+	return EventDonutMoneyWithdrawError
+}
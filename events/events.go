@@ -8,6 +8,8 @@ package events // import "github.com/SevereCloud/vksdk/v2/events"
 import (
 	"context"
 	"encoding/json"
+	"reflect"
+	"sync"
 
 	"github.com/SevereCloud/vksdk/v2/internal"
 )
@@ -25,6 +27,7 @@ const (
 	EventMessageDeny                   = "message_deny"
 	EventMessageTypingState            = "message_typing_state"
 	EventMessageEvent                  = "message_event"
+	EventMessageReactionEvent          = "message_reaction_event"
 	EventPhotoNew                      = "photo_new"
 	EventPhotoCommentNew               = "photo_comment_new"
 	EventPhotoCommentEdit              = "photo_comment_edit"
@@ -75,17 +78,85 @@ const (
 	EventDonutMoneyWithdrawError       = "donut_money_withdraw_error"
 )
 
+//go:generate easyjson -build_tags easyjson events.go
+
 // GroupEvent struct.
+//
+//easyjson:json
 type GroupEvent struct {
 	Type    EventType       `json:"type"`
 	Object  json.RawMessage `json:"object"`
 	GroupID int             `json:"group_id"`
 	EventID string          `json:"event_id"`
+	V       string          `json:"v,omitempty"` // API version of the object
 	Secret  string          `json:"secret"`
 }
 
+// MarshalCompat returns the event in the format of VK, e.g. to forward an
+// event received by the longpoll to a Callback API compatible endpoint or a
+// queue. Unlike json.Marshal, the secret is omitted if it is empty.
+func (e GroupEvent) MarshalCompat() ([]byte, error) {
+	object := e.Object
+	if len(object) == 0 {
+		object = json.RawMessage("{}")
+	}
+
+	return json.Marshal(struct {
+		GroupID int             `json:"group_id"`
+		Type    EventType       `json:"type"`
+		EventID string          `json:"event_id"`
+		V       string          `json:"v,omitempty"`
+		Object  json.RawMessage `json:"object"`
+		Secret  string          `json:"secret,omitempty"`
+	}{
+		GroupID: e.GroupID,
+		Type:    e.Type,
+		EventID: e.EventID,
+		V:       e.V,
+		Object:  object,
+		Secret:  e.Secret,
+	})
+}
+
+// Handler handles a group event.
+type Handler func(context.Context, GroupEvent) error
+
+// DispatchMode specifies how the handlers of an event type are invoked.
+type DispatchMode int
+
+// Dispatch modes.
+const (
+	// DispatchDefault invokes handlers as set by Goroutine.
+	DispatchDefault DispatchMode = iota
+	// DispatchSync invokes handlers one by one before Handler returns,
+	// e.g. to answer callback buttons fast.
+	DispatchSync
+	// DispatchAsync invokes each handler in a goroutine.
+	DispatchAsync
+)
+
+// HandlerID identifies a registered handler.
+type HandlerID uint64
+
+// Keys of handlers that are not bound to an event type.
+const (
+	eventUnknown    EventType = ""
+	eventEverything EventType = "*"
+)
+
 // FuncList struct.
+//
+// Handlers can be registered and removed while events are being handled,
+// e.g. by plugins after the bot is started. Registration takes effect for
+// the events that are handled after it.
 type FuncList struct {
+	// funcList is a pointer, so Handler and ListEvents of a copy of
+	// FuncList see the handlers registered later. It is nil for the zero
+	// value until the first registration.
+	*funcList
+}
+
+type funcList struct {
 	messageNew                    []func(context.Context, MessageNewObject)
 	messageReply                  []func(context.Context, MessageReplyObject)
 	messageEdit                   []func(context.Context, MessageEditObject)
@@ -93,6 +164,7 @@ type FuncList struct {
 	messageDeny                   []func(context.Context, MessageDenyObject)
 	messageTypingState            []func(context.Context, MessageTypingStateObject)
 	messageEvent                  []func(context.Context, MessageEventObject)
+	messageReactionEvent          []func(context.Context, MessageReactionEventObject)
 	photoNew                      []func(context.Context, PhotoNewObject)
 	photoCommentNew               []func(context.Context, PhotoCommentNewObject)
 	photoCommentEdit              []func(context.Context, PhotoCommentEditObject)
@@ -141,30 +213,145 @@ type FuncList struct {
 	donutSubscriptionPriceChanged []func(context.Context, DonutSubscriptionPriceChangedObject)
 	donutMoneyWithdraw            []func(context.Context, DonutMoneyWithdrawObject)
 	donutMoneyWithdrawError       []func(context.Context, DonutMoneyWithdrawErrorObject)
-	special                       map[EventType][]func(context.Context, GroupEvent)
+	special                       map[EventType][]func(context.Context, GroupEvent) error
+	unknown                       []func(context.Context, GroupEvent)
+	everything                    []func(context.Context, EventType, GroupEvent)
 	eventsList                    []EventType
-
-	goroutine bool
+	middlewares                   []func(Handler) Handler
+	allowEvents                   map[EventType]struct{}
+	ignoreEvents                  map[EventType]struct{}
+	handlerIDs                    map[EventType][]HandlerID
+	specialIDs                    map[EventType][]HandlerID
+	lastID                        HandlerID
+	priorities                    map[HandlerID]int
+	dispatchModes                 map[EventType]DispatchMode
+
+	// mux is nil for the zero value of FuncList, which is not safe for
+	// concurrent use.
+	mux        *sync.RWMutex
+	goroutine  bool
+	asyncPanic func(GroupEvent, interface{})
+	json       JSONCodec
 }
 
 // NewFuncList returns a new FuncList.
 func NewFuncList() *FuncList {
 	return &FuncList{
-		special: make(map[EventType][]func(context.Context, GroupEvent)),
+		funcList: &funcList{
+			special: make(map[EventType][]func(context.Context, GroupEvent) error),
+			mux:     new(sync.RWMutex),
+		},
+	}
+}
+
+func (fl *FuncList) lock() {
+	if fl.funcList == nil {
+		fl.funcList = new(funcList)
+	}
+
+	if fl.mux != nil {
+		fl.mux.Lock()
+	}
+}
+
+func (fl *FuncList) unlock() {
+	if fl.mux != nil {
+		fl.mux.Unlock()
+	}
+}
+
+func (fl *FuncList) rlock() {
+	if fl.mux != nil {
+		fl.mux.RLock()
+	}
+}
+
+func (fl *FuncList) runlock() {
+	if fl.mux != nil {
+		fl.mux.RUnlock()
 	}
 }
 
 // Handler group event handler.
-func (fl FuncList) Handler(ctx context.Context, e GroupEvent) error { // nolint:gocyclo
+func (fl FuncList) Handler(ctx context.Context, e GroupEvent) error {
+	// the zero value has no handlers, but objects are still decoded
+	if fl.funcList == nil {
+		fl.funcList = new(funcList)
+	}
+
+	fl.rlock()
+
+	if !fl.allowed(e.Type) {
+		fl.runlock()
+
+		return nil
+	}
+
+	snapshot := fl.snapshot(e.Type)
+	fl.runlock()
+
 	ctx = context.WithValue(ctx, internal.GroupIDKey, e.GroupID)
 	ctx = context.WithValue(ctx, internal.EventIDKey, e.EventID)
+	ctx = context.WithValue(ctx, internal.EventObjectKey, e.Object)
+
+	h := snapshot.handler
+	for i := len(snapshot.middlewares) - 1; i >= 0; i-- {
+		h = snapshot.middlewares[i](h)
+	}
+
+	return h(ctx, e)
+}
+
+// snapshot returns a copy of FuncList with the handlers of the event type
+// that is used without the lock.
+//
+// Slices of handlers are never changed in place, appending does not
+// change the elements of the copy, and removing and sorting replace the
+// slices.
+func (fl *FuncList) snapshot(eventType EventType) FuncList {
+	snapshot := *fl.funcList
+	snapshot.special = nil
+
+	switch fl.dispatchModes[eventType] {
+	case DispatchSync:
+		snapshot.goroutine = false
+	case DispatchAsync:
+		snapshot.goroutine = true
+	case DispatchDefault:
+	}
+
+	if sliceFunc, ok := fl.special[eventType]; ok {
+		snapshot.special = map[EventType][]func(context.Context, GroupEvent) error{
+			eventType: sliceFunc,
+		}
+	}
+
+	return FuncList{funcList: &snapshot}
+}
+
+func (fl FuncList) handler(ctx context.Context, e GroupEvent) error { // nolint:gocyclo
+	for _, f := range fl.everything {
+		if fl.goroutine {
+			go func(f func(context.Context, EventType, GroupEvent)) {
+				defer recoverAsync(e, fl.asyncPanic)
+
+				f(ctx, e.Type, e)
+			}(f)
+		} else {
+			f(ctx, e.Type, e)
+		}
+	}
 
 	if sliceFunc, ok := fl.special[e.Type]; ok {
 		for _, f := range sliceFunc {
 			if fl.goroutine {
-				go func() { f(ctx, e) }()
-			} else {
-				f(ctx, e)
+				go func(f func(context.Context, GroupEvent) error) {
+					defer recoverAsync(e, fl.asyncPanic)
+
+					_ = f(ctx, e)
+				}(f)
+			} else if err := f(ctx, e); err != nil {
+				return err
 			}
 		}
 	}
@@ -172,719 +359,744 @@ func (fl FuncList) Handler(ctx context.Context, e GroupEvent) error { // nolint:
 	switch e.Type {
 	case EventMessageNew:
 		var obj MessageNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageReply:
 		var obj MessageReplyObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageReply {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageEdit:
 		var obj MessageEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageAllow:
 		var obj MessageAllowObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageAllow {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageDeny:
 		var obj MessageDenyObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageDeny {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageTypingState: // На основе ответа
 		var obj MessageTypingStateObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageTypingState {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageEvent:
 		var obj MessageEventObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageEvent {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
+			} else {
+				f(ctx, obj)
+			}
+		}
+	case EventMessageReactionEvent:
+		var obj MessageReactionEventObject
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
+			return err
+		}
+
+		for _, f := range fl.messageReactionEvent {
+			if fl.goroutine {
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPhotoNew:
 		var obj PhotoNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.photoNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPhotoCommentNew:
 		var obj PhotoCommentNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.photoCommentNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPhotoCommentEdit:
 		var obj PhotoCommentEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.photoCommentEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPhotoCommentRestore:
 		var obj PhotoCommentRestoreObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.photoCommentRestore {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPhotoCommentDelete:
 		var obj PhotoCommentDeleteObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.photoCommentDelete {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventAudioNew:
 		var obj AudioNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.audioNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVideoNew:
 		var obj VideoNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.videoNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVideoCommentNew:
 		var obj VideoCommentNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.videoCommentNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVideoCommentEdit:
 		var obj VideoCommentEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.videoCommentEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVideoCommentRestore:
 		var obj VideoCommentRestoreObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.videoCommentRestore {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVideoCommentDelete:
 		var obj VideoCommentDeleteObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.videoCommentDelete {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallPostNew:
 		var obj WallPostNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallPostNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallRepost:
 		var obj WallRepostObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallRepost {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallReplyNew:
 		var obj WallReplyNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallReplyNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallReplyEdit:
 		var obj WallReplyEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallReplyEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallReplyRestore:
 		var obj WallReplyRestoreObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallReplyRestore {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventWallReplyDelete:
 		var obj WallReplyDeleteObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.wallReplyDelete {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventBoardPostNew:
 		var obj BoardPostNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.boardPostNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventBoardPostEdit:
 		var obj BoardPostEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.boardPostEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventBoardPostRestore:
 		var obj BoardPostRestoreObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.boardPostRestore {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventBoardPostDelete:
 		var obj BoardPostDeleteObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.boardPostDelete {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketCommentNew:
 		var obj MarketCommentNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketCommentNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketCommentEdit:
 		var obj MarketCommentEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketCommentEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketCommentRestore:
 		var obj MarketCommentRestoreObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketCommentRestore {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketCommentDelete:
 		var obj MarketCommentDeleteObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketCommentDelete {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketOrderNew:
 		var obj MarketOrderNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketOrderNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMarketOrderEdit:
 		var obj MarketOrderEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.marketOrderEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventGroupLeave:
 		var obj GroupLeaveObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.groupLeave {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventGroupJoin:
 		var obj GroupJoinObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.groupJoin {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventUserBlock:
 		var obj UserBlockObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.userBlock {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventUserUnblock:
 		var obj UserUnblockObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.userUnblock {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventPollVoteNew:
 		var obj PollVoteNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.pollVoteNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventGroupOfficersEdit:
 		var obj GroupOfficersEditObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.groupOfficersEdit {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventGroupChangeSettings:
 		var obj GroupChangeSettingsObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.groupChangeSettings {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventGroupChangePhoto:
 		var obj GroupChangePhotoObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.groupChangePhoto {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventVkpayTransaction:
 		var obj VkpayTransactionObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.vkpayTransaction {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventLeadFormsNew:
 		var obj LeadFormsNewObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.leadFormsNew {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventAppPayload:
 		var obj AppPayloadObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.appPayload {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventMessageRead:
 		var obj MessageReadObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.messageRead {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventLikeAdd:
 		var obj LikeAddObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.likeAdd {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventLikeRemove:
 		var obj LikeRemoveObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.likeRemove {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutSubscriptionCreate:
 		var obj DonutSubscriptionCreateObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutSubscriptionCreate {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutSubscriptionProlonged:
 		var obj DonutSubscriptionProlongedObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutSubscriptionProlonged {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutSubscriptionExpired:
 		var obj DonutSubscriptionExpiredObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutSubscriptionExpired {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutSubscriptionCancelled:
 		var obj DonutSubscriptionCancelledObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutSubscriptionCancelled {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutSubscriptionPriceChanged:
 		var obj DonutSubscriptionPriceChangedObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutSubscriptionPriceChanged {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutMoneyWithdraw:
 		var obj DonutMoneyWithdrawObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutMoneyWithdraw {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
 	case EventDonutMoneyWithdrawError:
 		var obj DonutMoneyWithdrawErrorObject
-		if err := json.Unmarshal(e.Object, &obj); err != nil {
+		if err := unmarshal(fl.json, e.Object, &obj); err != nil {
 			return err
 		}
 
 		for _, f := range fl.donutMoneyWithdrawError {
 			if fl.goroutine {
-				go func() { f(ctx, obj) }()
+				go invokeAsync(ctx, fl.asyncPanic, e, f, obj)
 			} else {
 				f(ctx, obj)
 			}
 		}
+	default:
+		if len(fl.special[e.Type]) > 0 {
+			break
+		}
+
+		for _, f := range fl.unknown {
+			if fl.goroutine {
+				go invokeAsync(ctx, fl.asyncPanic, e, f, e)
+			} else {
+				f(ctx, e)
+			}
+		}
 	}
 
 	return nil
@@ -892,350 +1104,1225 @@ func (fl FuncList) Handler(ctx context.Context, e GroupEvent) error { // nolint:
 
 // ListEvents return list of events.
 func (fl FuncList) ListEvents() []EventType {
-	return fl.eventsList
+	if fl.funcList == nil {
+		return nil
+	}
+
+	fl.rlock()
+	defer fl.runlock()
+
+	return append([]EventType(nil), fl.eventsList...)
 }
 
 // Goroutine invoke functions in a goroutine.
 func (fl *FuncList) Goroutine(v bool) {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.goroutine = v
 }
 
+// OnAsyncPanic enables recovery of panics in handlers invoked in a
+// goroutine by Goroutine(true) or DispatchAsync. The recovered value is
+// passed to f. Without f such a panic crashes the program.
+func (fl *FuncList) OnAsyncPanic(f func(e GroupEvent, v interface{})) {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.asyncPanic = f
+}
+
+// invokeAsync invokes the handler in a goroutine.
+func invokeAsync[T any](
+	ctx context.Context,
+	onPanic func(GroupEvent, interface{}),
+	e GroupEvent,
+	f func(context.Context, T),
+	obj T,
+) {
+	defer recoverAsync(e, onPanic)
+
+	f(ctx, obj)
+}
+
+// recoverAsync passes the panic of the handler invoked in a goroutine to
+// onPanic. Without onPanic the panic is not recovered.
+func recoverAsync(e GroupEvent, onPanic func(GroupEvent, interface{})) {
+	if onPanic == nil {
+		return
+	}
+
+	if v := recover(); v != nil {
+		onPanic(e, v)
+	}
+}
+
+// JSONCodec encodes and decodes JSON, for example,
+// jsoniter.ConfigCompatibleWithStandardLibrary.
+type JSONCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// SetJSONCodec sets the codec of objects of events. If c is nil,
+// encoding/json is used.
+func (fl *FuncList) SetJSONCodec(c JSONCodec) {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.json = c
+}
+
+// unmarshal decodes the object of the event with the codec or, if it is
+// nil, with encoding/json.
+func unmarshal(c JSONCodec, data []byte, v interface{}) error {
+	if c != nil {
+		return c.Unmarshal(data, v)
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// SetDispatchMode sets the dispatch mode of the event types, overriding
+// Goroutine for them.
+//
+//	fl.Goroutine(true)
+//	fl.SetDispatchMode(events.DispatchSync, events.EventMessageEvent)
+func (fl *FuncList) SetDispatchMode(mode DispatchMode, eventTypes ...EventType) {
+	fl.lock()
+	defer fl.unlock()
+
+	if fl.dispatchModes == nil {
+		fl.dispatchModes = make(map[EventType]DispatchMode, len(eventTypes))
+	}
+
+	for _, eventType := range eventTypes {
+		fl.dispatchModes[eventType] = mode
+	}
+}
+
+// Use adds middlewares that wrap the handling of all events.
+//
+// Middlewares are applied in the order they are added, so the first one
+// is the outermost.
+//
+//	fl.Use(func(next events.Handler) events.Handler {
+//		return func(ctx context.Context, e events.GroupEvent) error {
+//			log.Print(e.Type)
+//			return next(ctx, e)
+//		}
+//	})
+func (fl *FuncList) Use(middlewares ...func(Handler) Handler) {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.middlewares = append(fl.middlewares, middlewares...)
+}
+
+// AllowEvents sets event types that are handled. Events of other types are
+// skipped before decoding.
+func (fl *FuncList) AllowEvents(eventTypes ...EventType) {
+	fl.lock()
+	defer fl.unlock()
+
+	if fl.allowEvents == nil {
+		fl.allowEvents = make(map[EventType]struct{}, len(eventTypes))
+	}
+
+	for _, eventType := range eventTypes {
+		fl.allowEvents[eventType] = struct{}{}
+	}
+}
+
+// IgnoreEvents sets event types that are skipped before decoding.
+func (fl *FuncList) IgnoreEvents(eventTypes ...EventType) {
+	fl.lock()
+	defer fl.unlock()
+
+	if fl.ignoreEvents == nil {
+		fl.ignoreEvents = make(map[EventType]struct{}, len(eventTypes))
+	}
+
+	for _, eventType := range eventTypes {
+		fl.ignoreEvents[eventType] = struct{}{}
+	}
+}
+
+func (fl FuncList) allowed(eventType EventType) bool {
+	if _, ok := fl.ignoreEvents[eventType]; ok {
+		return false
+	}
+
+	if fl.allowEvents == nil {
+		return true
+	}
+
+	_, ok := fl.allowEvents[eventType]
+
+	return ok
+}
+
 // OnEvent handler.
-func (fl *FuncList) OnEvent(eventType EventType, f func(context.Context, GroupEvent)) {
+func (fl *FuncList) OnEvent(eventType EventType, f func(context.Context, GroupEvent)) HandlerID {
+	return fl.onEvent(eventType, func(ctx context.Context, e GroupEvent) error {
+		f(ctx, e)
+
+		return nil
+	})
+}
+
+func (fl *FuncList) onEvent(eventType EventType, f func(context.Context, GroupEvent) error) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	if fl.special == nil {
-		fl.special = make(map[EventType][]func(context.Context, GroupEvent))
+		fl.special = make(map[EventType][]func(context.Context, GroupEvent) error)
 	}
 
 	fl.special[eventType] = append(fl.special[eventType], f)
 	fl.eventsList = append(fl.eventsList, eventType)
+
+	return fl.register(eventType, true)
+}
+
+// OnUnknown handler.
+//
+// The handler is called for events of types that have neither a typed
+// handler in the SDK nor a handler registered with OnEvent, e.g. events
+// introduced by new versions of VK API. Unknown events must be enabled
+// in the community settings.
+func (fl *FuncList) OnUnknown(f func(context.Context, GroupEvent)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.unknown = append(fl.unknown, f)
+
+	return fl.register(eventUnknown, false)
+}
+
+// OnEverything handler.
+//
+// The handler is called for every event before the handlers of its type,
+// e.g. for audit logging. It does not subscribe the community to events.
+func (fl *FuncList) OnEverything(f func(ctx context.Context, eventType EventType, e GroupEvent)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.everything = append(fl.everything, f)
+
+	return fl.register(eventEverything, false)
+}
+
+// register returns the ID of the last handler of the event type.
+func (fl *FuncList) register(eventType EventType, special bool) HandlerID {
+	fl.lastID++
+
+	ids := &fl.handlerIDs
+	if special {
+		ids = &fl.specialIDs
+	}
+
+	if *ids == nil {
+		*ids = make(map[EventType][]HandlerID)
+	}
+
+	(*ids)[eventType] = append((*ids)[eventType], fl.lastID)
+
+	// keep handlers with a negative priority last
+	if len(fl.priorities) > 0 {
+		sortByPriority((*ids)[eventType], fl.swapper(eventType, special), fl.priorities)
+	}
+
+	return fl.lastID
+}
+
+// Remove unregisters the handler with the ID returned by the registration.
+// It returns false if the handler is not found.
+//
+//	id := fl.MessageNew(f)
+//	fl.Remove(id)
+func (fl *FuncList) Remove(id HandlerID) bool {
+	fl.lock()
+	defer fl.unlock()
+
+	for eventType, ids := range fl.specialIDs {
+		if i := indexID(ids, id); i >= 0 {
+			delete(fl.priorities, id)
+			fl.specialIDs[eventType] = append(ids[:i], ids[i+1:]...)
+			fl.special[eventType] = append(fl.special[eventType][:i:i], fl.special[eventType][i+1:]...)
+			fl.removeEvent(eventType)
+
+			return true
+		}
+	}
+
+	for eventType, ids := range fl.handlerIDs {
+		if i := indexID(ids, id); i >= 0 {
+			delete(fl.priorities, id)
+			fl.handlerIDs[eventType] = append(ids[:i], ids[i+1:]...)
+			fl.removeFunc(eventType, i)
+			fl.removeEvent(eventType)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func indexID(ids []HandlerID, id HandlerID) int {
+	for i := range ids {
+		if ids[i] == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// removeEvent removes the last occurrence of the event type from the list
+// of events.
+func (fl *FuncList) removeEvent(eventType EventType) {
+	for i := len(fl.eventsList) - 1; i >= 0; i-- {
+		if fl.eventsList[i] == eventType {
+			fl.eventsList = append(fl.eventsList[:i:i], fl.eventsList[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// SetPriority sets the priority of the handler with the ID returned by the
+// registration. Handlers of the same event type are called in the order of
+// decreasing priority, handlers with the same priority are called in the
+// order of registration. The default priority is zero.
+//
+// Handlers registered with OnEvent and On are called before the typed
+// handlers and are ordered separately.
+//
+//	id := fl.MessageNew(checkAccess)
+//	fl.SetPriority(id, 100)
+//
+// It returns false if the handler is not found.
+func (fl *FuncList) SetPriority(id HandlerID, priority int) bool {
+	fl.lock()
+	defer fl.unlock()
+
+	for eventType, ids := range fl.specialIDs {
+		if indexID(ids, id) >= 0 {
+			fl.setPriority(id, priority)
+			sortByPriority(ids, fl.swapper(eventType, true), fl.priorities)
+
+			return true
+		}
+	}
+
+	for eventType, ids := range fl.handlerIDs {
+		if indexID(ids, id) >= 0 {
+			fl.setPriority(id, priority)
+			sortByPriority(ids, fl.swapper(eventType, false), fl.priorities)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (fl *FuncList) setPriority(id HandlerID, priority int) {
+	if fl.priorities == nil {
+		fl.priorities = make(map[HandlerID]int)
+	}
+
+	fl.priorities[id] = priority
+}
+
+// swapper replaces the handlers of the event type with a copy and returns
+// the function that swaps the handlers of the copy, so the handlers that are
+// being dispatched are not changed.
+func (fl *FuncList) swapper(eventType EventType, special bool) func(i, j int) {
+	if special {
+		fl.special[eventType] = append([]func(context.Context, GroupEvent) error(nil), fl.special[eventType]...)
+
+		return reflect.Swapper(fl.special[eventType])
+	}
+
+	funcs := reflect.ValueOf(fl.funcs(eventType)).Elem()
+	clone := reflect.MakeSlice(funcs.Type(), funcs.Len(), funcs.Len())
+	reflect.Copy(clone, funcs)
+	funcs.Set(clone)
+
+	return reflect.Swapper(clone.Interface())
+}
+
+// sortByPriority stably sorts the IDs and handlers by decreasing priority.
+func sortByPriority(ids []HandlerID, swap func(i, j int), priorities map[HandlerID]int) {
+	for i := 1; i < len(ids); i++ {
+		for j := i; j > 0 && priorities[ids[j]] > priorities[ids[j-1]]; j-- {
+			ids[j], ids[j-1] = ids[j-1], ids[j]
+			swap(j, j-1)
+		}
+	}
+}
+
+// funcs returns the pointer to the typed handlers of the event type.
+func (fl *FuncList) funcs(eventType EventType) interface{} { // nolint:gocyclo
+	switch eventType {
+	case EventMessageNew:
+		return &fl.messageNew
+	case EventMessageReply:
+		return &fl.messageReply
+	case EventMessageEdit:
+		return &fl.messageEdit
+	case EventMessageAllow:
+		return &fl.messageAllow
+	case EventMessageDeny:
+		return &fl.messageDeny
+	case EventMessageTypingState:
+		return &fl.messageTypingState
+	case EventMessageEvent:
+		return &fl.messageEvent
+	case EventMessageReactionEvent:
+		return &fl.messageReactionEvent
+	case EventPhotoNew:
+		return &fl.photoNew
+	case EventPhotoCommentNew:
+		return &fl.photoCommentNew
+	case EventPhotoCommentEdit:
+		return &fl.photoCommentEdit
+	case EventPhotoCommentRestore:
+		return &fl.photoCommentRestore
+	case EventPhotoCommentDelete:
+		return &fl.photoCommentDelete
+	case EventAudioNew:
+		return &fl.audioNew
+	case EventVideoNew:
+		return &fl.videoNew
+	case EventVideoCommentNew:
+		return &fl.videoCommentNew
+	case EventVideoCommentEdit:
+		return &fl.videoCommentEdit
+	case EventVideoCommentRestore:
+		return &fl.videoCommentRestore
+	case EventVideoCommentDelete:
+		return &fl.videoCommentDelete
+	case EventWallPostNew:
+		return &fl.wallPostNew
+	case EventWallRepost:
+		return &fl.wallRepost
+	case EventWallReplyNew:
+		return &fl.wallReplyNew
+	case EventWallReplyEdit:
+		return &fl.wallReplyEdit
+	case EventWallReplyRestore:
+		return &fl.wallReplyRestore
+	case EventWallReplyDelete:
+		return &fl.wallReplyDelete
+	case EventBoardPostNew:
+		return &fl.boardPostNew
+	case EventBoardPostEdit:
+		return &fl.boardPostEdit
+	case EventBoardPostRestore:
+		return &fl.boardPostRestore
+	case EventBoardPostDelete:
+		return &fl.boardPostDelete
+	case EventMarketCommentNew:
+		return &fl.marketCommentNew
+	case EventMarketCommentEdit:
+		return &fl.marketCommentEdit
+	case EventMarketCommentRestore:
+		return &fl.marketCommentRestore
+	case EventMarketCommentDelete:
+		return &fl.marketCommentDelete
+	case EventMarketOrderNew:
+		return &fl.marketOrderNew
+	case EventMarketOrderEdit:
+		return &fl.marketOrderEdit
+	case EventGroupLeave:
+		return &fl.groupLeave
+	case EventGroupJoin:
+		return &fl.groupJoin
+	case EventUserBlock:
+		return &fl.userBlock
+	case EventUserUnblock:
+		return &fl.userUnblock
+	case EventPollVoteNew:
+		return &fl.pollVoteNew
+	case EventGroupOfficersEdit:
+		return &fl.groupOfficersEdit
+	case EventGroupChangeSettings:
+		return &fl.groupChangeSettings
+	case EventGroupChangePhoto:
+		return &fl.groupChangePhoto
+	case EventVkpayTransaction:
+		return &fl.vkpayTransaction
+	case EventLeadFormsNew:
+		return &fl.leadFormsNew
+	case EventAppPayload:
+		return &fl.appPayload
+	case EventMessageRead:
+		return &fl.messageRead
+	case EventLikeAdd:
+		return &fl.likeAdd
+	case EventLikeRemove:
+		return &fl.likeRemove
+	case EventDonutSubscriptionCreate:
+		return &fl.donutSubscriptionCreate
+	case EventDonutSubscriptionProlonged:
+		return &fl.donutSubscriptionProlonged
+	case EventDonutSubscriptionExpired:
+		return &fl.donutSubscriptionExpired
+	case EventDonutSubscriptionCancelled:
+		return &fl.donutSubscriptionCancelled
+	case EventDonutSubscriptionPriceChanged:
+		return &fl.donutSubscriptionPriceChanged
+	case EventDonutMoneyWithdraw:
+		return &fl.donutMoneyWithdraw
+	case EventDonutMoneyWithdrawError:
+		return &fl.donutMoneyWithdrawError
+	case eventUnknown:
+		return &fl.unknown
+	case eventEverything:
+		return &fl.everything
+	}
+
+	return nil
+}
+
+// removeFunc removes the i-th typed handler of the event type.
+func (fl *FuncList) removeFunc(eventType EventType, i int) { // nolint:gocyclo
+	switch eventType {
+	case EventMessageNew:
+		fl.messageNew = append(fl.messageNew[:i:i], fl.messageNew[i+1:]...)
+	case EventMessageReply:
+		fl.messageReply = append(fl.messageReply[:i:i], fl.messageReply[i+1:]...)
+	case EventMessageEdit:
+		fl.messageEdit = append(fl.messageEdit[:i:i], fl.messageEdit[i+1:]...)
+	case EventMessageAllow:
+		fl.messageAllow = append(fl.messageAllow[:i:i], fl.messageAllow[i+1:]...)
+	case EventMessageDeny:
+		fl.messageDeny = append(fl.messageDeny[:i:i], fl.messageDeny[i+1:]...)
+	case EventMessageTypingState:
+		fl.messageTypingState = append(fl.messageTypingState[:i:i], fl.messageTypingState[i+1:]...)
+	case EventMessageEvent:
+		fl.messageEvent = append(fl.messageEvent[:i:i], fl.messageEvent[i+1:]...)
+	case EventMessageReactionEvent:
+		fl.messageReactionEvent = append(fl.messageReactionEvent[:i:i], fl.messageReactionEvent[i+1:]...)
+	case EventPhotoNew:
+		fl.photoNew = append(fl.photoNew[:i:i], fl.photoNew[i+1:]...)
+	case EventPhotoCommentNew:
+		fl.photoCommentNew = append(fl.photoCommentNew[:i:i], fl.photoCommentNew[i+1:]...)
+	case EventPhotoCommentEdit:
+		fl.photoCommentEdit = append(fl.photoCommentEdit[:i:i], fl.photoCommentEdit[i+1:]...)
+	case EventPhotoCommentRestore:
+		fl.photoCommentRestore = append(fl.photoCommentRestore[:i:i], fl.photoCommentRestore[i+1:]...)
+	case EventPhotoCommentDelete:
+		fl.photoCommentDelete = append(fl.photoCommentDelete[:i:i], fl.photoCommentDelete[i+1:]...)
+	case EventAudioNew:
+		fl.audioNew = append(fl.audioNew[:i:i], fl.audioNew[i+1:]...)
+	case EventVideoNew:
+		fl.videoNew = append(fl.videoNew[:i:i], fl.videoNew[i+1:]...)
+	case EventVideoCommentNew:
+		fl.videoCommentNew = append(fl.videoCommentNew[:i:i], fl.videoCommentNew[i+1:]...)
+	case EventVideoCommentEdit:
+		fl.videoCommentEdit = append(fl.videoCommentEdit[:i:i], fl.videoCommentEdit[i+1:]...)
+	case EventVideoCommentRestore:
+		fl.videoCommentRestore = append(fl.videoCommentRestore[:i:i], fl.videoCommentRestore[i+1:]...)
+	case EventVideoCommentDelete:
+		fl.videoCommentDelete = append(fl.videoCommentDelete[:i:i], fl.videoCommentDelete[i+1:]...)
+	case EventWallPostNew:
+		fl.wallPostNew = append(fl.wallPostNew[:i:i], fl.wallPostNew[i+1:]...)
+	case EventWallRepost:
+		fl.wallRepost = append(fl.wallRepost[:i:i], fl.wallRepost[i+1:]...)
+	case EventWallReplyNew:
+		fl.wallReplyNew = append(fl.wallReplyNew[:i:i], fl.wallReplyNew[i+1:]...)
+	case EventWallReplyEdit:
+		fl.wallReplyEdit = append(fl.wallReplyEdit[:i:i], fl.wallReplyEdit[i+1:]...)
+	case EventWallReplyRestore:
+		fl.wallReplyRestore = append(fl.wallReplyRestore[:i:i], fl.wallReplyRestore[i+1:]...)
+	case EventWallReplyDelete:
+		fl.wallReplyDelete = append(fl.wallReplyDelete[:i:i], fl.wallReplyDelete[i+1:]...)
+	case EventBoardPostNew:
+		fl.boardPostNew = append(fl.boardPostNew[:i:i], fl.boardPostNew[i+1:]...)
+	case EventBoardPostEdit:
+		fl.boardPostEdit = append(fl.boardPostEdit[:i:i], fl.boardPostEdit[i+1:]...)
+	case EventBoardPostRestore:
+		fl.boardPostRestore = append(fl.boardPostRestore[:i:i], fl.boardPostRestore[i+1:]...)
+	case EventBoardPostDelete:
+		fl.boardPostDelete = append(fl.boardPostDelete[:i:i], fl.boardPostDelete[i+1:]...)
+	case EventMarketCommentNew:
+		fl.marketCommentNew = append(fl.marketCommentNew[:i:i], fl.marketCommentNew[i+1:]...)
+	case EventMarketCommentEdit:
+		fl.marketCommentEdit = append(fl.marketCommentEdit[:i:i], fl.marketCommentEdit[i+1:]...)
+	case EventMarketCommentRestore:
+		fl.marketCommentRestore = append(fl.marketCommentRestore[:i:i], fl.marketCommentRestore[i+1:]...)
+	case EventMarketCommentDelete:
+		fl.marketCommentDelete = append(fl.marketCommentDelete[:i:i], fl.marketCommentDelete[i+1:]...)
+	case EventMarketOrderNew:
+		fl.marketOrderNew = append(fl.marketOrderNew[:i:i], fl.marketOrderNew[i+1:]...)
+	case EventMarketOrderEdit:
+		fl.marketOrderEdit = append(fl.marketOrderEdit[:i:i], fl.marketOrderEdit[i+1:]...)
+	case EventGroupLeave:
+		fl.groupLeave = append(fl.groupLeave[:i:i], fl.groupLeave[i+1:]...)
+	case EventGroupJoin:
+		fl.groupJoin = append(fl.groupJoin[:i:i], fl.groupJoin[i+1:]...)
+	case EventUserBlock:
+		fl.userBlock = append(fl.userBlock[:i:i], fl.userBlock[i+1:]...)
+	case EventUserUnblock:
+		fl.userUnblock = append(fl.userUnblock[:i:i], fl.userUnblock[i+1:]...)
+	case EventPollVoteNew:
+		fl.pollVoteNew = append(fl.pollVoteNew[:i:i], fl.pollVoteNew[i+1:]...)
+	case EventGroupOfficersEdit:
+		fl.groupOfficersEdit = append(fl.groupOfficersEdit[:i:i], fl.groupOfficersEdit[i+1:]...)
+	case EventGroupChangeSettings:
+		fl.groupChangeSettings = append(fl.groupChangeSettings[:i:i], fl.groupChangeSettings[i+1:]...)
+	case EventGroupChangePhoto:
+		fl.groupChangePhoto = append(fl.groupChangePhoto[:i:i], fl.groupChangePhoto[i+1:]...)
+	case EventVkpayTransaction:
+		fl.vkpayTransaction = append(fl.vkpayTransaction[:i:i], fl.vkpayTransaction[i+1:]...)
+	case EventLeadFormsNew:
+		fl.leadFormsNew = append(fl.leadFormsNew[:i:i], fl.leadFormsNew[i+1:]...)
+	case EventAppPayload:
+		fl.appPayload = append(fl.appPayload[:i:i], fl.appPayload[i+1:]...)
+	case EventMessageRead:
+		fl.messageRead = append(fl.messageRead[:i:i], fl.messageRead[i+1:]...)
+	case EventLikeAdd:
+		fl.likeAdd = append(fl.likeAdd[:i:i], fl.likeAdd[i+1:]...)
+	case EventLikeRemove:
+		fl.likeRemove = append(fl.likeRemove[:i:i], fl.likeRemove[i+1:]...)
+	case EventDonutSubscriptionCreate:
+		fl.donutSubscriptionCreate = append(fl.donutSubscriptionCreate[:i:i], fl.donutSubscriptionCreate[i+1:]...)
+	case EventDonutSubscriptionProlonged:
+		fl.donutSubscriptionProlonged = append(fl.donutSubscriptionProlonged[:i:i], fl.donutSubscriptionProlonged[i+1:]...)
+	case EventDonutSubscriptionExpired:
+		fl.donutSubscriptionExpired = append(fl.donutSubscriptionExpired[:i:i], fl.donutSubscriptionExpired[i+1:]...)
+	case EventDonutSubscriptionCancelled:
+		fl.donutSubscriptionCancelled = append(fl.donutSubscriptionCancelled[:i:i], fl.donutSubscriptionCancelled[i+1:]...)
+	case EventDonutSubscriptionPriceChanged:
+		fl.donutSubscriptionPriceChanged = append(fl.donutSubscriptionPriceChanged[:i:i], fl.donutSubscriptionPriceChanged[i+1:]...)
+	case EventDonutMoneyWithdraw:
+		fl.donutMoneyWithdraw = append(fl.donutMoneyWithdraw[:i:i], fl.donutMoneyWithdraw[i+1:]...)
+	case EventDonutMoneyWithdrawError:
+		fl.donutMoneyWithdrawError = append(fl.donutMoneyWithdrawError[:i:i], fl.donutMoneyWithdrawError[i+1:]...)
+	case eventUnknown:
+		fl.unknown = append(fl.unknown[:i:i], fl.unknown[i+1:]...)
+	case eventEverything:
+		fl.everything = append(fl.everything[:i:i], fl.everything[i+1:]...)
+	}
 }
 
 // MessageNew handler.
-func (fl *FuncList) MessageNew(f func(context.Context, MessageNewObject)) {
+func (fl *FuncList) MessageNew(f func(context.Context, MessageNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageNew = append(fl.messageNew, f)
 	fl.eventsList = append(fl.eventsList, EventMessageNew)
+
+	return fl.register(EventMessageNew, false)
 }
 
 // MessageReply handler.
-func (fl *FuncList) MessageReply(f func(context.Context, MessageReplyObject)) {
+func (fl *FuncList) MessageReply(f func(context.Context, MessageReplyObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageReply = append(fl.messageReply, f)
 	fl.eventsList = append(fl.eventsList, EventMessageReply)
+
+	return fl.register(EventMessageReply, false)
 }
 
 // MessageEdit handler.
-func (fl *FuncList) MessageEdit(f func(context.Context, MessageEditObject)) {
+func (fl *FuncList) MessageEdit(f func(context.Context, MessageEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageEdit = append(fl.messageEdit, f)
 	fl.eventsList = append(fl.eventsList, EventMessageEdit)
+
+	return fl.register(EventMessageEdit, false)
 }
 
 // MessageAllow handler.
-func (fl *FuncList) MessageAllow(f func(context.Context, MessageAllowObject)) {
+func (fl *FuncList) MessageAllow(f func(context.Context, MessageAllowObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageAllow = append(fl.messageAllow, f)
 	fl.eventsList = append(fl.eventsList, EventMessageAllow)
+
+	return fl.register(EventMessageAllow, false)
 }
 
 // MessageDeny handler.
-func (fl *FuncList) MessageDeny(f func(context.Context, MessageDenyObject)) {
+func (fl *FuncList) MessageDeny(f func(context.Context, MessageDenyObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageDeny = append(fl.messageDeny, f)
 	fl.eventsList = append(fl.eventsList, EventMessageDeny)
+
+	return fl.register(EventMessageDeny, false)
 }
 
 // MessageTypingState handler.
-func (fl *FuncList) MessageTypingState(f func(context.Context, MessageTypingStateObject)) {
+func (fl *FuncList) MessageTypingState(f func(context.Context, MessageTypingStateObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageTypingState = append(fl.messageTypingState, f)
 	fl.eventsList = append(fl.eventsList, EventMessageTypingState)
+
+	return fl.register(EventMessageTypingState, false)
 }
 
 // MessageEvent handler.
-func (fl *FuncList) MessageEvent(f func(context.Context, MessageEventObject)) {
+func (fl *FuncList) MessageEvent(f func(context.Context, MessageEventObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageEvent = append(fl.messageEvent, f)
 	fl.eventsList = append(fl.eventsList, EventMessageEvent)
+
+	return fl.register(EventMessageEvent, false)
+}
+
+// MessageReactionEvent handler.
+func (fl *FuncList) MessageReactionEvent(f func(context.Context, MessageReactionEventObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
+	fl.messageReactionEvent = append(fl.messageReactionEvent, f)
+	fl.eventsList = append(fl.eventsList, EventMessageReactionEvent)
+
+	return fl.register(EventMessageReactionEvent, false)
 }
 
 // PhotoNew handler.
-func (fl *FuncList) PhotoNew(f func(context.Context, PhotoNewObject)) {
+func (fl *FuncList) PhotoNew(f func(context.Context, PhotoNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.photoNew = append(fl.photoNew, f)
 	fl.eventsList = append(fl.eventsList, EventPhotoNew)
+
+	return fl.register(EventPhotoNew, false)
 }
 
 // PhotoCommentNew handler.
-func (fl *FuncList) PhotoCommentNew(f func(context.Context, PhotoCommentNewObject)) {
+func (fl *FuncList) PhotoCommentNew(f func(context.Context, PhotoCommentNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.photoCommentNew = append(fl.photoCommentNew, f)
 	fl.eventsList = append(fl.eventsList, EventPhotoCommentNew)
+
+	return fl.register(EventPhotoCommentNew, false)
 }
 
 // PhotoCommentEdit handler.
-func (fl *FuncList) PhotoCommentEdit(f func(context.Context, PhotoCommentEditObject)) {
+func (fl *FuncList) PhotoCommentEdit(f func(context.Context, PhotoCommentEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.photoCommentEdit = append(fl.photoCommentEdit, f)
 	fl.eventsList = append(fl.eventsList, EventPhotoCommentEdit)
+
+	return fl.register(EventPhotoCommentEdit, false)
 }
 
 // PhotoCommentRestore handler.
-func (fl *FuncList) PhotoCommentRestore(f func(context.Context, PhotoCommentRestoreObject)) {
+func (fl *FuncList) PhotoCommentRestore(f func(context.Context, PhotoCommentRestoreObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.photoCommentRestore = append(fl.photoCommentRestore, f)
 	fl.eventsList = append(fl.eventsList, EventPhotoCommentRestore)
+
+	return fl.register(EventPhotoCommentRestore, false)
 }
 
 // PhotoCommentDelete handler.
-func (fl *FuncList) PhotoCommentDelete(f func(context.Context, PhotoCommentDeleteObject)) {
+func (fl *FuncList) PhotoCommentDelete(f func(context.Context, PhotoCommentDeleteObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.photoCommentDelete = append(fl.photoCommentDelete, f)
 	fl.eventsList = append(fl.eventsList, EventPhotoCommentDelete)
+
+	return fl.register(EventPhotoCommentDelete, false)
 }
 
 // AudioNew handler.
-func (fl *FuncList) AudioNew(f func(context.Context, AudioNewObject)) {
+func (fl *FuncList) AudioNew(f func(context.Context, AudioNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.audioNew = append(fl.audioNew, f)
 	fl.eventsList = append(fl.eventsList, EventAudioNew)
+
+	return fl.register(EventAudioNew, false)
 }
 
 // VideoNew handler.
-func (fl *FuncList) VideoNew(f func(context.Context, VideoNewObject)) {
+func (fl *FuncList) VideoNew(f func(context.Context, VideoNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.videoNew = append(fl.videoNew, f)
 	fl.eventsList = append(fl.eventsList, EventVideoNew)
+
+	return fl.register(EventVideoNew, false)
 }
 
 // VideoCommentNew handler.
-func (fl *FuncList) VideoCommentNew(f func(context.Context, VideoCommentNewObject)) {
+func (fl *FuncList) VideoCommentNew(f func(context.Context, VideoCommentNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.videoCommentNew = append(fl.videoCommentNew, f)
 	fl.eventsList = append(fl.eventsList, EventVideoCommentNew)
+
+	return fl.register(EventVideoCommentNew, false)
 }
 
 // VideoCommentEdit handler.
-func (fl *FuncList) VideoCommentEdit(f func(context.Context, VideoCommentEditObject)) {
+func (fl *FuncList) VideoCommentEdit(f func(context.Context, VideoCommentEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.videoCommentEdit = append(fl.videoCommentEdit, f)
 	fl.eventsList = append(fl.eventsList, EventVideoCommentEdit)
+
+	return fl.register(EventVideoCommentEdit, false)
 }
 
 // VideoCommentRestore handler.
-func (fl *FuncList) VideoCommentRestore(f func(context.Context, VideoCommentRestoreObject)) {
+func (fl *FuncList) VideoCommentRestore(f func(context.Context, VideoCommentRestoreObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.videoCommentRestore = append(fl.videoCommentRestore, f)
 	fl.eventsList = append(fl.eventsList, EventVideoCommentRestore)
+
+	return fl.register(EventVideoCommentRestore, false)
 }
 
 // VideoCommentDelete handler.
-func (fl *FuncList) VideoCommentDelete(f func(context.Context, VideoCommentDeleteObject)) {
+func (fl *FuncList) VideoCommentDelete(f func(context.Context, VideoCommentDeleteObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.videoCommentDelete = append(fl.videoCommentDelete, f)
 	fl.eventsList = append(fl.eventsList, EventVideoCommentDelete)
+
+	return fl.register(EventVideoCommentDelete, false)
 }
 
 // WallPostNew handler.
-func (fl *FuncList) WallPostNew(f func(context.Context, WallPostNewObject)) {
+func (fl *FuncList) WallPostNew(f func(context.Context, WallPostNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallPostNew = append(fl.wallPostNew, f)
 	fl.eventsList = append(fl.eventsList, EventWallPostNew)
+
+	return fl.register(EventWallPostNew, false)
 }
 
 // WallRepost handler.
-func (fl *FuncList) WallRepost(f func(context.Context, WallRepostObject)) {
+func (fl *FuncList) WallRepost(f func(context.Context, WallRepostObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallRepost = append(fl.wallRepost, f)
 	fl.eventsList = append(fl.eventsList, EventWallRepost)
+
+	return fl.register(EventWallRepost, false)
 }
 
 // WallReplyNew handler.
-func (fl *FuncList) WallReplyNew(f func(context.Context, WallReplyNewObject)) {
+func (fl *FuncList) WallReplyNew(f func(context.Context, WallReplyNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallReplyNew = append(fl.wallReplyNew, f)
 	fl.eventsList = append(fl.eventsList, EventWallReplyNew)
+
+	return fl.register(EventWallReplyNew, false)
 }
 
 // WallReplyEdit handler.
-func (fl *FuncList) WallReplyEdit(f func(context.Context, WallReplyEditObject)) {
+func (fl *FuncList) WallReplyEdit(f func(context.Context, WallReplyEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallReplyEdit = append(fl.wallReplyEdit, f)
 	fl.eventsList = append(fl.eventsList, EventWallReplyEdit)
+
+	return fl.register(EventWallReplyEdit, false)
 }
 
 // WallReplyRestore handler.
-func (fl *FuncList) WallReplyRestore(f func(context.Context, WallReplyRestoreObject)) {
+func (fl *FuncList) WallReplyRestore(f func(context.Context, WallReplyRestoreObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallReplyRestore = append(fl.wallReplyRestore, f)
 	fl.eventsList = append(fl.eventsList, EventWallReplyRestore)
+
+	return fl.register(EventWallReplyRestore, false)
 }
 
 // WallReplyDelete handler.
-func (fl *FuncList) WallReplyDelete(f func(context.Context, WallReplyDeleteObject)) {
+func (fl *FuncList) WallReplyDelete(f func(context.Context, WallReplyDeleteObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.wallReplyDelete = append(fl.wallReplyDelete, f)
 	fl.eventsList = append(fl.eventsList, EventWallReplyDelete)
+
+	return fl.register(EventWallReplyDelete, false)
 }
 
 // BoardPostNew handler.
-func (fl *FuncList) BoardPostNew(f func(context.Context, BoardPostNewObject)) {
+func (fl *FuncList) BoardPostNew(f func(context.Context, BoardPostNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.boardPostNew = append(fl.boardPostNew, f)
 	fl.eventsList = append(fl.eventsList, EventBoardPostNew)
+
+	return fl.register(EventBoardPostNew, false)
 }
 
 // BoardPostEdit handler.
-func (fl *FuncList) BoardPostEdit(f func(context.Context, BoardPostEditObject)) {
+func (fl *FuncList) BoardPostEdit(f func(context.Context, BoardPostEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.boardPostEdit = append(fl.boardPostEdit, f)
 	fl.eventsList = append(fl.eventsList, EventBoardPostEdit)
+
+	return fl.register(EventBoardPostEdit, false)
 }
 
 // BoardPostRestore handler.
-func (fl *FuncList) BoardPostRestore(f func(context.Context, BoardPostRestoreObject)) {
+func (fl *FuncList) BoardPostRestore(f func(context.Context, BoardPostRestoreObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.boardPostRestore = append(fl.boardPostRestore, f)
 	fl.eventsList = append(fl.eventsList, EventBoardPostRestore)
+
+	return fl.register(EventBoardPostRestore, false)
 }
 
 // BoardPostDelete handler.
-func (fl *FuncList) BoardPostDelete(f func(context.Context, BoardPostDeleteObject)) {
+func (fl *FuncList) BoardPostDelete(f func(context.Context, BoardPostDeleteObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.boardPostDelete = append(fl.boardPostDelete, f)
 	fl.eventsList = append(fl.eventsList, EventBoardPostDelete)
+
+	return fl.register(EventBoardPostDelete, false)
 }
 
 // MarketCommentNew handler.
-func (fl *FuncList) MarketCommentNew(f func(context.Context, MarketCommentNewObject)) {
+func (fl *FuncList) MarketCommentNew(f func(context.Context, MarketCommentNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketCommentNew = append(fl.marketCommentNew, f)
 	fl.eventsList = append(fl.eventsList, EventMarketCommentNew)
+
+	return fl.register(EventMarketCommentNew, false)
 }
 
 // MarketCommentEdit handler.
-func (fl *FuncList) MarketCommentEdit(f func(context.Context, MarketCommentEditObject)) {
+func (fl *FuncList) MarketCommentEdit(f func(context.Context, MarketCommentEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketCommentEdit = append(fl.marketCommentEdit, f)
 	fl.eventsList = append(fl.eventsList, EventMarketCommentEdit)
+
+	return fl.register(EventMarketCommentEdit, false)
 }
 
 // MarketCommentRestore handler.
-func (fl *FuncList) MarketCommentRestore(f func(context.Context, MarketCommentRestoreObject)) {
+func (fl *FuncList) MarketCommentRestore(f func(context.Context, MarketCommentRestoreObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketCommentRestore = append(fl.marketCommentRestore, f)
 	fl.eventsList = append(fl.eventsList, EventMarketCommentRestore)
+
+	return fl.register(EventMarketCommentRestore, false)
 }
 
 // MarketCommentDelete handler.
-func (fl *FuncList) MarketCommentDelete(f func(context.Context, MarketCommentDeleteObject)) {
+func (fl *FuncList) MarketCommentDelete(f func(context.Context, MarketCommentDeleteObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketCommentDelete = append(fl.marketCommentDelete, f)
 	fl.eventsList = append(fl.eventsList, EventMarketCommentDelete)
+
+	return fl.register(EventMarketCommentDelete, false)
 }
 
 // MarketOrderNew handler.
-func (fl *FuncList) MarketOrderNew(f func(context.Context, MarketOrderNewObject)) {
+func (fl *FuncList) MarketOrderNew(f func(context.Context, MarketOrderNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketOrderNew = append(fl.marketOrderNew, f)
 	fl.eventsList = append(fl.eventsList, EventMarketOrderNew)
+
+	return fl.register(EventMarketOrderNew, false)
 }
 
 // MarketOrderEdit handler.
-func (fl *FuncList) MarketOrderEdit(f func(context.Context, MarketOrderEditObject)) {
+func (fl *FuncList) MarketOrderEdit(f func(context.Context, MarketOrderEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.marketOrderEdit = append(fl.marketOrderEdit, f)
 	fl.eventsList = append(fl.eventsList, EventMarketOrderEdit)
+
+	return fl.register(EventMarketOrderEdit, false)
 }
 
 // GroupLeave handler.
-func (fl *FuncList) GroupLeave(f func(context.Context, GroupLeaveObject)) {
+func (fl *FuncList) GroupLeave(f func(context.Context, GroupLeaveObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.groupLeave = append(fl.groupLeave, f)
 	fl.eventsList = append(fl.eventsList, EventGroupLeave)
+
+	return fl.register(EventGroupLeave, false)
 }
 
 // GroupJoin handler.
-func (fl *FuncList) GroupJoin(f func(context.Context, GroupJoinObject)) {
+func (fl *FuncList) GroupJoin(f func(context.Context, GroupJoinObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.groupJoin = append(fl.groupJoin, f)
 	fl.eventsList = append(fl.eventsList, EventGroupJoin)
+
+	return fl.register(EventGroupJoin, false)
 }
 
 // UserBlock handler.
-func (fl *FuncList) UserBlock(f func(context.Context, UserBlockObject)) {
+func (fl *FuncList) UserBlock(f func(context.Context, UserBlockObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.userBlock = append(fl.userBlock, f)
 	fl.eventsList = append(fl.eventsList, EventUserBlock)
+
+	return fl.register(EventUserBlock, false)
 }
 
 // UserUnblock handler.
-func (fl *FuncList) UserUnblock(f func(context.Context, UserUnblockObject)) {
+func (fl *FuncList) UserUnblock(f func(context.Context, UserUnblockObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.userUnblock = append(fl.userUnblock, f)
 	fl.eventsList = append(fl.eventsList, EventUserUnblock)
+
+	return fl.register(EventUserUnblock, false)
 }
 
 // PollVoteNew handler.
-func (fl *FuncList) PollVoteNew(f func(context.Context, PollVoteNewObject)) {
+func (fl *FuncList) PollVoteNew(f func(context.Context, PollVoteNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.pollVoteNew = append(fl.pollVoteNew, f)
 	fl.eventsList = append(fl.eventsList, EventPollVoteNew)
+
+	return fl.register(EventPollVoteNew, false)
 }
 
 // GroupOfficersEdit handler.
-func (fl *FuncList) GroupOfficersEdit(f func(context.Context, GroupOfficersEditObject)) {
+func (fl *FuncList) GroupOfficersEdit(f func(context.Context, GroupOfficersEditObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.groupOfficersEdit = append(fl.groupOfficersEdit, f)
 	fl.eventsList = append(fl.eventsList, EventGroupOfficersEdit)
+
+	return fl.register(EventGroupOfficersEdit, false)
 }
 
 // GroupChangeSettings handler.
-func (fl *FuncList) GroupChangeSettings(f func(context.Context, GroupChangeSettingsObject)) {
+func (fl *FuncList) GroupChangeSettings(f func(context.Context, GroupChangeSettingsObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.groupChangeSettings = append(fl.groupChangeSettings, f)
 	fl.eventsList = append(fl.eventsList, EventGroupChangeSettings)
+
+	return fl.register(EventGroupChangeSettings, false)
 }
 
 // GroupChangePhoto handler.
-func (fl *FuncList) GroupChangePhoto(f func(context.Context, GroupChangePhotoObject)) {
+func (fl *FuncList) GroupChangePhoto(f func(context.Context, GroupChangePhotoObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.groupChangePhoto = append(fl.groupChangePhoto, f)
 	fl.eventsList = append(fl.eventsList, EventGroupChangePhoto)
+
+	return fl.register(EventGroupChangePhoto, false)
 }
 
 // VkpayTransaction handler.
-func (fl *FuncList) VkpayTransaction(f func(context.Context, VkpayTransactionObject)) {
+func (fl *FuncList) VkpayTransaction(f func(context.Context, VkpayTransactionObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.vkpayTransaction = append(fl.vkpayTransaction, f)
 	fl.eventsList = append(fl.eventsList, EventVkpayTransaction)
+
+	return fl.register(EventVkpayTransaction, false)
 }
 
 // LeadFormsNew handler.
-func (fl *FuncList) LeadFormsNew(f func(context.Context, LeadFormsNewObject)) {
+func (fl *FuncList) LeadFormsNew(f func(context.Context, LeadFormsNewObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.leadFormsNew = append(fl.leadFormsNew, f)
 	fl.eventsList = append(fl.eventsList, EventLeadFormsNew)
+
+	return fl.register(EventLeadFormsNew, false)
 }
 
 // AppPayload handler.
-func (fl *FuncList) AppPayload(f func(context.Context, AppPayloadObject)) {
+func (fl *FuncList) AppPayload(f func(context.Context, AppPayloadObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.appPayload = append(fl.appPayload, f)
 	fl.eventsList = append(fl.eventsList, EventAppPayload)
+
+	return fl.register(EventAppPayload, false)
 }
 
 // MessageRead handler.
-func (fl *FuncList) MessageRead(f func(context.Context, MessageReadObject)) {
+func (fl *FuncList) MessageRead(f func(context.Context, MessageReadObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.messageRead = append(fl.messageRead, f)
 	fl.eventsList = append(fl.eventsList, EventMessageRead)
+
+	return fl.register(EventMessageRead, false)
 }
 
 // LikeAdd handler.
-func (fl *FuncList) LikeAdd(f func(context.Context, LikeAddObject)) {
+func (fl *FuncList) LikeAdd(f func(context.Context, LikeAddObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.likeAdd = append(fl.likeAdd, f)
 	fl.eventsList = append(fl.eventsList, EventLikeAdd)
+
+	return fl.register(EventLikeAdd, false)
 }
 
 // LikeRemove handler.
-func (fl *FuncList) LikeRemove(f func(context.Context, LikeRemoveObject)) {
+func (fl *FuncList) LikeRemove(f func(context.Context, LikeRemoveObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.likeRemove = append(fl.likeRemove, f)
 	fl.eventsList = append(fl.eventsList, EventLikeRemove)
+
+	return fl.register(EventLikeRemove, false)
 }
 
 // DonutSubscriptionCreate handler.
-func (fl *FuncList) DonutSubscriptionCreate(f func(context.Context, DonutSubscriptionCreateObject)) {
+func (fl *FuncList) DonutSubscriptionCreate(f func(context.Context, DonutSubscriptionCreateObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutSubscriptionCreate = append(fl.donutSubscriptionCreate, f)
 	fl.eventsList = append(fl.eventsList, EventDonutSubscriptionCreate)
+
+	return fl.register(EventDonutSubscriptionCreate, false)
 }
 
 // DonutSubscriptionProlonged handler.
-func (fl *FuncList) DonutSubscriptionProlonged(f func(context.Context, DonutSubscriptionProlongedObject)) {
+func (fl *FuncList) DonutSubscriptionProlonged(f func(context.Context, DonutSubscriptionProlongedObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutSubscriptionProlonged = append(fl.donutSubscriptionProlonged, f)
 	fl.eventsList = append(fl.eventsList, EventDonutSubscriptionProlonged)
+
+	return fl.register(EventDonutSubscriptionProlonged, false)
 }
 
 // DonutSubscriptionExpired handler.
-func (fl *FuncList) DonutSubscriptionExpired(f func(context.Context, DonutSubscriptionExpiredObject)) {
+func (fl *FuncList) DonutSubscriptionExpired(f func(context.Context, DonutSubscriptionExpiredObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutSubscriptionExpired = append(fl.donutSubscriptionExpired, f)
 	fl.eventsList = append(fl.eventsList, EventDonutSubscriptionExpired)
+
+	return fl.register(EventDonutSubscriptionExpired, false)
 }
 
 // DonutSubscriptionCancelled handler.
-func (fl *FuncList) DonutSubscriptionCancelled(f func(context.Context, DonutSubscriptionCancelledObject)) {
+func (fl *FuncList) DonutSubscriptionCancelled(f func(context.Context, DonutSubscriptionCancelledObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutSubscriptionCancelled = append(fl.donutSubscriptionCancelled, f)
 	fl.eventsList = append(fl.eventsList, EventDonutSubscriptionCancelled)
+
+	return fl.register(EventDonutSubscriptionCancelled, false)
 }
 
 // DonutSubscriptionPriceChanged handler.
-func (fl *FuncList) DonutSubscriptionPriceChanged(f func(context.Context, DonutSubscriptionPriceChangedObject)) {
+func (fl *FuncList) DonutSubscriptionPriceChanged(f func(context.Context, DonutSubscriptionPriceChangedObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutSubscriptionPriceChanged = append(fl.donutSubscriptionPriceChanged, f)
 	fl.eventsList = append(fl.eventsList, EventDonutSubscriptionPriceChanged)
+
+	return fl.register(EventDonutSubscriptionPriceChanged, false)
 }
 
 // DonutMoneyWithdraw handler.
-func (fl *FuncList) DonutMoneyWithdraw(f func(context.Context, DonutMoneyWithdrawObject)) {
+func (fl *FuncList) DonutMoneyWithdraw(f func(context.Context, DonutMoneyWithdrawObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutMoneyWithdraw = append(fl.donutMoneyWithdraw, f)
 	fl.eventsList = append(fl.eventsList, EventDonutMoneyWithdraw)
+
+	return fl.register(EventDonutMoneyWithdraw, false)
 }
 
 // DonutMoneyWithdrawError handler.
-func (fl *FuncList) DonutMoneyWithdrawError(f func(context.Context, DonutMoneyWithdrawErrorObject)) {
+func (fl *FuncList) DonutMoneyWithdrawError(f func(context.Context, DonutMoneyWithdrawErrorObject)) HandlerID {
+	fl.lock()
+	defer fl.unlock()
+
 	fl.donutMoneyWithdrawError = append(fl.donutMoneyWithdrawError, f)
 	fl.eventsList = append(fl.eventsList, EventDonutMoneyWithdrawError)
+
+	return fl.register(EventDonutMoneyWithdrawError, false)
 }
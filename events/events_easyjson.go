@@ -0,0 +1,125 @@
+//go:build easyjson
+// +build easyjson
+
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package events
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson692db02bDecodeGithubComSevereCloudVksdkV2Events(in *jlexer.Lexer, out *GroupEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = EventType(in.String())
+		case "object":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Object).UnmarshalJSON(data))
+			}
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "event_id":
+			out.EventID = string(in.String())
+		case "v":
+			out.V = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson692db02bEncodeGithubComSevereCloudVksdkV2Events(out *jwriter.Writer, in GroupEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"object\":"
+		out.RawString(prefix)
+		out.Raw((in.Object).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EventID))
+	}
+	if in.V != "" {
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.V))
+	}
+	{
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix)
+		out.String(string(in.Secret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson692db02bEncodeGithubComSevereCloudVksdkV2Events(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson692db02bEncodeGithubComSevereCloudVksdkV2Events(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson692db02bDecodeGithubComSevereCloudVksdkV2Events(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson692db02bDecodeGithubComSevereCloudVksdkV2Events(l, v)
+}
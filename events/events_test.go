@@ -2,11 +2,15 @@ package events_test
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/SevereCloud/vksdk/v2/object"
 )
 
 const GID = 123456
@@ -58,6 +62,39 @@ func TestFuncList_HandlerMessageNew(t *testing.T) {
 	)
 }
 
+func TestMessageNewObject_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	f := func(data string, want events.MessageNewObject) {
+		t.Helper()
+
+		var obj events.MessageNewObject
+
+		assert.NoError(t, json.Unmarshal([]byte(data), &obj))
+		assert.Equal(t, want, obj)
+	}
+
+	// API version >= 5.103
+	f(
+		`{"message":{"id":1,"peer_id":2,"text":"hi"},"client_info":{"lang_id":3}}`,
+		events.MessageNewObject{
+			Message:    object.MessagesMessage{ID: 1, PeerID: 2, Text: "hi"},
+			ClientInfo: object.ClientInfo{LangID: 3},
+		},
+	)
+	// API version < 5.103
+	f(
+		`{"id":1,"peer_id":2,"text":"hi"}`,
+		events.MessageNewObject{
+			Message: object.MessagesMessage{ID: 1, PeerID: 2, Text: "hi"},
+		},
+	)
+
+	var obj events.MessageNewObject
+
+	assert.Error(t, json.Unmarshal([]byte(`{"message":1}`), &obj))
+}
+
 func TestFuncList_HandlerMessageReply(t *testing.T) {
 	t.Parallel()
 
@@ -1434,6 +1471,40 @@ func TestFuncList_HandlerMarketOrderNew(t *testing.T) {
 	)
 }
 
+func TestMarketOrderObjects(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var (
+		newOrder  events.MarketOrderNewObject
+		editOrder events.MarketOrderEditObject
+	)
+
+	fl.MarketOrderNew(func(_ context.Context, obj events.MarketOrderNewObject) { newOrder = obj })
+	fl.MarketOrderEdit(func(_ context.Context, obj events.MarketOrderEditObject) { editOrder = obj })
+
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type: events.EventMarketOrderNew,
+		Object: []byte(`{"id":1,"group_id":2,"user_id":3,"display_order_id":"2-1","status":0,` +
+			`"items_count":1,"total_price":{"amount":"10000","currency":{"id":643,"name":"RUB"}},` +
+			`"comment":"call me","weight":500,"is_viewed_by_admin":0}`),
+	}))
+	assert.Equal(t, 1, newOrder.ID)
+	assert.Equal(t, "2-1", newOrder.DisplayOrderID)
+	assert.Equal(t, object.MarketOrderNew, newOrder.Status)
+	assert.Equal(t, "10000", newOrder.TotalPrice.Amount)
+	assert.Equal(t, 500, newOrder.Weight)
+
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMarketOrderEdit,
+		Object: []byte(`{"id":1,"status":5,"merchant_comment":"out of stock","is_viewed_by_admin":1}`),
+	}))
+	assert.Equal(t, object.MarketOrderCanceled, editOrder.Status)
+	assert.Equal(t, "out of stock", editOrder.MerchantComment)
+	assert.True(t, bool(editOrder.IsViewedByAdmin))
+}
+
 func TestFuncList_HandlerMarketOrderEdit(t *testing.T) {
 	t.Parallel()
 
@@ -2380,6 +2451,63 @@ func TestFuncList_DonutMoneyWithdrawError(t *testing.T) {
 	)
 }
 
+func TestFuncList_MessageReactionEvent(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var reactions []events.MessageReactionEventObject
+
+	fl.MessageReactionEvent(func(ctx context.Context, obj events.MessageReactionEventObject) {
+		groupID := events.GroupIDFromContext(ctx)
+		assert.Equal(t, groupID, GID)
+
+		reactions = append(reactions, obj)
+	})
+	assert.Equal(t, []events.EventType{events.EventMessageReactionEvent}, fl.ListEvents())
+
+	f := func(e events.GroupEvent, wantErr bool) {
+		if err := fl.Handler(context.Background(), e); (err != nil) != wantErr {
+			t.Errorf("FuncList.Handler() error = %v, wantErr %v", err, wantErr)
+		}
+	}
+
+	f(
+		events.GroupEvent{
+			Type:    events.EventMessageReactionEvent,
+			Object:  []byte(`{"reacted_id":1,"peer_id":2000000001,"cmid":3,"reaction_id":4}`),
+			GroupID: GID,
+		},
+		false,
+	)
+	f(
+		events.GroupEvent{
+			Type:    events.EventMessageReactionEvent,
+			Object:  []byte(`{"reacted_id":1,"peer_id":2000000001,"cmid":3}`),
+			GroupID: GID,
+		},
+		false,
+	)
+	f(
+		events.GroupEvent{
+			Type:   events.EventMessageReactionEvent,
+			Object: []byte(""),
+		},
+		true,
+	)
+
+	if assert.Len(t, reactions, 2) {
+		assert.Equal(t, events.MessageReactionEventObject{
+			ReactedID:  1,
+			PeerID:     2000000001,
+			Cmid:       3,
+			ReactionID: 4,
+		}, reactions[0])
+		assert.False(t, reactions[0].Removed())
+		assert.True(t, reactions[1].Removed())
+	}
+}
+
 func TestFuncList_OnEvent(t *testing.T) {
 	t.Parallel()
 
@@ -2421,3 +2549,542 @@ func TestFuncList_OnEvent(t *testing.T) {
 		false,
 	)
 }
+
+func TestFuncList_Use(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var calls []string
+
+	mw := func(name string) func(events.Handler) events.Handler {
+		return func(next events.Handler) events.Handler {
+			return func(ctx context.Context, e events.GroupEvent) error {
+				assert.Equal(t, GID, events.GroupIDFromContext(ctx))
+
+				calls = append(calls, name)
+
+				return next(ctx, e)
+			}
+		}
+	}
+
+	fl.Use(mw("first"), mw("second"))
+	fl.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			if e.Type == "skip" {
+				return nil
+			}
+
+			return next(ctx, e)
+		}
+	})
+	fl.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		calls = append(calls, "handler")
+	})
+	fl.OnEvent("skip", func(ctx context.Context, e events.GroupEvent) {
+		calls = append(calls, "skipped")
+	})
+
+	err := fl.Handler(context.Background(), events.GroupEvent{Type: "test", GroupID: GID})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+
+	calls = nil
+	err = fl.Handler(context.Background(), events.GroupEvent{Type: "skip", GroupID: GID})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestFuncList_AllowEvents(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var handled []events.EventType
+
+	fl.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			handled = append(handled, e.Type)
+			return next(ctx, e)
+		}
+	})
+	fl.AllowEvents(events.EventMessageNew, events.EventMessageEdit)
+	fl.IgnoreEvents(events.EventMessageEdit)
+
+	for _, eventType := range []events.EventType{
+		events.EventMessageNew,
+		events.EventMessageEdit,
+		events.EventMessageReply,
+	} {
+		err := fl.Handler(context.Background(), events.GroupEvent{
+			Type:   eventType,
+			Object: []byte(`{}`),
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []events.EventType{events.EventMessageNew}, handled)
+}
+
+func TestFuncList_IgnoreEvents(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.IgnoreEvents(events.EventMessageNew)
+
+	// invalid object is not decoded
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte(""),
+	})
+	assert.NoError(t, err)
+}
+
+func TestFuncList_Remove(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var calls []string
+
+	id1 := fl.MessageNew(func(_ context.Context, _ events.MessageNewObject) {
+		calls = append(calls, "first")
+	})
+	id2 := fl.MessageNew(func(_ context.Context, _ events.MessageNewObject) {
+		calls = append(calls, "second")
+	})
+	id3 := fl.OnEvent(events.EventMessageNew, func(_ context.Context, _ events.GroupEvent) {
+		calls = append(calls, "special")
+	})
+
+	assert.NotEqual(t, id1, id2)
+	assert.True(t, fl.Remove(id1))
+	assert.False(t, fl.Remove(id1))
+	assert.True(t, fl.Remove(id3))
+	assert.Equal(t, []events.EventType{events.EventMessageNew}, fl.ListEvents())
+
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte("{}"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"second"}, calls)
+
+	assert.True(t, fl.Remove(id2))
+	assert.Empty(t, fl.ListEvents())
+}
+
+func TestFuncList_OnUnknown(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var unknown []events.EventType
+
+	id := fl.OnUnknown(func(_ context.Context, e events.GroupEvent) {
+		unknown = append(unknown, e.Type)
+	})
+	fl.OnEvent("special", func(_ context.Context, _ events.GroupEvent) {})
+
+	for _, eventType := range []events.EventType{"new_event", "special", events.EventMessageNew} {
+		err := fl.Handler(context.Background(), events.GroupEvent{
+			Type:   eventType,
+			Object: []byte("{}"),
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []events.EventType{"new_event"}, unknown)
+	assert.Equal(t, []events.EventType{"special"}, fl.ListEvents())
+	assert.True(t, fl.Remove(id))
+}
+
+func TestDonutObjects(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var (
+		create   events.DonutSubscriptionCreateObject
+		prolong  events.DonutSubscriptionProlongedObject
+		expire   events.DonutSubscriptionExpiredObject
+		cancel   events.DonutSubscriptionCancelledObject
+		price    events.DonutSubscriptionPriceChangedObject
+		withdraw events.DonutMoneyWithdrawObject
+		withErr  events.DonutMoneyWithdrawErrorObject
+	)
+
+	fl.DonutSubscriptionCreate(func(_ context.Context, obj events.DonutSubscriptionCreateObject) { create = obj })
+	fl.DonutSubscriptionProlonged(func(_ context.Context, obj events.DonutSubscriptionProlongedObject) { prolong = obj })
+	fl.DonutSubscriptionExpired(func(_ context.Context, obj events.DonutSubscriptionExpiredObject) { expire = obj })
+	fl.DonutSubscriptionCancelled(func(_ context.Context, obj events.DonutSubscriptionCancelledObject) { cancel = obj })
+	fl.DonutSubscriptionPriceChanged(func(_ context.Context, obj events.DonutSubscriptionPriceChangedObject) {
+		price = obj
+	})
+	fl.DonutMoneyWithdraw(func(_ context.Context, obj events.DonutMoneyWithdrawObject) { withdraw = obj })
+	fl.DonutMoneyWithdrawError(func(_ context.Context, obj events.DonutMoneyWithdrawErrorObject) { withErr = obj })
+
+	f := func(eventType events.EventType, object string) {
+		t.Helper()
+
+		err := fl.Handler(context.Background(), events.GroupEvent{Type: eventType, Object: []byte(object)})
+		assert.NoError(t, err)
+	}
+
+	f(events.EventDonutSubscriptionCreate, `{"amount":50,"amount_without_fee":47.5,"user_id":117253521}`)
+	f(events.EventDonutSubscriptionProlonged, `{"amount":50,"amount_without_fee":47.5,"user_id":117253521}`)
+	f(events.EventDonutSubscriptionExpired, `{"user_id":117253521}`)
+	f(events.EventDonutSubscriptionCancelled, `{"user_id":117253521}`)
+	f(events.EventDonutSubscriptionPriceChanged,
+		`{"amount_old":50,"amount_new":100,"amount_diff":50,"amount_diff_without_fee":47.5,"user_id":117253521}`)
+	f(events.EventDonutMoneyWithdraw, `{"amount":1000,"amount_without_fee":950.5}`)
+	f(events.EventDonutMoneyWithdrawError, `{"reason":"error"}`)
+
+	assert.Equal(t, events.DonutSubscriptionCreateObject{
+		Amount: 50, AmountWithoutFee: 47.5, UserID: 117253521,
+	}, create)
+	assert.Equal(t, events.DonutSubscriptionProlongedObject{
+		Amount: 50, AmountWithoutFee: 47.5, UserID: 117253521,
+	}, prolong)
+	assert.Equal(t, 117253521, expire.UserID)
+	assert.Equal(t, 117253521, cancel.UserID)
+	assert.Equal(t, events.DonutSubscriptionPriceChangedObject{
+		AmountOld: 50, AmountNew: 100, AmountDiff: 50, AmountDiffWithoutFee: 47.5, UserID: 117253521,
+	}, price)
+	assert.Equal(t, events.DonutMoneyWithdrawObject{Amount: 1000, AmountWithoutFee: 950.5}, withdraw)
+	assert.Equal(t, "error", withErr.Reason)
+}
+
+func TestFuncList_SetPriority(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var calls []string
+
+	handler := func(name string) func(context.Context, events.MessageNewObject) {
+		return func(_ context.Context, _ events.MessageNewObject) {
+			calls = append(calls, name)
+		}
+	}
+
+	fl.MessageNew(handler("first"))
+	id := fl.MessageNew(handler("access"))
+	last := fl.MessageNew(handler("last"))
+
+	assert.True(t, fl.SetPriority(id, 100))
+	assert.True(t, fl.SetPriority(last, -1))
+	assert.False(t, fl.SetPriority(0, 1))
+
+	fl.MessageNew(handler("second"))
+
+	special := fl.OnEvent(events.EventMessageNew, func(_ context.Context, _ events.GroupEvent) {
+		calls = append(calls, "special")
+	})
+	fl.OnEvent(events.EventMessageNew, func(_ context.Context, _ events.GroupEvent) {
+		calls = append(calls, "special access")
+	})
+	assert.True(t, fl.SetPriority(special, -1))
+
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte("{}"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"special access", "special", "access", "first", "second", "last"}, calls)
+}
+
+func TestFuncList_OnEverything(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var all []events.EventType
+
+	id := fl.OnEverything(func(_ context.Context, eventType events.EventType, e events.GroupEvent) {
+		assert.Equal(t, e.Type, eventType)
+
+		all = append(all, eventType)
+	})
+	fl.IgnoreEvents(events.EventMessageReply)
+
+	for _, eventType := range []events.EventType{"new_event", events.EventMessageNew, events.EventMessageReply} {
+		err := fl.Handler(context.Background(), events.GroupEvent{
+			Type:   eventType,
+			Object: []byte("{}"),
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []events.EventType{"new_event", events.EventMessageNew}, all)
+	assert.Empty(t, fl.ListEvents())
+	assert.True(t, fl.Remove(id))
+}
+
+func TestFuncList_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var (
+		wg    sync.WaitGroup
+		calls int32
+	)
+
+	e := events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte(`{"message":{"id":1}}`),
+	}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			id := fl.MessageNew(func(_ context.Context, _ events.MessageNewObject) {
+				atomic.AddInt32(&calls, 1)
+			})
+			fl.SetPriority(fl.OnEvent(events.EventMessageNew, func(_ context.Context, _ events.GroupEvent) {}), 1)
+			fl.SetPriority(id, 1)
+			fl.Remove(id)
+			fl.MessageNew(func(_ context.Context, _ events.MessageNewObject) {})
+			fl.AllowEvents(events.EventMessageNew)
+			_ = fl.ListEvents()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, fl.Handler(context.Background(), e))
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Len(t, fl.ListEvents(), 20)
+}
+
+func TestFuncList_Copy(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	handler := fl.Handler
+
+	var handled bool
+
+	fl.OnEvent("test", func(_ context.Context, _ events.GroupEvent) {
+		handled = true
+	})
+
+	assert.NoError(t, handler(context.Background(), events.GroupEvent{Type: "test"}))
+	assert.True(t, handled)
+	assert.Equal(t, []events.EventType{"test"}, (*fl).ListEvents())
+}
+
+func TestFuncList_SetDispatchMode(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.Goroutine(true)
+	fl.SetDispatchMode(events.DispatchSync, events.EventMessageEvent)
+
+	var (
+		mux     sync.Mutex
+		handled []events.EventType
+	)
+
+	wait := make(chan struct{})
+
+	fl.MessageEvent(func(_ context.Context, _ events.MessageEventObject) {
+		mux.Lock()
+		handled = append(handled, events.EventMessageEvent)
+		mux.Unlock()
+	})
+	fl.WallReplyNew(func(_ context.Context, _ events.WallReplyNewObject) {
+		<-wait
+	})
+
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventWallReplyNew,
+		Object: []byte(`{}`),
+	}))
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageEvent,
+		Object: []byte(`{}`),
+	}))
+
+	// the sync handler is done before Handler returns
+	mux.Lock()
+	assert.Equal(t, []events.EventType{events.EventMessageEvent}, handled)
+	mux.Unlock()
+
+	close(wait)
+
+	fl.Goroutine(false)
+	fl.SetDispatchMode(events.DispatchAsync, events.EventWallReplyNew)
+
+	block := make(chan struct{})
+
+	fl.WallReplyNew(func(_ context.Context, _ events.WallReplyNewObject) {
+		<-block
+	})
+
+	// the async handler does not block Handler
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventWallReplyNew,
+		Object: []byte(`{}`),
+	}))
+	close(block)
+}
+
+func TestFuncList_OnAsyncPanic(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.Goroutine(true)
+	fl.SetDispatchMode(events.DispatchAsync, events.EventMessageEvent)
+
+	recovered := make(chan interface{}, 4)
+
+	fl.OnAsyncPanic(func(e events.GroupEvent, v interface{}) {
+		assert.Equal(t, "test", string(e.Type))
+		recovered <- v
+	})
+	fl.OnEvent("test", func(_ context.Context, _ events.GroupEvent) {
+		panic("special")
+	})
+	fl.OnEverything(func(_ context.Context, _ events.EventType, _ events.GroupEvent) {
+		panic("everything")
+	})
+	fl.OnUnknown(func(_ context.Context, _ events.GroupEvent) {
+		panic("unknown")
+	})
+
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{Type: "test"}))
+
+	var values []string
+
+	for i := 0; i < 2; i++ {
+		values = append(values, (<-recovered).(string))
+	}
+
+	assert.ElementsMatch(t, []string{"special", "everything"}, values)
+
+	fl.OnAsyncPanic(func(e events.GroupEvent, v interface{}) {
+		recovered <- v
+	})
+	fl.MessageEvent(func(_ context.Context, _ events.MessageEventObject) {
+		panic("typed")
+	})
+
+	assert.NoError(t, fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageEvent,
+		Object: []byte(`{}`),
+	}))
+
+	values = nil
+
+	for i := 0; i < 2; i++ {
+		values = append(values, (<-recovered).(string))
+	}
+
+	assert.ElementsMatch(t, []string{"typed", "everything"}, values)
+}
+
+func TestGroupEvent_MarshalCompat(t *testing.T) {
+	t.Parallel()
+
+	f := func(e events.GroupEvent, want string) {
+		t.Helper()
+
+		got, err := e.MarshalCompat()
+		assert.NoError(t, err)
+		assert.JSONEq(t, want, string(got))
+	}
+
+	f(
+		events.GroupEvent{
+			Type:    events.EventMessageDeny,
+			Object:  []byte(`{"user_id": 1}`),
+			GroupID: GID,
+			EventID: "abc",
+			V:       "5.131",
+		},
+		`{"group_id":123456,"type":"message_deny","event_id":"abc","v":"5.131","object":{"user_id":1}}`,
+	)
+	f(
+		events.GroupEvent{
+			Type:    events.EventMessageDeny,
+			GroupID: GID,
+			EventID: "abc",
+			Secret:  "secret",
+		},
+		`{"group_id":123456,"type":"message_deny","event_id":"abc","object":{},"secret":"secret"}`,
+	)
+
+	// the event is decoded back unchanged
+	e := events.GroupEvent{Type: events.EventMessageDeny, Object: []byte(`{"user_id":1}`), GroupID: GID, EventID: "abc"}
+
+	b, err := e.MarshalCompat()
+	assert.NoError(t, err)
+
+	var got events.GroupEvent
+
+	assert.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, e.Type, got.Type)
+	assert.Equal(t, e.GroupID, got.GroupID)
+	assert.Equal(t, e.EventID, got.EventID)
+	assert.JSONEq(t, string(e.Object), string(got.Object))
+
+	_, err = events.GroupEvent{Object: []byte(`{`)}.MarshalCompat()
+	assert.Error(t, err)
+}
+
+type countingCodec struct {
+	unmarshal int32
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	atomic.AddInt32(&c.unmarshal, 1)
+
+	return json.Unmarshal(data, v)
+}
+
+func TestFuncList_SetJSONCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := &countingCodec{}
+
+	fl := events.NewFuncList()
+	fl.SetJSONCodec(codec)
+
+	var got events.MessageEventObject
+
+	fl.MessageEvent(func(_ context.Context, obj events.MessageEventObject) {
+		got = obj
+	})
+
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageEvent,
+		Object: []byte(`{"user_id":1}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.UserID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&codec.unmarshal))
+
+	fl.SetJSONCodec(nil)
+
+	err = fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageEvent,
+		Object: []byte(`{"user_id":2}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got.UserID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&codec.unmarshal))
+}
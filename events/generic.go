@@ -0,0 +1,39 @@
+package events // import "github.com/SevereCloud/vksdk/v2/events"
+
+import "context"
+
+// On registers the handler of events of type T. It allows to handle event
+// types that have no dedicated method in FuncList:
+//
+//	type ChatJoinObject struct {
+//		UserID int `json:"user_id"`
+//	}
+//
+//	func (ChatJoinObject) EventType() events.EventType {
+//		return "chat_join"
+//	}
+//
+//	events.On(fl, func(ctx context.Context, obj ChatJoinObject) {
+//		log.Print(obj.UserID)
+//	})
+//
+// The EventType method is called on the zero value of T, so T must not be
+// a pointer. The error of decoding is returned by Handler.
+func On[T Event](fl *FuncList, f func(context.Context, T)) HandlerID {
+	var zero T
+
+	return fl.onEvent(zero.EventType(), func(ctx context.Context, e GroupEvent) error {
+		fl.rlock()
+		codec := fl.json
+		fl.runlock()
+
+		var obj T
+		if err := unmarshal(codec, e.Object, &obj); err != nil {
+			return err
+		}
+
+		f(ctx, obj)
+
+		return nil
+	})
+}
@@ -0,0 +1,59 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+type chatJoinObject struct {
+	UserID int `json:"user_id"`
+}
+
+func (chatJoinObject) EventType() events.EventType {
+	return "chat_join"
+}
+
+func TestOn(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	var (
+		userID int
+		text   string
+	)
+
+	events.On(fl, func(ctx context.Context, obj chatJoinObject) {
+		userID = obj.UserID
+	})
+	id := events.On(fl, func(ctx context.Context, obj events.MessageNewObject) {
+		text = obj.Message.Text
+	})
+
+	assert.Equal(t, []events.EventType{"chat_join", events.EventMessageNew}, fl.ListEvents())
+
+	err := fl.Handler(context.Background(), events.GroupEvent{
+		Type:   "chat_join",
+		Object: []byte(`{"user_id":1}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, userID)
+
+	err = fl.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageNew,
+		Object: []byte(`{"message":{"text":"hello"}}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", text)
+
+	err = fl.Handler(context.Background(), events.GroupEvent{
+		Type:   "chat_join",
+		Object: []byte(`""`),
+	})
+	assert.Error(t, err)
+
+	assert.True(t, fl.Remove(id))
+}
@@ -1,3 +1,5 @@
+//go:generate easyjson -all -build_tags easyjson objects.go
+
 package events // import "github.com/SevereCloud/vksdk/v2/events"
 
 import (
@@ -7,15 +9,46 @@ import (
 )
 
 // MessageNewObject struct.
+//
+//easyjson:skip
 type MessageNewObject struct {
 	Message    object.MessagesMessage `json:"message"`
 	ClientInfo object.ClientInfo      `json:"client_info"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// Before API version 5.103 the object is the message itself without
+// client_info, both formats are supported.
+func (obj *MessageNewObject) UnmarshalJSON(data []byte) error {
+	var r struct {
+		Message    json.RawMessage   `json:"message"`
+		ClientInfo object.ClientInfo `json:"client_info"`
+	}
+
+	err := json.Unmarshal(data, &r)
+	if err != nil {
+		return err
+	}
+
+	if len(r.Message) == 0 || string(r.Message) == "null" {
+		r.Message = data
+	}
+
+	obj.ClientInfo = r.ClientInfo
+
+	// MessageReplyObject has the same fields and the generated decoder
+	return json.Unmarshal(r.Message, (*MessageReplyObject)(&obj.Message))
+}
+
 // MessageReplyObject struct.
+//
+//easyjson:json
 type MessageReplyObject object.MessagesMessage
 
 // MessageEditObject struct.
+//
+//easyjson:json
 type MessageEditObject object.MessagesMessage
 
 // MessageAllowObject struct.
@@ -45,16 +78,41 @@ type MessageEventObject struct {
 	ConversationMessageID int             `json:"conversation_message_id"`
 }
 
+// MessageReactionEventObject struct.
+type MessageReactionEventObject struct {
+	// ReactedID is the ID of the user who changed the reaction.
+	ReactedID int `json:"reacted_id"`
+	PeerID    int `json:"peer_id"`
+	// Cmid is the conversation message ID of the message.
+	Cmid int `json:"cmid"`
+	// ReactionID is the ID of the added reaction. It is zero if the
+	// reaction is removed.
+	ReactionID int `json:"reaction_id,omitempty"`
+}
+
+// Removed returns true if the reaction is removed.
+func (obj MessageReactionEventObject) Removed() bool {
+	return obj.ReactionID == 0
+}
+
 // PhotoNewObject struct.
+//
+//easyjson:json
 type PhotoNewObject object.PhotosPhoto
 
 // PhotoCommentNewObject struct.
+//
+//easyjson:json
 type PhotoCommentNewObject object.WallWallComment
 
 // PhotoCommentEditObject struct.
+//
+//easyjson:json
 type PhotoCommentEditObject object.WallWallComment
 
 // PhotoCommentRestoreObject struct.
+//
+//easyjson:json
 type PhotoCommentRestoreObject object.WallWallComment
 
 // PhotoCommentDeleteObject struct.
@@ -67,18 +125,28 @@ type PhotoCommentDeleteObject struct {
 }
 
 // AudioNewObject struct.
+//
+//easyjson:json
 type AudioNewObject object.AudioAudio
 
 // VideoNewObject struct.
+//
+//easyjson:json
 type VideoNewObject object.VideoVideo
 
 // VideoCommentNewObject struct.
+//
+//easyjson:json
 type VideoCommentNewObject object.WallWallComment
 
 // VideoCommentEditObject struct.
+//
+//easyjson:json
 type VideoCommentEditObject object.WallWallComment
 
 // VideoCommentRestoreObject struct.
+//
+//easyjson:json
 type VideoCommentRestoreObject object.WallWallComment
 
 // VideoCommentDeleteObject struct.
@@ -91,18 +159,28 @@ type VideoCommentDeleteObject struct {
 }
 
 // WallPostNewObject struct.
+//
+//easyjson:json
 type WallPostNewObject object.WallWallpost
 
 // WallRepostObject struct.
+//
+//easyjson:json
 type WallRepostObject object.WallWallpost
 
 // WallReplyNewObject struct.
+//
+//easyjson:json
 type WallReplyNewObject object.WallWallComment
 
 // WallReplyEditObject struct.
+//
+//easyjson:json
 type WallReplyEditObject object.WallWallComment
 
 // WallReplyRestoreObject struct.
+//
+//easyjson:json
 type WallReplyRestoreObject object.WallWallComment
 
 // WallReplyDeleteObject struct.
@@ -114,12 +192,18 @@ type WallReplyDeleteObject struct {
 }
 
 // BoardPostNewObject struct.
+//
+//easyjson:json
 type BoardPostNewObject object.BoardTopicComment
 
 // BoardPostEditObject struct.
+//
+//easyjson:json
 type BoardPostEditObject object.BoardTopicComment
 
 // BoardPostRestoreObject struct.
+//
+//easyjson:json
 type BoardPostRestoreObject object.BoardTopicComment
 
 // BoardPostDeleteObject struct.
@@ -130,12 +214,18 @@ type BoardPostDeleteObject struct {
 }
 
 // MarketCommentNewObject struct.
+//
+//easyjson:json
 type MarketCommentNewObject object.WallWallComment
 
 // MarketCommentEditObject struct.
+//
+//easyjson:json
 type MarketCommentEditObject object.WallWallComment
 
 // MarketCommentRestoreObject struct.
+//
+//easyjson:json
 type MarketCommentRestoreObject object.WallWallComment
 
 // MarketCommentDeleteObject struct.
@@ -148,9 +238,13 @@ type MarketCommentDeleteObject struct {
 }
 
 // MarketOrderNewObject struct.
+//
+//easyjson:json
 type MarketOrderNewObject object.MarketOrder
 
 // MarketOrderEditObject struct.
+//
+//easyjson:json
 type MarketOrderEditObject object.MarketOrder
 
 // GroupLeaveObject struct.
@@ -315,36 +409,39 @@ type LikeRemoveObject struct {
 }
 
 // DonutSubscriptionCreateObject struct.
+//
+// Amount is the subscription price in rubles, AmountWithoutFee is the
+// amount that the community receives.
 type DonutSubscriptionCreateObject struct {
-	Amount           float64 `json:"amount"`
+	Amount           int     `json:"amount"`
 	AmountWithoutFee float64 `json:"amount_without_fee"`
-	UserID           float64 `json:"user_id"`
+	UserID           int     `json:"user_id"`
 }
 
 // DonutSubscriptionProlongedObject struct.
 type DonutSubscriptionProlongedObject struct {
-	Amount           float64 `json:"amount"`
+	Amount           int     `json:"amount"`
 	AmountWithoutFee float64 `json:"amount_without_fee"`
-	UserID           float64 `json:"user_id"`
+	UserID           int     `json:"user_id"`
 }
 
 // DonutSubscriptionExpiredObject struct.
 type DonutSubscriptionExpiredObject struct {
-	UserID float64 `json:"user_id"`
+	UserID int `json:"user_id"`
 }
 
 // DonutSubscriptionCancelledObject struct.
 type DonutSubscriptionCancelledObject struct {
-	UserID float64 `json:"user_id"`
+	UserID int `json:"user_id"`
 }
 
 // DonutSubscriptionPriceChangedObject struct.
 type DonutSubscriptionPriceChangedObject struct {
-	AmountOld            float64 `json:"amount_old"`
-	AmountNew            float64 `json:"amount_new"`
+	AmountOld            int     `json:"amount_old"`
+	AmountNew            int     `json:"amount_new"`
 	AmountDiff           float64 `json:"amount_diff"`
 	AmountDiffWithoutFee float64 `json:"amount_diff_without_fee"`
-	UserID               float64 `json:"user_id"`
+	UserID               int     `json:"user_id"`
 }
 
 // DonutMoneyWithdrawObject struct.
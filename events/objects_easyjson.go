@@ -0,0 +1,26110 @@
+//go:build easyjson
+// +build easyjson
+
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package events
+
+import (
+	json "encoding/json"
+	object "github.com/SevereCloud/vksdk/v2/object"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events(in *jlexer.Lexer, out *WallRepostObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "created_by":
+			out.CreatedBy = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "reply_owner_id":
+			out.ReplyOwnerID = int(in.Int())
+		case "reply_post_id":
+			out.ReplyPostID = int(in.Int())
+		case "friends_only":
+			out.FriendsOnly = int(in.Int())
+		case "comments":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object(in, &out.Comments)
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "views":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object3(in, &out.Views)
+		case "post_type":
+			out.PostType = string(in.String())
+		case "post_source":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object4(in, &out.PostSource)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallWallpostAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallWallpostAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 object.WallWallpostAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object5(in, &v1)
+					out.Attachments = append(out.Attachments, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object6(in, &out.Geo)
+		case "signer_id":
+			out.SignerID = int(in.Int())
+		case "copy_history":
+			if in.IsNull() {
+				in.Skip()
+				out.CopyHistory = nil
+			} else {
+				in.Delim('[')
+				if out.CopyHistory == nil {
+					if !in.IsDelim(']') {
+						out.CopyHistory = make([]object.WallWallpost, 0, 0)
+					} else {
+						out.CopyHistory = []object.WallWallpost{}
+					}
+				} else {
+					out.CopyHistory = (out.CopyHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 object.WallWallpost
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object7(in, &v2)
+					out.CopyHistory = append(out.CopyHistory, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "can_pin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPin).UnmarshalJSON(data))
+			}
+		case "can_delete":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanDelete).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "is_pinned":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsPinned).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_archived":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsArchived).UnmarshalJSON(data))
+			}
+		case "is_deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDeleted).UnmarshalJSON(data))
+			}
+		case "marked_as_ads":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MarkedAsAds).UnmarshalJSON(data))
+			}
+		case "edited":
+			out.Edited = int(in.Int())
+		case "copyright":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object8(in, &out.Copyright)
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 int
+					v3 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object9(in, &out.Donut)
+		case "short_text_rate":
+			out.ShortTextRate = float64(in.Float64())
+		case "carousel_offset":
+			out.CarouselOffset = int(in.Int())
+		case "header":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object10(in, &out.Header)
+		case "hash":
+			out.Hash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events(out *jwriter.Writer, in WallRepostObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		out.Int(int(in.CreatedBy))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"reply_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyOwnerID))
+	}
+	{
+		const prefix string = ",\"reply_post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyPostID))
+	}
+	{
+		const prefix string = ",\"friends_only\":"
+		out.RawString(prefix)
+		out.Int(int(in.FriendsOnly))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object(out, in.Comments)
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object3(out, in.Views)
+	}
+	{
+		const prefix string = ",\"post_type\":"
+		out.RawString(prefix)
+		out.String(string(in.PostType))
+	}
+	{
+		const prefix string = ",\"post_source\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object4(out, in.PostSource)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Attachments {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object5(out, v5)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object6(out, in.Geo)
+	}
+	{
+		const prefix string = ",\"signer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SignerID))
+	}
+	{
+		const prefix string = ",\"copy_history\":"
+		out.RawString(prefix)
+		if in.CopyHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.CopyHistory {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object7(out, v7)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"can_pin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPin))
+	}
+	{
+		const prefix string = ",\"can_delete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDelete))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"is_pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPinned))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsArchived))
+	}
+	{
+		const prefix string = ",\"is_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"marked_as_ads\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MarkedAsAds))
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Int(int(in.Edited))
+	}
+	{
+		const prefix string = ",\"copyright\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object8(out, in.Copyright)
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.ParentsStack {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object9(out, in.Donut)
+	}
+	{
+		const prefix string = ",\"short_text_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ShortTextRate))
+	}
+	{
+		const prefix string = ",\"carousel_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.CarouselOffset))
+	}
+	{
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object10(out, in.Header)
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallRepostObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallRepostObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallRepostObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallRepostObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object10(in *jlexer.Lexer, out *object.WallWallpostHeader) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "custom_description":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object11(in, &out.CustomDescription)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object10(out *jwriter.Writer, in object.WallWallpostHeader) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"custom_description\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object11(out, in.CustomDescription)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object11(in *jlexer.Lexer, out *object.WallWallpostHeaderCustomDescription) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "source_id":
+			out.SourceID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object11(out *jwriter.Writer, in object.WallWallpostHeaderCustomDescription) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"source_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.SourceID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object9(in *jlexer.Lexer, out *object.WallWallpostDonut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_donut":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDonut).UnmarshalJSON(data))
+			}
+		case "can_publish_free_copy":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPublishFreeCopy).UnmarshalJSON(data))
+			}
+		case "paid_duration":
+			out.PaidDuration = int(in.Int())
+		case "edit_mode":
+			out.EditMode = string(in.String())
+		case "durations":
+			if in.IsNull() {
+				in.Skip()
+				out.Durations = nil
+			} else {
+				in.Delim('[')
+				if out.Durations == nil {
+					if !in.IsDelim(']') {
+						out.Durations = make([]object.BaseObjectWithName, 0, 2)
+					} else {
+						out.Durations = []object.BaseObjectWithName{}
+					}
+				} else {
+					out.Durations = (out.Durations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 object.BaseObjectWithName
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object12(in, &v10)
+					out.Durations = append(out.Durations, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object9(out *jwriter.Writer, in object.WallWallpostDonut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_donut\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsDonut))
+	}
+	{
+		const prefix string = ",\"can_publish_free_copy\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPublishFreeCopy))
+	}
+	{
+		const prefix string = ",\"paid_duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.PaidDuration))
+	}
+	{
+		const prefix string = ",\"edit_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.EditMode))
+	}
+	{
+		const prefix string = ",\"durations\":"
+		out.RawString(prefix)
+		if in.Durations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Durations {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object12(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object12(in *jlexer.Lexer, out *object.BaseObjectWithName) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object12(out *jwriter.Writer, in object.BaseObjectWithName) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object8(in *jlexer.Lexer, out *object.WallPostCopyright) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "link":
+			out.Link = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object8(out *jwriter.Writer, in object.WallPostCopyright) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"link\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object7(in *jlexer.Lexer, out *object.WallWallpost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "created_by":
+			out.CreatedBy = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "reply_owner_id":
+			out.ReplyOwnerID = int(in.Int())
+		case "reply_post_id":
+			out.ReplyPostID = int(in.Int())
+		case "friends_only":
+			out.FriendsOnly = int(in.Int())
+		case "comments":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object(in, &out.Comments)
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "views":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object3(in, &out.Views)
+		case "post_type":
+			out.PostType = string(in.String())
+		case "post_source":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object4(in, &out.PostSource)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallWallpostAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallWallpostAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 object.WallWallpostAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object5(in, &v13)
+					out.Attachments = append(out.Attachments, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object6(in, &out.Geo)
+		case "signer_id":
+			out.SignerID = int(in.Int())
+		case "copy_history":
+			if in.IsNull() {
+				in.Skip()
+				out.CopyHistory = nil
+			} else {
+				in.Delim('[')
+				if out.CopyHistory == nil {
+					if !in.IsDelim(']') {
+						out.CopyHistory = make([]object.WallWallpost, 0, 0)
+					} else {
+						out.CopyHistory = []object.WallWallpost{}
+					}
+				} else {
+					out.CopyHistory = (out.CopyHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 object.WallWallpost
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object7(in, &v14)
+					out.CopyHistory = append(out.CopyHistory, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "can_pin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPin).UnmarshalJSON(data))
+			}
+		case "can_delete":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanDelete).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "is_pinned":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsPinned).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_archived":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsArchived).UnmarshalJSON(data))
+			}
+		case "is_deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDeleted).UnmarshalJSON(data))
+			}
+		case "marked_as_ads":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MarkedAsAds).UnmarshalJSON(data))
+			}
+		case "edited":
+			out.Edited = int(in.Int())
+		case "copyright":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object8(in, &out.Copyright)
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 int
+					v15 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object9(in, &out.Donut)
+		case "short_text_rate":
+			out.ShortTextRate = float64(in.Float64())
+		case "carousel_offset":
+			out.CarouselOffset = int(in.Int())
+		case "header":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object10(in, &out.Header)
+		case "hash":
+			out.Hash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object7(out *jwriter.Writer, in object.WallWallpost) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		out.Int(int(in.CreatedBy))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"reply_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyOwnerID))
+	}
+	{
+		const prefix string = ",\"reply_post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyPostID))
+	}
+	{
+		const prefix string = ",\"friends_only\":"
+		out.RawString(prefix)
+		out.Int(int(in.FriendsOnly))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object(out, in.Comments)
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object3(out, in.Views)
+	}
+	{
+		const prefix string = ",\"post_type\":"
+		out.RawString(prefix)
+		out.String(string(in.PostType))
+	}
+	{
+		const prefix string = ",\"post_source\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object4(out, in.PostSource)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Attachments {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object5(out, v17)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object6(out, in.Geo)
+	}
+	{
+		const prefix string = ",\"signer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SignerID))
+	}
+	{
+		const prefix string = ",\"copy_history\":"
+		out.RawString(prefix)
+		if in.CopyHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.CopyHistory {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object7(out, v19)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"can_pin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPin))
+	}
+	{
+		const prefix string = ",\"can_delete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDelete))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"is_pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPinned))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsArchived))
+	}
+	{
+		const prefix string = ",\"is_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"marked_as_ads\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MarkedAsAds))
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Int(int(in.Edited))
+	}
+	{
+		const prefix string = ",\"copyright\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object8(out, in.Copyright)
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.ParentsStack {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object9(out, in.Donut)
+	}
+	{
+		const prefix string = ",\"short_text_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ShortTextRate))
+	}
+	{
+		const prefix string = ",\"carousel_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.CarouselOffset))
+	}
+	{
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object10(out, in.Header)
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object6(in *jlexer.Lexer, out *object.BaseGeo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coordinates":
+			out.Coordinates = string(in.String())
+		case "place":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object13(in, &out.Place)
+		case "showmap":
+			out.Showmap = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object6(out *jwriter.Writer, in object.BaseGeo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coordinates\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coordinates))
+	}
+	{
+		const prefix string = ",\"place\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object13(out, in.Place)
+	}
+	{
+		const prefix string = ",\"showmap\":"
+		out.RawString(prefix)
+		out.Int(int(in.Showmap))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object13(in *jlexer.Lexer, out *object.BasePlace) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address":
+			out.Address = string(in.String())
+		case "checkins":
+			out.Checkins = int(in.Int())
+		case "city":
+			if m, ok := out.City.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.City.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.City = in.Interface()
+			}
+		case "country":
+			if m, ok := out.Country.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Country.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Country = in.Interface()
+			}
+		case "created":
+			out.Created = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "icon":
+			out.Icon = string(in.String())
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
+		case "title":
+			out.Title = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "is_deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDeleted).UnmarshalJSON(data))
+			}
+		case "total_checkins":
+			out.TotalCheckins = int(in.Int())
+		case "updated":
+			out.Updated = int(in.Int())
+		case "category_object":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object14(in, &out.CategoryObject)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object13(out *jwriter.Writer, in object.BasePlace) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"checkins\":"
+		out.RawString(prefix)
+		out.Int(int(in.Checkins))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		if m, ok := in.City.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.City.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.City))
+		}
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		if m, ok := in.Country.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Country.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Country))
+		}
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"icon\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon))
+	}
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"is_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"total_checkins\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalCheckins))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int(int(in.Updated))
+	}
+	{
+		const prefix string = ",\"category_object\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object14(out, in.CategoryObject)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object14(in *jlexer.Lexer, out *object.BaseCategoryObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "icons":
+			if in.IsNull() {
+				in.Skip()
+				out.Icons = nil
+			} else {
+				in.Delim('[')
+				if out.Icons == nil {
+					if !in.IsDelim(']') {
+						out.Icons = make([]object.BaseImage, 0, 1)
+					} else {
+						out.Icons = []object.BaseImage{}
+					}
+				} else {
+					out.Icons = (out.Icons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v22).UnmarshalJSON(data))
+					}
+					out.Icons = append(out.Icons, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object14(out *jwriter.Writer, in object.BaseCategoryObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"icons\":"
+		out.RawString(prefix)
+		if in.Icons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Icons {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v24)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object15(in *jlexer.Lexer, out *object.BaseImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "height":
+			out.Height = float64(in.Float64())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = float64(in.Float64())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out *jwriter.Writer, in object.BaseImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Height))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Width))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object5(in *jlexer.Lexer, out *object.WallWallpostAttachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "album":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object16(in, &out.Album)
+		case "app":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object17(in, &out.App)
+		case "audio":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in, &out.Audio)
+		case "doc":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in, &out.Doc)
+		case "event":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object20(in, &out.Event)
+		case "graffiti":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object21(in, &out.Graffiti)
+		case "link":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.Link)
+		case "market":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Market).UnmarshalJSON(data))
+			}
+		case "market_market_album":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object23(in, &out.MarketMarketAlbum)
+		case "note":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object24(in, &out.Note)
+		case "page":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object25(in, &out.Page)
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "photos_list":
+			if in.IsNull() {
+				in.Skip()
+				out.PhotosList = nil
+			} else {
+				in.Delim('[')
+				if out.PhotosList == nil {
+					if !in.IsDelim(']') {
+						out.PhotosList = make([]string, 0, 4)
+					} else {
+						out.PhotosList = []string{}
+					}
+				} else {
+					out.PhotosList = (out.PhotosList)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.PhotosList = append(out.PhotosList, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "poll":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object27(in, &out.Poll)
+		case "posted_photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object28(in, &out.PostedPhoto)
+		case "type":
+			out.Type = string(in.String())
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in, &out.Video)
+		case "podcast":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object30(in, &out.Podcast)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object5(out *jwriter.Writer, in object.WallWallpostAttachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object16(out, in.Album)
+	}
+	{
+		const prefix string = ",\"app\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object17(out, in.App)
+	}
+	{
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out, in.Audio)
+	}
+	{
+		const prefix string = ",\"doc\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out, in.Doc)
+	}
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object20(out, in.Event)
+	}
+	{
+		const prefix string = ",\"graffiti\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object21(out, in.Graffiti)
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.Link)
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out, in.Market)
+	}
+	{
+		const prefix string = ",\"market_market_album\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object24(out, in.MarketMarketAlbum)
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object25(out, in.Note)
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object26(out, in.Page)
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"photos_list\":"
+		out.RawString(prefix)
+		if in.PhotosList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.PhotosList {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object28(out, in.Poll)
+	}
+	{
+		const prefix string = ",\"posted_photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object29(out, in.PostedPhoto)
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out, in.Video)
+	}
+	{
+		const prefix string = ",\"podcast\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object31(out, in.Podcast)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object31(in *jlexer.Lexer, out *object.MarketMarketItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "availability":
+			out.Availability = int(in.Int())
+		case "category":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object32(in, &out.Category)
+		case "date":
+			out.Date = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "thumb_photo":
+			out.ThumbPhoto = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "can_comment":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanComment).UnmarshalJSON(data))
+			}
+		case "can_repost":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanRepost).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_main_variant":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMainVariant).UnmarshalJSON(data))
+			}
+		case "albums_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AlbumsIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AlbumsIDs == nil {
+					if !in.IsDelim(']') {
+						out.AlbumsIDs = make([]int, 0, 8)
+					} else {
+						out.AlbumsIDs = []int{}
+					}
+				} else {
+					out.AlbumsIDs = (out.AlbumsIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 int
+					v28 = int(in.Int())
+					out.AlbumsIDs = append(out.AlbumsIDs, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]object.PhotosPhoto, 0, 0)
+					} else {
+						out.Photos = []object.PhotosPhoto{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 object.PhotosPhoto
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &v29)
+					out.Photos = append(out.Photos, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "views_count":
+			out.ViewsCount = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "button_title":
+			out.ButtonTitle = string(in.String())
+		case "external_id":
+			out.ExternalID = string(in.String())
+		case "dimensions":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object33(in, &out.Dimensions)
+		case "weight":
+			out.Weight = int(in.Int())
+		case "variants_grouping_id":
+			out.VariantsGroupingID = int(in.Int())
+		case "property_values":
+			if in.IsNull() {
+				in.Skip()
+				out.PropertyValues = nil
+			} else {
+				in.Delim('[')
+				if out.PropertyValues == nil {
+					if !in.IsDelim(']') {
+						out.PropertyValues = make([]object.MarketMarketItemProperty, 0, 1)
+					} else {
+						out.PropertyValues = []object.MarketMarketItemProperty{}
+					}
+				} else {
+					out.PropertyValues = (out.PropertyValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 object.MarketMarketItemProperty
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object34(in, &v30)
+					out.PropertyValues = append(out.PropertyValues, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cart_quantity":
+			out.CartQuantity = int(in.Int())
+		case "sku":
+			out.SKU = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out *jwriter.Writer, in object.MarketMarketItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"availability\":"
+		out.RawString(prefix)
+		out.Int(int(in.Availability))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object32(out, in.Category)
+	}
+	if in.Date != 0 {
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.Price)
+	}
+	{
+		const prefix string = ",\"thumb_photo\":"
+		out.RawString(prefix)
+		out.String(string(in.ThumbPhoto))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"can_comment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanComment))
+	}
+	{
+		const prefix string = ",\"can_repost\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanRepost))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_main_variant\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMainVariant))
+	}
+	{
+		const prefix string = ",\"albums_ids\":"
+		out.RawString(prefix)
+		if in.AlbumsIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.AlbumsIDs {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v32))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Photos {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, v34)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	if in.ViewsCount != 0 {
+		const prefix string = ",\"views_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ViewsCount))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"button_title\":"
+		out.RawString(prefix)
+		out.String(string(in.ButtonTitle))
+	}
+	{
+		const prefix string = ",\"external_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ExternalID))
+	}
+	{
+		const prefix string = ",\"dimensions\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object34(out, in.Dimensions)
+	}
+	{
+		const prefix string = ",\"weight\":"
+		out.RawString(prefix)
+		out.Int(int(in.Weight))
+	}
+	{
+		const prefix string = ",\"variants_grouping_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VariantsGroupingID))
+	}
+	{
+		const prefix string = ",\"property_values\":"
+		out.RawString(prefix)
+		if in.PropertyValues == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.PropertyValues {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object35(out, v36)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cart_quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.CartQuantity))
+	}
+	{
+		const prefix string = ",\"sku\":"
+		out.RawString(prefix)
+		out.String(string(in.SKU))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object35(in *jlexer.Lexer, out *object.MarketPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = string(in.String())
+		case "currency":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object36(in, &out.Currency)
+		case "discount_rate":
+			out.DiscountRate = int(in.Int())
+		case "old_amount":
+			out.OldAmount = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "old_amount_text":
+			out.OldAmountText = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out *jwriter.Writer, in object.MarketPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object36(out, in.Currency)
+	}
+	{
+		const prefix string = ",\"discount_rate\":"
+		out.RawString(prefix)
+		out.Int(int(in.DiscountRate))
+	}
+	{
+		const prefix string = ",\"old_amount\":"
+		out.RawString(prefix)
+		out.String(string(in.OldAmount))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"old_amount_text\":"
+		out.RawString(prefix)
+		out.String(string(in.OldAmountText))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object36(in *jlexer.Lexer, out *object.MarketCurrency) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object36(out *jwriter.Writer, in object.MarketCurrency) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object34(in *jlexer.Lexer, out *object.MarketMarketItemProperty) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "variant_id":
+			out.VariantID = int(in.Int())
+		case "variant_name":
+			out.VariantName = string(in.String())
+		case "property_name":
+			out.PropertyName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object35(out *jwriter.Writer, in object.MarketMarketItemProperty) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"variant_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.VariantID))
+	}
+	{
+		const prefix string = ",\"variant_name\":"
+		out.RawString(prefix)
+		out.String(string(in.VariantName))
+	}
+	{
+		const prefix string = ",\"property_name\":"
+		out.RawString(prefix)
+		out.String(string(in.PropertyName))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object33(in *jlexer.Lexer, out *object.MarketDimensions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "length":
+			out.Length = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object34(out *jwriter.Writer, in object.MarketDimensions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"length\":"
+		out.RawString(prefix)
+		out.Int(int(in.Length))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object32(in *jlexer.Lexer, out *object.MarketMarketCategory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "section":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object37(in, &out.Section)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object32(out *jwriter.Writer, in object.MarketMarketCategory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"section\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object37(out, in.Section)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object37(in *jlexer.Lexer, out *object.MarketSection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object37(out *jwriter.Writer, in object.MarketSection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object30(in *jlexer.Lexer, out *object.PodcastsEpisode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "artist":
+			out.Artist = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "lyrics_id":
+			out.LyricsID = int(in.Int())
+		case "no_search":
+			out.NoSearch = int(in.Int())
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "is_hq":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHq).UnmarshalJSON(data))
+			}
+		case "is_focus_track":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFocusTrack).UnmarshalJSON(data))
+			}
+		case "is_explicit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExplicit).UnmarshalJSON(data))
+			}
+		case "short_videos_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ShortVideosAllowed).UnmarshalJSON(data))
+			}
+		case "stories_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesAllowed).UnmarshalJSON(data))
+			}
+		case "stories_cover_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesCoverAllowed).UnmarshalJSON(data))
+			}
+		case "podcast_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object38(in, &out.PodcastInfo)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object31(out *jwriter.Writer, in object.PodcastsEpisode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"lyrics_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LyricsID))
+	}
+	{
+		const prefix string = ",\"no_search\":"
+		out.RawString(prefix)
+		out.Int(int(in.NoSearch))
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"is_hq\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHq))
+	}
+	{
+		const prefix string = ",\"is_focus_track\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFocusTrack))
+	}
+	{
+		const prefix string = ",\"is_explicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExplicit))
+	}
+	{
+		const prefix string = ",\"short_videos_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShortVideosAllowed))
+	}
+	{
+		const prefix string = ",\"stories_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesAllowed))
+	}
+	{
+		const prefix string = ",\"stories_cover_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesCoverAllowed))
+	}
+	{
+		const prefix string = ",\"podcast_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object38(out, in.PodcastInfo)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object38(in *jlexer.Lexer, out *object.PodcastsPodcastInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Cover":
+			easyjsonCce3d1beDecode(in, &out.Cover)
+		case "plays":
+			out.Plays = int(in.Int())
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object38(out *jwriter.Writer, in object.PodcastsPodcastInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Cover\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncode(out, in.Cover)
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int(int(in.Plays))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecode(in *jlexer.Lexer, out *struct {
+	Sizes []object.BaseImage `json:"cover"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cover":
+			if in.IsNull() {
+				in.Skip()
+				out.Sizes = nil
+			} else {
+				in.Delim('[')
+				if out.Sizes == nil {
+					if !in.IsDelim(']') {
+						out.Sizes = make([]object.BaseImage, 0, 1)
+					} else {
+						out.Sizes = []object.BaseImage{}
+					}
+				} else {
+					out.Sizes = (out.Sizes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v37).UnmarshalJSON(data))
+					}
+					out.Sizes = append(out.Sizes, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncode(out *jwriter.Writer, in struct {
+	Sizes []object.BaseImage `json:"cover"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix[1:])
+		if in.Sizes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Sizes {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v39)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in *jlexer.Lexer, out *object.VideoVideo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "adding_date":
+			out.AddingDate = int(in.Int())
+		case "release_date":
+			out.ReleaseDate = int(in.Int())
+		case "can_add":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAdd).UnmarshalJSON(data))
+			}
+		case "can_add_to_faves":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAddToFaves).UnmarshalJSON(data))
+			}
+		case "can_comment":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanComment).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "can_like":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanLike).UnmarshalJSON(data))
+			}
+		case "can_download":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanDownload).UnmarshalJSON(data))
+			}
+		case "can_repost":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanRepost).UnmarshalJSON(data))
+			}
+		case "can_subscribe":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSubscribe).UnmarshalJSON(data))
+			}
+		case "can_attach_link":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAttachLink).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_private":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsPrivate).UnmarshalJSON(data))
+			}
+		case "is_explicit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExplicit).UnmarshalJSON(data))
+			}
+		case "is_subscribed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSubscribed).UnmarshalJSON(data))
+			}
+		case "added":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Added).UnmarshalJSON(data))
+			}
+		case "repeat":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Repeat).UnmarshalJSON(data))
+			}
+		case "content_restricted":
+			out.ContentRestricted = int(in.Int())
+		case "live":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Live).UnmarshalJSON(data))
+			}
+		case "upcoming":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Upcoming).UnmarshalJSON(data))
+			}
+		case "comments":
+			out.Comments = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "files":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object39(in, &out.Files)
+		case "trailer":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object39(in, &out.Trailer)
+		case "first_frame":
+			if in.IsNull() {
+				in.Skip()
+				out.FirstFrame = nil
+			} else {
+				in.Delim('[')
+				if out.FirstFrame == nil {
+					if !in.IsDelim(']') {
+						out.FirstFrame = make([]object.VideoVideoImage, 0, 1)
+					} else {
+						out.FirstFrame = []object.VideoVideoImage{}
+					}
+				} else {
+					out.FirstFrame = (out.FirstFrame)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 object.VideoVideoImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v40).UnmarshalJSON(data))
+					}
+					out.FirstFrame = append(out.FirstFrame, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "image":
+			if in.IsNull() {
+				in.Skip()
+				out.Image = nil
+			} else {
+				in.Delim('[')
+				if out.Image == nil {
+					if !in.IsDelim(']') {
+						out.Image = make([]object.VideoVideoImage, 0, 1)
+					} else {
+						out.Image = []object.VideoVideoImage{}
+					}
+				} else {
+					out.Image = (out.Image)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v41 object.VideoVideoImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v41).UnmarshalJSON(data))
+					}
+					out.Image = append(out.Image, v41)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "height":
+			out.Height = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "photo_130":
+			out.Photo130 = string(in.String())
+		case "photo_320":
+			out.Photo320 = string(in.String())
+		case "photo_640":
+			out.Photo640 = string(in.String())
+		case "photo_800":
+			out.Photo800 = string(in.String())
+		case "photo_1280":
+			out.Photo1280 = string(in.String())
+		case "player":
+			out.Player = string(in.String())
+		case "processing":
+			out.Processing = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "subtitle":
+			out.Subtitle = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		case "width":
+			out.Width = int(in.Int())
+		case "platform":
+			out.Platform = string(in.String())
+		case "local_views":
+			out.LocalViews = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "privacy_view":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object40(in, &out.PrivacyView)
+		case "privacy_comment":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object40(in, &out.PrivacyComment)
+		case "action_button":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object41(in, &out.ActionButton)
+		case "restriction":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object42(in, &out.Restriction)
+		case "content_restricted_message":
+			out.ContentRestrictedMessage = string(in.String())
+		case "main_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.MainArtists = nil
+			} else {
+				in.Delim('[')
+				if out.MainArtists == nil {
+					if !in.IsDelim(']') {
+						out.MainArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.MainArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.MainArtists = (out.MainArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v42 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v42)
+					out.MainArtists = append(out.MainArtists, v42)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "featured_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.FeaturedArtists = nil
+			} else {
+				in.Delim('[')
+				if out.FeaturedArtists == nil {
+					if !in.IsDelim(']') {
+						out.FeaturedArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.FeaturedArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.FeaturedArtists = (out.FeaturedArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v43)
+					out.FeaturedArtists = append(out.FeaturedArtists, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]object.BaseObjectWithName, 0, 2)
+					} else {
+						out.Genres = []object.BaseObjectWithName{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 object.BaseObjectWithName
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object12(in, &v44)
+					out.Genres = append(out.Genres, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ov_id":
+			out.OvID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out *jwriter.Writer, in object.VideoVideo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"adding_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.AddingDate))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReleaseDate))
+	}
+	{
+		const prefix string = ",\"can_add\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAdd))
+	}
+	{
+		const prefix string = ",\"can_add_to_faves\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAddToFaves))
+	}
+	{
+		const prefix string = ",\"can_comment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanComment))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"can_like\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanLike))
+	}
+	{
+		const prefix string = ",\"can_download\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDownload))
+	}
+	{
+		const prefix string = ",\"can_repost\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanRepost))
+	}
+	{
+		const prefix string = ",\"can_subscribe\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSubscribe))
+	}
+	{
+		const prefix string = ",\"can_attach_link\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAttachLink))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"is_explicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExplicit))
+	}
+	{
+		const prefix string = ",\"is_subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribed))
+	}
+	{
+		const prefix string = ",\"added\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Added))
+	}
+	{
+		const prefix string = ",\"repeat\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Repeat))
+	}
+	{
+		const prefix string = ",\"content_restricted\":"
+		out.RawString(prefix)
+		out.Int(int(in.ContentRestricted))
+	}
+	{
+		const prefix string = ",\"live\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Live))
+	}
+	{
+		const prefix string = ",\"upcoming\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Upcoming))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object39(out, in.Files)
+	}
+	if true {
+		const prefix string = ",\"trailer\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object39(out, in.Trailer)
+	}
+	{
+		const prefix string = ",\"first_frame\":"
+		out.RawString(prefix)
+		if in.FirstFrame == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v45, v46 := range in.FirstFrame {
+				if v45 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object40(out, v46)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		if in.Image == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Image {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object40(out, v48)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"photo_130\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo130))
+	}
+	{
+		const prefix string = ",\"photo_320\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo320))
+	}
+	{
+		const prefix string = ",\"photo_640\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo640))
+	}
+	{
+		const prefix string = ",\"photo_800\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo800))
+	}
+	{
+		const prefix string = ",\"photo_1280\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo1280))
+	}
+	{
+		const prefix string = ",\"player\":"
+		out.RawString(prefix)
+		out.String(string(in.Player))
+	}
+	{
+		const prefix string = ",\"processing\":"
+		out.RawString(prefix)
+		out.Int(int(in.Processing))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"subtitle\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtitle))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"platform\":"
+		out.RawString(prefix)
+		out.String(string(in.Platform))
+	}
+	{
+		const prefix string = ",\"local_views\":"
+		out.RawString(prefix)
+		out.Int(int(in.LocalViews))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"privacy_view\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object41(out, in.PrivacyView)
+	}
+	{
+		const prefix string = ",\"privacy_comment\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object41(out, in.PrivacyComment)
+	}
+	{
+		const prefix string = ",\"action_button\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object42(out, in.ActionButton)
+	}
+	{
+		const prefix string = ",\"restriction\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object43(out, in.Restriction)
+	}
+	{
+		const prefix string = ",\"content_restricted_message\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentRestrictedMessage))
+	}
+	{
+		const prefix string = ",\"main_artists\":"
+		out.RawString(prefix)
+		if in.MainArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.MainArtists {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v50)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"featured_artists\":"
+		out.RawString(prefix)
+		if in.FeaturedArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v51, v52 := range in.FeaturedArtists {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v52)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Genres {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object12(out, v54)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OvID != "" {
+		const prefix string = ",\"ov_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OvID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object44(in *jlexer.Lexer, out *object.VideoVideoImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "with_padding":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WithPadding).UnmarshalJSON(data))
+			}
+		case "height":
+			out.Height = float64(in.Float64())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = float64(in.Float64())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object40(out *jwriter.Writer, in object.VideoVideoImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"with_padding\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.WithPadding))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Height))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Width))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in *jlexer.Lexer, out *object.AudioAudioArtist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "domain":
+			out.Domain = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out *jwriter.Writer, in object.AudioAudioArtist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"domain\":"
+		out.RawString(prefix)
+		out.String(string(in.Domain))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object42(in *jlexer.Lexer, out *object.VideoRestriction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "always_shown":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AlwaysShown).UnmarshalJSON(data))
+			}
+		case "blur":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Blur).UnmarshalJSON(data))
+			}
+		case "can_play":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPlay).UnmarshalJSON(data))
+			}
+		case "can_preview":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPreview).UnmarshalJSON(data))
+			}
+		case "card_icon":
+			if in.IsNull() {
+				in.Skip()
+				out.CardIcon = nil
+			} else {
+				in.Delim('[')
+				if out.CardIcon == nil {
+					if !in.IsDelim(']') {
+						out.CardIcon = make([]object.BaseImage, 0, 1)
+					} else {
+						out.CardIcon = []object.BaseImage{}
+					}
+				} else {
+					out.CardIcon = (out.CardIcon)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v55).UnmarshalJSON(data))
+					}
+					out.CardIcon = append(out.CardIcon, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "list_icon":
+			if in.IsNull() {
+				in.Skip()
+				out.ListIcon = nil
+			} else {
+				in.Delim('[')
+				if out.ListIcon == nil {
+					if !in.IsDelim(']') {
+						out.ListIcon = make([]object.BaseImage, 0, 1)
+					} else {
+						out.ListIcon = []object.BaseImage{}
+					}
+				} else {
+					out.ListIcon = (out.ListIcon)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v56).UnmarshalJSON(data))
+					}
+					out.ListIcon = append(out.ListIcon, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "disclaimer_type":
+			out.DisclaimerType = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object43(out *jwriter.Writer, in object.VideoRestriction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"always_shown\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AlwaysShown))
+	}
+	{
+		const prefix string = ",\"blur\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blur))
+	}
+	{
+		const prefix string = ",\"can_play\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPlay))
+	}
+	{
+		const prefix string = ",\"can_preview\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPreview))
+	}
+	{
+		const prefix string = ",\"card_icon\":"
+		out.RawString(prefix)
+		if in.CardIcon == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.CardIcon {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v58)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"list_icon\":"
+		out.RawString(prefix)
+		if in.ListIcon == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.ListIcon {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v60)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"disclaimer_type\":"
+		out.RawString(prefix)
+		out.Int(int(in.DisclaimerType))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object41(in *jlexer.Lexer, out *object.VideoActionButton) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "snippet":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object45(in, &out.Snippet)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object42(out *jwriter.Writer, in object.VideoActionButton) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object45(out, in.Snippet)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object45(in *jlexer.Lexer, out *object.VideoSnippet) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "description":
+			out.Description = string(in.String())
+		case "open_title":
+			out.OpenTitle = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "type_name":
+			out.TypeName = string(in.String())
+		case "date":
+			out.Date = int(in.Int())
+		case "image":
+			if in.IsNull() {
+				in.Skip()
+				out.Image = nil
+			} else {
+				in.Delim('[')
+				if out.Image == nil {
+					if !in.IsDelim(']') {
+						out.Image = make([]object.BaseImage, 0, 1)
+					} else {
+						out.Image = []object.BaseImage{}
+					}
+				} else {
+					out.Image = (out.Image)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v61).UnmarshalJSON(data))
+					}
+					out.Image = append(out.Image, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object45(out *jwriter.Writer, in object.VideoSnippet) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"open_title\":"
+		out.RawString(prefix)
+		out.String(string(in.OpenTitle))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"type_name\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeName))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		if in.Image == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Image {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v63)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object40(in *jlexer.Lexer, out *object.Privacy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "category":
+			out.Category = object.PrivacyCategory(in.String())
+		case "lists":
+			easyjsonCce3d1beDecode1(in, &out.Lists)
+		case "owners":
+			easyjsonCce3d1beDecode1(in, &out.Owners)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object41(out *jwriter.Writer, in object.Privacy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Category != "" {
+		const prefix string = ",\"category\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Category))
+	}
+	if true {
+		const prefix string = ",\"lists\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonCce3d1beEncode1(out, in.Lists)
+	}
+	if true {
+		const prefix string = ",\"owners\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonCce3d1beEncode1(out, in.Owners)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecode1(in *jlexer.Lexer, out *struct {
+	Allowed  []int `json:"allowed"`
+	Excluded []int `json:"excluded"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "allowed":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowed = nil
+			} else {
+				in.Delim('[')
+				if out.Allowed == nil {
+					if !in.IsDelim(']') {
+						out.Allowed = make([]int, 0, 8)
+					} else {
+						out.Allowed = []int{}
+					}
+				} else {
+					out.Allowed = (out.Allowed)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 int
+					v64 = int(in.Int())
+					out.Allowed = append(out.Allowed, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "excluded":
+			if in.IsNull() {
+				in.Skip()
+				out.Excluded = nil
+			} else {
+				in.Delim('[')
+				if out.Excluded == nil {
+					if !in.IsDelim(']') {
+						out.Excluded = make([]int, 0, 8)
+					} else {
+						out.Excluded = []int{}
+					}
+				} else {
+					out.Excluded = (out.Excluded)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v65 int
+					v65 = int(in.Int())
+					out.Excluded = append(out.Excluded, v65)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncode1(out *jwriter.Writer, in struct {
+	Allowed  []int `json:"allowed"`
+	Excluded []int `json:"excluded"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"allowed\":"
+		out.RawString(prefix[1:])
+		if in.Allowed == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v66, v67 := range in.Allowed {
+				if v66 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v67))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"excluded\":"
+		out.RawString(prefix)
+		if in.Excluded == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Excluded {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v69))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object39(in *jlexer.Lexer, out *object.VideoVideoFiles) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "external":
+			out.External = string(in.String())
+		case "mp4_1080":
+			out.Mp4_1080 = string(in.String())
+		case "mp4_1440":
+			out.Mp4_1440 = string(in.String())
+		case "mp4_2160":
+			out.Mp4_2160 = string(in.String())
+		case "mp4_240":
+			out.Mp4_240 = string(in.String())
+		case "mp4_360":
+			out.Mp4_360 = string(in.String())
+		case "mp4_480":
+			out.Mp4_480 = string(in.String())
+		case "mp4_720":
+			out.Mp4_720 = string(in.String())
+		case "live":
+			out.Live = string(in.String())
+		case "hls":
+			out.HLS = string(in.String())
+		case "dash_uni":
+			out.DashUni = string(in.String())
+		case "dash_sep":
+			out.DashSep = string(in.String())
+		case "dash_webm":
+			out.DashWebm = string(in.String())
+		case "failover_host":
+			out.FailoverHost = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object39(out *jwriter.Writer, in object.VideoVideoFiles) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.External != "" {
+		const prefix string = ",\"external\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.External))
+	}
+	if in.Mp4_1080 != "" {
+		const prefix string = ",\"mp4_1080\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_1080))
+	}
+	if in.Mp4_1440 != "" {
+		const prefix string = ",\"mp4_1440\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_1440))
+	}
+	if in.Mp4_2160 != "" {
+		const prefix string = ",\"mp4_2160\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_2160))
+	}
+	if in.Mp4_240 != "" {
+		const prefix string = ",\"mp4_240\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_240))
+	}
+	if in.Mp4_360 != "" {
+		const prefix string = ",\"mp4_360\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_360))
+	}
+	if in.Mp4_480 != "" {
+		const prefix string = ",\"mp4_480\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_480))
+	}
+	if in.Mp4_720 != "" {
+		const prefix string = ",\"mp4_720\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Mp4_720))
+	}
+	if in.Live != "" {
+		const prefix string = ",\"live\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Live))
+	}
+	if in.HLS != "" {
+		const prefix string = ",\"hls\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.HLS))
+	}
+	if in.DashUni != "" {
+		const prefix string = ",\"dash_uni\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DashUni))
+	}
+	if in.DashSep != "" {
+		const prefix string = ",\"dash_sep\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DashSep))
+	}
+	if in.DashWebm != "" {
+		const prefix string = ",\"dash_webm\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DashWebm))
+	}
+	if in.FailoverHost != "" {
+		const prefix string = ",\"failover_host\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FailoverHost))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object28(in *jlexer.Lexer, out *object.WallPostedPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "photo_130":
+			out.Photo130 = string(in.String())
+		case "photo_604":
+			out.Photo604 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object29(out *jwriter.Writer, in object.WallPostedPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"photo_130\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo130))
+	}
+	{
+		const prefix string = ",\"photo_604\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo604))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object27(in *jlexer.Lexer, out *object.PollsPoll) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "answer_id":
+			out.AnswerID = int(in.Int())
+		case "answers":
+			if in.IsNull() {
+				in.Skip()
+				out.Answers = nil
+			} else {
+				in.Delim('[')
+				if out.Answers == nil {
+					if !in.IsDelim(']') {
+						out.Answers = make([]object.PollsAnswer, 0, 1)
+					} else {
+						out.Answers = []object.PollsAnswer{}
+					}
+				} else {
+					out.Answers = (out.Answers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 object.PollsAnswer
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object46(in, &v70)
+					out.Answers = append(out.Answers, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created":
+			out.Created = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "question":
+			out.Question = string(in.String())
+		case "votes":
+			out.Votes = int(in.Int())
+		case "answer_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AnswerIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AnswerIDs == nil {
+					if !in.IsDelim(']') {
+						out.AnswerIDs = make([]int, 0, 8)
+					} else {
+						out.AnswerIDs = []int{}
+					}
+				} else {
+					out.AnswerIDs = (out.AnswerIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v71 int
+					v71 = int(in.Int())
+					out.AnswerIDs = append(out.AnswerIDs, v71)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "end_date":
+			out.EndDate = int(in.Int())
+		case "anonymous":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Anonymous).UnmarshalJSON(data))
+			}
+		case "closed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Closed).UnmarshalJSON(data))
+			}
+		case "is_board":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsBoard).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "can_vote":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanVote).UnmarshalJSON(data))
+			}
+		case "can_report":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanReport).UnmarshalJSON(data))
+			}
+		case "can_share":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanShare).UnmarshalJSON(data))
+			}
+		case "multiple":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Multiple).UnmarshalJSON(data))
+			}
+		case "disable_unvote":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DisableUnvote).UnmarshalJSON(data))
+			}
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "author_id":
+			out.AuthorID = int(in.Int())
+		case "background":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object47(in, &out.Background)
+		case "friends":
+			if in.IsNull() {
+				in.Skip()
+				out.Friends = nil
+			} else {
+				in.Delim('[')
+				if out.Friends == nil {
+					if !in.IsDelim(']') {
+						out.Friends = make([]object.PollsFriend, 0, 8)
+					} else {
+						out.Friends = []object.PollsFriend{}
+					}
+				} else {
+					out.Friends = (out.Friends)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 object.PollsFriend
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object48(in, &v72)
+					out.Friends = append(out.Friends, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]object.UsersUser, 0, 0)
+					} else {
+						out.Profiles = []object.UsersUser{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 object.UsersUser
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object49(in, &v73)
+					out.Profiles = append(out.Profiles, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "groups":
+			if in.IsNull() {
+				in.Skip()
+				out.Groups = nil
+			} else {
+				in.Delim('[')
+				if out.Groups == nil {
+					if !in.IsDelim(']') {
+						out.Groups = make([]object.GroupsGroup, 0, 0)
+					} else {
+						out.Groups = []object.GroupsGroup{}
+					}
+				} else {
+					out.Groups = (out.Groups)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 object.GroupsGroup
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object50(in, &v74)
+					out.Groups = append(out.Groups, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "embed_hash":
+			out.EmbedHash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object28(out *jwriter.Writer, in object.PollsPoll) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"answer_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AnswerID))
+	}
+	{
+		const prefix string = ",\"answers\":"
+		out.RawString(prefix)
+		if in.Answers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v75, v76 := range in.Answers {
+				if v75 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object46(out, v76)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"votes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Votes))
+	}
+	{
+		const prefix string = ",\"answer_ids\":"
+		out.RawString(prefix)
+		if in.AnswerIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v77, v78 := range in.AnswerIDs {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v78))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"end_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.EndDate))
+	}
+	{
+		const prefix string = ",\"anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Anonymous))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"is_board\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBoard))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"can_vote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanVote))
+	}
+	{
+		const prefix string = ",\"can_report\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanReport))
+	}
+	{
+		const prefix string = ",\"can_share\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanShare))
+	}
+	{
+		const prefix string = ",\"multiple\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Multiple))
+	}
+	{
+		const prefix string = ",\"disable_unvote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DisableUnvote))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"background\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object47(out, in.Background)
+	}
+	{
+		const prefix string = ",\"friends\":"
+		out.RawString(prefix)
+		if in.Friends == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v79, v80 := range in.Friends {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object48(out, v80)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"profiles\":"
+		out.RawString(prefix)
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v81, v82 := range in.Profiles {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object49(out, v82)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"groups\":"
+		out.RawString(prefix)
+		if in.Groups == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Groups {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object50(out, v84)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"embed_hash\":"
+		out.RawString(prefix)
+		out.String(string(in.EmbedHash))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object50(in *jlexer.Lexer, out *object.GroupsGroup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "screen_name":
+			out.ScreenName = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "is_closed":
+			out.IsClosed = int(in.Int())
+		case "admin_level":
+			out.AdminLevel = int(in.Int())
+		case "deactivated":
+			out.Deactivated = string(in.String())
+		case "finish_date":
+			out.FinishDate = int(in.Int())
+		case "photo_100":
+			out.Photo100 = string(in.String())
+		case "photo_200":
+			out.Photo200 = string(in.String())
+		case "photo_50":
+			out.Photo50 = string(in.String())
+		case "start_date":
+			out.StartDate = int(in.Int())
+		case "market":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object51(in, &out.Market)
+		case "member_status":
+			out.MemberStatus = int(in.Int())
+		case "city":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object52(in, &out.City)
+		case "country":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object53(in, &out.Country)
+		case "is_admin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsAdmin).UnmarshalJSON(data))
+			}
+		case "is_advertiser":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsAdvertiser).UnmarshalJSON(data))
+			}
+		case "is_member":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMember).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_adult":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsAdult).UnmarshalJSON(data))
+			}
+		case "is_subscribed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSubscribed).UnmarshalJSON(data))
+			}
+		case "can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPost).UnmarshalJSON(data))
+			}
+		case "can_see_all_posts":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSeeAllPosts).UnmarshalJSON(data))
+			}
+		case "can_create_topic":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanCreateTopic).UnmarshalJSON(data))
+			}
+		case "can_upload_video":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanUploadVideo).UnmarshalJSON(data))
+			}
+		case "can_upload_doc":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanUploadDoc).UnmarshalJSON(data))
+			}
+		case "has_photo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasPhoto).UnmarshalJSON(data))
+			}
+		case "can_message":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanMessage).UnmarshalJSON(data))
+			}
+		case "is_messages_blocked":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMessagesBlocked).UnmarshalJSON(data))
+			}
+		case "can_send_notify":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSendNotify).UnmarshalJSON(data))
+			}
+		case "is_subscribed_podcasts":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSubscribedPodcasts).UnmarshalJSON(data))
+			}
+		case "can_subscribe_podcasts":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSubscribePodcasts).UnmarshalJSON(data))
+			}
+		case "can_subscribe_posts":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSubscribePosts).UnmarshalJSON(data))
+			}
+		case "has_market_app":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasMarketApp).UnmarshalJSON(data))
+			}
+		case "is_hidden_from_feed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHiddenFromFeed).UnmarshalJSON(data))
+			}
+		case "is_market_cart_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMarketCartEnabled).UnmarshalJSON(data))
+			}
+		case "verified":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Verified).UnmarshalJSON(data))
+			}
+		case "trending":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Trending).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "wiki_page":
+			out.WikiPage = string(in.String())
+		case "members_count":
+			out.MembersCount = int(in.Int())
+		case "counters":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Counters).UnmarshalJSON(data))
+			}
+		case "cover":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object54(in, &out.Cover)
+		case "activity":
+			out.Activity = string(in.String())
+		case "fixed_post":
+			out.FixedPost = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "main_album_id":
+			out.MainAlbumID = int(in.Int())
+		case "links":
+			if in.IsNull() {
+				in.Skip()
+				out.Links = nil
+			} else {
+				in.Delim('[')
+				if out.Links == nil {
+					if !in.IsDelim(']') {
+						out.Links = make([]object.GroupsLinksItem, 0, 0)
+					} else {
+						out.Links = []object.GroupsLinksItem{}
+					}
+				} else {
+					out.Links = (out.Links)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 object.GroupsLinksItem
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object55(in, &v85)
+					out.Links = append(out.Links, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "contacts":
+			if in.IsNull() {
+				in.Skip()
+				out.Contacts = nil
+			} else {
+				in.Delim('[')
+				if out.Contacts == nil {
+					if !in.IsDelim(']') {
+						out.Contacts = make([]object.GroupsContactsItem, 0, 1)
+					} else {
+						out.Contacts = []object.GroupsContactsItem{}
+					}
+				} else {
+					out.Contacts = (out.Contacts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v86 object.GroupsContactsItem
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object56(in, &v86)
+					out.Contacts = append(out.Contacts, v86)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "site":
+			out.Site = string(in.String())
+		case "main_section":
+			out.MainSection = int(in.Int())
+		case "online_status":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object57(in, &out.OnlineStatus)
+		case "age_limits":
+			out.AgeLimits = int(in.Int())
+		case "ban_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object58(in, &out.BanInfo)
+		case "addresses":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object59(in, &out.Addresses)
+		case "live_covers":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object60(in, &out.LiveCovers)
+		case "crop_photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object61(in, &out.CropPhoto)
+		case "wall":
+			out.Wall = int(in.Int())
+		case "action_button":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object62(in, &out.ActionButton)
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "public_date_label":
+			out.PublicDateLabel = string(in.String())
+		case "author_id":
+			out.AuthorID = int(in.Int())
+		case "phone":
+			out.Phone = string(in.String())
+		case "like":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object63(in, &out.Like)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object50(out *jwriter.Writer, in object.GroupsGroup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"screen_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ScreenName))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"is_closed\":"
+		out.RawString(prefix)
+		out.Int(int(in.IsClosed))
+	}
+	if in.AdminLevel != 0 {
+		const prefix string = ",\"admin_level\":"
+		out.RawString(prefix)
+		out.Int(int(in.AdminLevel))
+	}
+	if in.Deactivated != "" {
+		const prefix string = ",\"deactivated\":"
+		out.RawString(prefix)
+		out.String(string(in.Deactivated))
+	}
+	if in.FinishDate != 0 {
+		const prefix string = ",\"finish_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.FinishDate))
+	}
+	if in.Photo100 != "" {
+		const prefix string = ",\"photo_100\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo100))
+	}
+	if in.Photo200 != "" {
+		const prefix string = ",\"photo_200\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo200))
+	}
+	if in.Photo50 != "" {
+		const prefix string = ",\"photo_50\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo50))
+	}
+	if in.StartDate != 0 {
+		const prefix string = ",\"start_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.StartDate))
+	}
+	if true {
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object51(out, in.Market)
+	}
+	if in.MemberStatus != 0 {
+		const prefix string = ",\"member_status\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemberStatus))
+	}
+	if true {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object52(out, in.City)
+	}
+	if true {
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object53(out, in.Country)
+	}
+	{
+		const prefix string = ",\"is_admin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdmin))
+	}
+	if in.IsAdvertiser {
+		const prefix string = ",\"is_advertiser\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdvertiser))
+	}
+	if in.IsMember {
+		const prefix string = ",\"is_member\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMember))
+	}
+	if in.IsFavorite {
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	if in.IsAdult {
+		const prefix string = ",\"is_adult\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdult))
+	}
+	if in.IsSubscribed {
+		const prefix string = ",\"is_subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribed))
+	}
+	if in.CanPost {
+		const prefix string = ",\"can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPost))
+	}
+	if in.CanSeeAllPosts {
+		const prefix string = ",\"can_see_all_posts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSeeAllPosts))
+	}
+	if in.CanCreateTopic {
+		const prefix string = ",\"can_create_topic\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanCreateTopic))
+	}
+	if in.CanUploadVideo {
+		const prefix string = ",\"can_upload_video\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanUploadVideo))
+	}
+	if in.CanUploadDoc {
+		const prefix string = ",\"can_upload_doc\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanUploadDoc))
+	}
+	if in.HasPhoto {
+		const prefix string = ",\"has_photo\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasPhoto))
+	}
+	if in.CanMessage {
+		const prefix string = ",\"can_message\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanMessage))
+	}
+	if in.IsMessagesBlocked {
+		const prefix string = ",\"is_messages_blocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMessagesBlocked))
+	}
+	if in.CanSendNotify {
+		const prefix string = ",\"can_send_notify\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSendNotify))
+	}
+	if in.IsSubscribedPodcasts {
+		const prefix string = ",\"is_subscribed_podcasts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribedPodcasts))
+	}
+	if in.CanSubscribePodcasts {
+		const prefix string = ",\"can_subscribe_podcasts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSubscribePodcasts))
+	}
+	if in.CanSubscribePosts {
+		const prefix string = ",\"can_subscribe_posts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSubscribePosts))
+	}
+	if in.HasMarketApp {
+		const prefix string = ",\"has_market_app\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasMarketApp))
+	}
+	if in.IsHiddenFromFeed {
+		const prefix string = ",\"is_hidden_from_feed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHiddenFromFeed))
+	}
+	if in.IsMarketCartEnabled {
+		const prefix string = ",\"is_market_cart_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMarketCartEnabled))
+	}
+	if in.Verified {
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
+	if in.Trending {
+		const prefix string = ",\"trending\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Trending))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.WikiPage != "" {
+		const prefix string = ",\"wiki_page\":"
+		out.RawString(prefix)
+		out.String(string(in.WikiPage))
+	}
+	if in.MembersCount != 0 {
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembersCount))
+	}
+	if true {
+		const prefix string = ",\"counters\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object54(out, in.Counters)
+	}
+	if true {
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object55(out, in.Cover)
+	}
+	if in.Activity != "" {
+		const prefix string = ",\"activity\":"
+		out.RawString(prefix)
+		out.String(string(in.Activity))
+	}
+	if in.FixedPost != 0 {
+		const prefix string = ",\"fixed_post\":"
+		out.RawString(prefix)
+		out.Int(int(in.FixedPost))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.MainAlbumID != 0 {
+		const prefix string = ",\"main_album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MainAlbumID))
+	}
+	if len(in.Links) != 0 {
+		const prefix string = ",\"links\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v87, v88 := range in.Links {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object56(out, v88)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Contacts) != 0 {
+		const prefix string = ",\"contacts\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v89, v90 := range in.Contacts {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object57(out, v90)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Site != "" {
+		const prefix string = ",\"site\":"
+		out.RawString(prefix)
+		out.String(string(in.Site))
+	}
+	if in.MainSection != 0 {
+		const prefix string = ",\"main_section\":"
+		out.RawString(prefix)
+		out.Int(int(in.MainSection))
+	}
+	if true {
+		const prefix string = ",\"online_status\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object58(out, in.OnlineStatus)
+	}
+	if in.AgeLimits != 0 {
+		const prefix string = ",\"age_limits\":"
+		out.RawString(prefix)
+		out.Int(int(in.AgeLimits))
+	}
+	if true {
+		const prefix string = ",\"ban_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object59(out, in.BanInfo)
+	}
+	if true {
+		const prefix string = ",\"addresses\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object60(out, in.Addresses)
+	}
+	if true {
+		const prefix string = ",\"live_covers\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object61(out, in.LiveCovers)
+	}
+	if true {
+		const prefix string = ",\"crop_photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object62(out, in.CropPhoto)
+	}
+	if in.Wall != 0 {
+		const prefix string = ",\"wall\":"
+		out.RawString(prefix)
+		out.Int(int(in.Wall))
+	}
+	if true {
+		const prefix string = ",\"action_button\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object63(out, in.ActionButton)
+	}
+	if in.TrackCode != "" {
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	if in.PublicDateLabel != "" {
+		const prefix string = ",\"public_date_label\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicDateLabel))
+	}
+	if in.AuthorID != 0 {
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuthorID))
+	}
+	if in.Phone != "" {
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"like\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object64(out, in.Like)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object64(in *jlexer.Lexer, out *object.GroupsCountersGroup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "addresses":
+			out.Addresses = int(in.Int())
+		case "albums":
+			out.Albums = int(in.Int())
+		case "articles":
+			out.Articles = int(in.Int())
+		case "audios":
+			out.Audios = int(in.Int())
+		case "docs":
+			out.Docs = int(in.Int())
+		case "market":
+			out.Market = int(in.Int())
+		case "photos":
+			out.Photos = int(in.Int())
+		case "topics":
+			out.Topics = int(in.Int())
+		case "videos":
+			out.Videos = int(in.Int())
+		case "narratives":
+			out.Narratives = int(in.Int())
+		case "clips":
+			out.Clips = int(in.Int())
+		case "clips_followers":
+			out.ClipsFollowers = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object54(out *jwriter.Writer, in object.GroupsCountersGroup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"addresses\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Addresses))
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		out.Int(int(in.Albums))
+	}
+	{
+		const prefix string = ",\"articles\":"
+		out.RawString(prefix)
+		out.Int(int(in.Articles))
+	}
+	{
+		const prefix string = ",\"audios\":"
+		out.RawString(prefix)
+		out.Int(int(in.Audios))
+	}
+	{
+		const prefix string = ",\"docs\":"
+		out.RawString(prefix)
+		out.Int(int(in.Docs))
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		out.Int(int(in.Market))
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		out.Int(int(in.Photos))
+	}
+	{
+		const prefix string = ",\"topics\":"
+		out.RawString(prefix)
+		out.Int(int(in.Topics))
+	}
+	{
+		const prefix string = ",\"videos\":"
+		out.RawString(prefix)
+		out.Int(int(in.Videos))
+	}
+	{
+		const prefix string = ",\"narratives\":"
+		out.RawString(prefix)
+		out.Int(int(in.Narratives))
+	}
+	{
+		const prefix string = ",\"clips\":"
+		out.RawString(prefix)
+		out.Int(int(in.Clips))
+	}
+	{
+		const prefix string = ",\"clips_followers\":"
+		out.RawString(prefix)
+		out.Int(int(in.ClipsFollowers))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object63(in *jlexer.Lexer, out *object.GroupsGroupLike) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_liked":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsLiked).UnmarshalJSON(data))
+			}
+		case "friends":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object65(in, &out.Friends)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object64(out *jwriter.Writer, in object.GroupsGroupLike) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsLiked))
+	}
+	{
+		const prefix string = ",\"friends\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object65(out, in.Friends)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object65(in *jlexer.Lexer, out *object.GroupsGroupLikeFriends) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "preview":
+			if in.IsNull() {
+				in.Skip()
+				out.Preview = nil
+			} else {
+				in.Delim('[')
+				if out.Preview == nil {
+					if !in.IsDelim(']') {
+						out.Preview = make([]int, 0, 8)
+					} else {
+						out.Preview = []int{}
+					}
+				} else {
+					out.Preview = (out.Preview)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 int
+					v91 = int(in.Int())
+					out.Preview = append(out.Preview, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object65(out *jwriter.Writer, in object.GroupsGroupLikeFriends) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		if in.Preview == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Preview {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v93))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object62(in *jlexer.Lexer, out *object.GroupsActionButton) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action_type":
+			out.ActionType = object.GroupsActionType(in.String())
+		case "target":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object66(in, &out.Target)
+		case "title":
+			out.Title = string(in.String())
+		case "is_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsEnabled).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object63(out *jwriter.Writer, in object.GroupsActionButton) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ActionType))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object66(out, in.Target)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.IsEnabled {
+		const prefix string = ",\"is_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsEnabled))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object66(in *jlexer.Lexer, out *object.GroupsActionButtonTarget) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "google_store_url":
+			out.GoogleStoreURL = string(in.String())
+		case "itunes_url":
+			out.ItunesURL = string(in.String())
+		case "app_id":
+			out.AppID = int(in.Int())
+		case "is_internal":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsInternal).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object66(out *jwriter.Writer, in object.GroupsActionButtonTarget) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"google_store_url\":"
+		out.RawString(prefix)
+		out.String(string(in.GoogleStoreURL))
+	}
+	{
+		const prefix string = ",\"itunes_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ItunesURL))
+	}
+	{
+		const prefix string = ",\"app_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AppID))
+	}
+	{
+		const prefix string = ",\"is_internal\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsInternal))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object61(in *jlexer.Lexer, out *object.UsersCropPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "crop":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object67(in, &out.Crop)
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "rect":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object68(in, &out.Rect)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object62(out *jwriter.Writer, in object.UsersCropPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"crop\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object67(out, in.Crop)
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"rect\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object68(out, in.Rect)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object68(in *jlexer.Lexer, out *object.UsersCropPhotoRect) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "x":
+			out.X = float64(in.Float64())
+		case "x2":
+			out.X2 = float64(in.Float64())
+		case "y":
+			out.Y = float64(in.Float64())
+		case "y2":
+			out.Y2 = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object68(out *jwriter.Writer, in object.UsersCropPhotoRect) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.X))
+	}
+	{
+		const prefix string = ",\"x2\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.X2))
+	}
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y))
+	}
+	{
+		const prefix string = ",\"y2\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y2))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object67(in *jlexer.Lexer, out *object.UsersCropPhotoCrop) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "x":
+			out.X = float64(in.Float64())
+		case "x2":
+			out.X2 = float64(in.Float64())
+		case "y":
+			out.Y = float64(in.Float64())
+		case "y2":
+			out.Y2 = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object67(out *jwriter.Writer, in object.UsersCropPhotoCrop) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.X))
+	}
+	{
+		const prefix string = ",\"x2\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.X2))
+	}
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y))
+	}
+	{
+		const prefix string = ",\"y2\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Y2))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object60(in *jlexer.Lexer, out *object.GroupsLiveCovers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsEnabled).UnmarshalJSON(data))
+			}
+		case "is_scalable":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsScalable).UnmarshalJSON(data))
+			}
+		case "story_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.StoryIds = nil
+			} else {
+				in.Delim('[')
+				if out.StoryIds == nil {
+					if !in.IsDelim(']') {
+						out.StoryIds = make([]string, 0, 4)
+					} else {
+						out.StoryIds = []string{}
+					}
+				} else {
+					out.StoryIds = (out.StoryIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 string
+					v94 = string(in.String())
+					out.StoryIds = append(out.StoryIds, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object61(out *jwriter.Writer, in object.GroupsLiveCovers) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsEnabled))
+	}
+	{
+		const prefix string = ",\"is_scalable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsScalable))
+	}
+	{
+		const prefix string = ",\"story_ids\":"
+		out.RawString(prefix)
+		if in.StoryIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.StoryIds {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v96))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object59(in *jlexer.Lexer, out *object.GroupsAddressesInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsEnabled).UnmarshalJSON(data))
+			}
+		case "main_address_id":
+			out.MainAddressID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object60(out *jwriter.Writer, in object.GroupsAddressesInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsEnabled))
+	}
+	{
+		const prefix string = ",\"main_address_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MainAddressID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object58(in *jlexer.Lexer, out *object.GroupsGroupBanInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment":
+			out.Comment = string(in.String())
+		case "end_date":
+			out.EndDate = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object59(out *jwriter.Writer, in object.GroupsGroupBanInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"end_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.EndDate))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object57(in *jlexer.Lexer, out *object.GroupsOnlineStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "minutes":
+			out.Minutes = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object58(out *jwriter.Writer, in object.GroupsOnlineStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"minutes\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Minutes))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object56(in *jlexer.Lexer, out *object.GroupsContactsItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "desc":
+			out.Desc = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object57(out *jwriter.Writer, in object.GroupsContactsItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"desc\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Desc))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object55(in *jlexer.Lexer, out *object.GroupsLinksItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "desc":
+			out.Desc = string(in.String())
+		case "edit_title":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EditTitle).UnmarshalJSON(data))
+			}
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "photo_100":
+			out.Photo100 = string(in.String())
+		case "photo_50":
+			out.Photo50 = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object56(out *jwriter.Writer, in object.GroupsLinksItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"desc\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Desc))
+	}
+	{
+		const prefix string = ",\"edit_title\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EditTitle))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"photo_100\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo100))
+	}
+	{
+		const prefix string = ",\"photo_50\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo50))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object54(in *jlexer.Lexer, out *object.GroupsCover) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Enabled).UnmarshalJSON(data))
+			}
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]object.BaseImage, 0, 1)
+					} else {
+						out.Images = []object.BaseImage{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v97).UnmarshalJSON(data))
+					}
+					out.Images = append(out.Images, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object55(out *jwriter.Writer, in object.GroupsCover) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v98, v99 := range in.Images {
+				if v98 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v99)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object53(in *jlexer.Lexer, out *object.BaseCountry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object53(out *jwriter.Writer, in object.BaseCountry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object52(in *jlexer.Lexer, out *object.BaseObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object52(out *jwriter.Writer, in object.BaseObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object51(in *jlexer.Lexer, out *object.GroupsMarketInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = object.GroupsMarketType(in.String())
+		case "contact_id":
+			out.ContactID = int(in.Int())
+		case "currency":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object36(in, &out.Currency)
+		case "currency_text":
+			out.CurrencyText = string(in.String())
+		case "enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Enabled).UnmarshalJSON(data))
+			}
+		case "comments_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CommentsEnabled).UnmarshalJSON(data))
+			}
+		case "can_message":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanMessage).UnmarshalJSON(data))
+			}
+		case "is_hs_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHsEnabled).UnmarshalJSON(data))
+			}
+		case "main_album_id":
+			out.MainAlbumID = int(in.Int())
+		case "price_max":
+			out.PriceMax = string(in.String())
+		case "price_min":
+			out.PriceMin = string(in.String())
+		case "wiki":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object25(in, &out.Wiki)
+		case "city_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.CityIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CityIDs == nil {
+					if !in.IsDelim(']') {
+						out.CityIDs = make([]int, 0, 8)
+					} else {
+						out.CityIDs = []int{}
+					}
+				} else {
+					out.CityIDs = (out.CityIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 int
+					v100 = int(in.Int())
+					out.CityIDs = append(out.CityIDs, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "country_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.CountryIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CountryIDs == nil {
+					if !in.IsDelim(']') {
+						out.CountryIDs = make([]int, 0, 8)
+					} else {
+						out.CountryIDs = []int{}
+					}
+				} else {
+					out.CountryIDs = (out.CountryIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v101 int
+					v101 = int(in.Int())
+					out.CountryIDs = append(out.CountryIDs, v101)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min_order_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MinOrderPrice).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object51(out *jwriter.Writer, in object.GroupsMarketInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.ContactID != 0 {
+		const prefix string = ",\"contact_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ContactID))
+	}
+	if true {
+		const prefix string = ",\"currency\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object36(out, in.Currency)
+	}
+	if in.CurrencyText != "" {
+		const prefix string = ",\"currency_text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CurrencyText))
+	}
+	{
+		const prefix string = ",\"enabled\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Enabled))
+	}
+	if in.CommentsEnabled {
+		const prefix string = ",\"comments_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CommentsEnabled))
+	}
+	if in.CanMessage {
+		const prefix string = ",\"can_message\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanMessage))
+	}
+	if in.IsHsEnabled {
+		const prefix string = ",\"is_hs_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHsEnabled))
+	}
+	if in.MainAlbumID != 0 {
+		const prefix string = ",\"main_album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MainAlbumID))
+	}
+	if in.PriceMax != "" {
+		const prefix string = ",\"price_max\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMax))
+	}
+	if in.PriceMin != "" {
+		const prefix string = ",\"price_min\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMin))
+	}
+	if true {
+		const prefix string = ",\"wiki\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object26(out, in.Wiki)
+	}
+	{
+		const prefix string = ",\"city_ids\":"
+		out.RawString(prefix)
+		if in.CityIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v102, v103 := range in.CityIDs {
+				if v102 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v103))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CountryIDs) != 0 {
+		const prefix string = ",\"country_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v104, v105 := range in.CountryIDs {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v105))
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"min_order_price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.MinOrderPrice)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object49(in *jlexer.Lexer, out *object.UsersUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "first_name":
+			out.FirstName = string(in.String())
+		case "last_name":
+			out.LastName = string(in.String())
+		case "first_name_nom":
+			out.FirstNameNom = string(in.String())
+		case "first_name_gen":
+			out.FirstNameGen = string(in.String())
+		case "first_name_dat":
+			out.FirstNameDat = string(in.String())
+		case "first_name_acc":
+			out.FirstNameAcc = string(in.String())
+		case "first_name_ins":
+			out.FirstNameIns = string(in.String())
+		case "first_name_abl":
+			out.FirstNameAbl = string(in.String())
+		case "last_name_nom":
+			out.LastNameNom = string(in.String())
+		case "last_name_gen":
+			out.LastNameGen = string(in.String())
+		case "last_name_dat":
+			out.LastNameDat = string(in.String())
+		case "last_name_acc":
+			out.LastNameAcc = string(in.String())
+		case "last_name_ins":
+			out.LastNameIns = string(in.String())
+		case "last_name_abl":
+			out.LastNameAbl = string(in.String())
+		case "maiden_name":
+			out.MaidenName = string(in.String())
+		case "sex":
+			out.Sex = int(in.Int())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "domain":
+			out.Domain = string(in.String())
+		case "screen_name":
+			out.ScreenName = string(in.String())
+		case "bdate":
+			out.Bdate = string(in.String())
+		case "city":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object52(in, &out.City)
+		case "country":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object52(in, &out.Country)
+		case "photo_50":
+			out.Photo50 = string(in.String())
+		case "photo_100":
+			out.Photo100 = string(in.String())
+		case "photo_200":
+			out.Photo200 = string(in.String())
+		case "photo_max":
+			out.PhotoMax = string(in.String())
+		case "photo_200_orig":
+			out.Photo200Orig = string(in.String())
+		case "photo_400_orig":
+			out.Photo400Orig = string(in.String())
+		case "photo_max_orig":
+			out.PhotoMaxOrig = string(in.String())
+		case "photo_id":
+			out.PhotoID = string(in.String())
+		case "friend_status":
+			out.FriendStatus = int(in.Int())
+		case "online_app":
+			out.OnlineApp = int(in.Int())
+		case "online":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Online).UnmarshalJSON(data))
+			}
+		case "online_mobile":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.OnlineMobile).UnmarshalJSON(data))
+			}
+		case "has_photo":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasPhoto).UnmarshalJSON(data))
+			}
+		case "has_mobile":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasMobile).UnmarshalJSON(data))
+			}
+		case "is_closed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsClosed).UnmarshalJSON(data))
+			}
+		case "is_friend":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFriend).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_hidden_from_feed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHiddenFromFeed).UnmarshalJSON(data))
+			}
+		case "can_access_closed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAccessClosed).UnmarshalJSON(data))
+			}
+		case "can_be_invited_group":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanBeInvitedGroup).UnmarshalJSON(data))
+			}
+		case "can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPost).UnmarshalJSON(data))
+			}
+		case "can_see_all_posts":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSeeAllPosts).UnmarshalJSON(data))
+			}
+		case "can_see_audio":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSeeAudio).UnmarshalJSON(data))
+			}
+		case "can_write_private_message":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanWritePrivateMessage).UnmarshalJSON(data))
+			}
+		case "can_send_friend_request":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSendFriendRequest).UnmarshalJSON(data))
+			}
+		case "can_call_from_group":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanCallFromGroup).UnmarshalJSON(data))
+			}
+		case "verified":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Verified).UnmarshalJSON(data))
+			}
+		case "trending":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Trending).UnmarshalJSON(data))
+			}
+		case "blacklisted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Blacklisted).UnmarshalJSON(data))
+			}
+		case "blacklisted_by_me":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BlacklistedByMe).UnmarshalJSON(data))
+			}
+		case "facebook":
+			out.Facebook = string(in.String())
+		case "facebook_name":
+			out.FacebookName = string(in.String())
+		case "twitter":
+			out.Twitter = string(in.String())
+		case "instagram":
+			out.Instagram = string(in.String())
+		case "site":
+			out.Site = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "status_audio":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in, &out.StatusAudio)
+		case "last_seen":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object69(in, &out.LastSeen)
+		case "crop_photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object61(in, &out.CropPhoto)
+		case "followers_count":
+			out.FollowersCount = int(in.Int())
+		case "common_count":
+			out.CommonCount = int(in.Int())
+		case "occupation":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object70(in, &out.Occupation)
+		case "career":
+			if in.IsNull() {
+				in.Skip()
+				out.Career = nil
+			} else {
+				in.Delim('[')
+				if out.Career == nil {
+					if !in.IsDelim(']') {
+						out.Career = make([]object.UsersCareer, 0, 0)
+					} else {
+						out.Career = []object.UsersCareer{}
+					}
+				} else {
+					out.Career = (out.Career)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 object.UsersCareer
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object71(in, &v106)
+					out.Career = append(out.Career, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "military":
+			if in.IsNull() {
+				in.Skip()
+				out.Military = nil
+			} else {
+				in.Delim('[')
+				if out.Military == nil {
+					if !in.IsDelim(']') {
+						out.Military = make([]object.UsersMilitary, 0, 1)
+					} else {
+						out.Military = []object.UsersMilitary{}
+					}
+				} else {
+					out.Military = (out.Military)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v107 object.UsersMilitary
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object72(in, &v107)
+					out.Military = append(out.Military, v107)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "university":
+			out.University = int(in.Int())
+		case "university_name":
+			out.UniversityName = string(in.String())
+		case "faculty":
+			out.Faculty = int(in.Int())
+		case "faculty_name":
+			out.FacultyName = string(in.String())
+		case "graduation":
+			out.Graduation = int(in.Int())
+		case "education_form":
+			out.EducationForm = string(in.String())
+		case "education_status":
+			out.EducationStatus = string(in.String())
+		case "home_town":
+			out.HomeTown = string(in.String())
+		case "relation":
+			out.Relation = int(in.Int())
+		case "personal":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Personal).UnmarshalJSON(data))
+			}
+		case "interests":
+			out.Interests = string(in.String())
+		case "music":
+			out.Music = string(in.String())
+		case "activities":
+			out.Activities = string(in.String())
+		case "movies":
+			out.Movies = string(in.String())
+		case "tv":
+			out.Tv = string(in.String())
+		case "books":
+			out.Books = string(in.String())
+		case "games":
+			out.Games = string(in.String())
+		case "universities":
+			if in.IsNull() {
+				in.Skip()
+				out.Universities = nil
+			} else {
+				in.Delim('[')
+				if out.Universities == nil {
+					if !in.IsDelim(']') {
+						out.Universities = make([]object.UsersUniversity, 0, 0)
+					} else {
+						out.Universities = []object.UsersUniversity{}
+					}
+				} else {
+					out.Universities = (out.Universities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v108 object.UsersUniversity
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object73(in, &v108)
+					out.Universities = append(out.Universities, v108)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "schools":
+			if in.IsNull() {
+				in.Skip()
+				out.Schools = nil
+			} else {
+				in.Delim('[')
+				if out.Schools == nil {
+					if !in.IsDelim(']') {
+						out.Schools = make([]object.UsersSchool, 0, 0)
+					} else {
+						out.Schools = []object.UsersSchool{}
+					}
+				} else {
+					out.Schools = (out.Schools)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v109 object.UsersSchool
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object74(in, &v109)
+					out.Schools = append(out.Schools, v109)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "about":
+			out.About = string(in.String())
+		case "relatives":
+			if in.IsNull() {
+				in.Skip()
+				out.Relatives = nil
+			} else {
+				in.Delim('[')
+				if out.Relatives == nil {
+					if !in.IsDelim(']') {
+						out.Relatives = make([]object.UsersRelative, 0, 1)
+					} else {
+						out.Relatives = []object.UsersRelative{}
+					}
+				} else {
+					out.Relatives = (out.Relatives)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v110 object.UsersRelative
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object75(in, &v110)
+					out.Relatives = append(out.Relatives, v110)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quotes":
+			out.Quotes = string(in.String())
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]int, 0, 8)
+					} else {
+						out.Lists = []int{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v111 int
+					v111 = int(in.Int())
+					out.Lists = append(out.Lists, v111)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deactivated":
+			out.Deactivated = string(in.String())
+		case "wall_default":
+			out.WallDefault = string(in.String())
+		case "timezone":
+			out.Timezone = int(in.Int())
+		case "exports":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object76(in, &out.Exports)
+		case "counters":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object77(in, &out.Counters)
+		case "mobile_phone":
+			out.MobilePhone = string(in.String())
+		case "home_phone":
+			out.HomePhone = string(in.String())
+		case "found_with":
+			out.FoundWith = int(in.Int())
+		case "online_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object78(in, &out.OnlineInfo)
+		case "mutual":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object79(in, &out.Mutual)
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "relation_partner":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object80(in, &out.RelationPartner)
+		case "type":
+			out.Type = string(in.String())
+		case "skype":
+			out.Skype = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object49(out *jwriter.Writer, in object.UsersUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"first_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"last_name\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	{
+		const prefix string = ",\"first_name_nom\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameNom))
+	}
+	{
+		const prefix string = ",\"first_name_gen\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameGen))
+	}
+	{
+		const prefix string = ",\"first_name_dat\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameDat))
+	}
+	{
+		const prefix string = ",\"first_name_acc\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameAcc))
+	}
+	{
+		const prefix string = ",\"first_name_ins\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameIns))
+	}
+	{
+		const prefix string = ",\"first_name_abl\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNameAbl))
+	}
+	{
+		const prefix string = ",\"last_name_nom\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameNom))
+	}
+	{
+		const prefix string = ",\"last_name_gen\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameGen))
+	}
+	{
+		const prefix string = ",\"last_name_dat\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameDat))
+	}
+	{
+		const prefix string = ",\"last_name_acc\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameAcc))
+	}
+	{
+		const prefix string = ",\"last_name_ins\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameIns))
+	}
+	{
+		const prefix string = ",\"last_name_abl\":"
+		out.RawString(prefix)
+		out.String(string(in.LastNameAbl))
+	}
+	{
+		const prefix string = ",\"maiden_name\":"
+		out.RawString(prefix)
+		out.String(string(in.MaidenName))
+	}
+	{
+		const prefix string = ",\"sex\":"
+		out.RawString(prefix)
+		out.Int(int(in.Sex))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"domain\":"
+		out.RawString(prefix)
+		out.String(string(in.Domain))
+	}
+	{
+		const prefix string = ",\"screen_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ScreenName))
+	}
+	{
+		const prefix string = ",\"bdate\":"
+		out.RawString(prefix)
+		out.String(string(in.Bdate))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object52(out, in.City)
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object52(out, in.Country)
+	}
+	{
+		const prefix string = ",\"photo_50\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo50))
+	}
+	{
+		const prefix string = ",\"photo_100\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo100))
+	}
+	{
+		const prefix string = ",\"photo_200\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo200))
+	}
+	{
+		const prefix string = ",\"photo_max\":"
+		out.RawString(prefix)
+		out.String(string(in.PhotoMax))
+	}
+	{
+		const prefix string = ",\"photo_200_orig\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo200Orig))
+	}
+	{
+		const prefix string = ",\"photo_400_orig\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo400Orig))
+	}
+	{
+		const prefix string = ",\"photo_max_orig\":"
+		out.RawString(prefix)
+		out.String(string(in.PhotoMaxOrig))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.String(string(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"friend_status\":"
+		out.RawString(prefix)
+		out.Int(int(in.FriendStatus))
+	}
+	{
+		const prefix string = ",\"online_app\":"
+		out.RawString(prefix)
+		out.Int(int(in.OnlineApp))
+	}
+	{
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	{
+		const prefix string = ",\"online_mobile\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OnlineMobile))
+	}
+	{
+		const prefix string = ",\"has_photo\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasPhoto))
+	}
+	{
+		const prefix string = ",\"has_mobile\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasMobile))
+	}
+	{
+		const prefix string = ",\"is_closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsClosed))
+	}
+	{
+		const prefix string = ",\"is_friend\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFriend))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_hidden_from_feed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHiddenFromFeed))
+	}
+	{
+		const prefix string = ",\"can_access_closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAccessClosed))
+	}
+	{
+		const prefix string = ",\"can_be_invited_group\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanBeInvitedGroup))
+	}
+	{
+		const prefix string = ",\"can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPost))
+	}
+	{
+		const prefix string = ",\"can_see_all_posts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSeeAllPosts))
+	}
+	{
+		const prefix string = ",\"can_see_audio\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSeeAudio))
+	}
+	{
+		const prefix string = ",\"can_write_private_message\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanWritePrivateMessage))
+	}
+	{
+		const prefix string = ",\"can_send_friend_request\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSendFriendRequest))
+	}
+	{
+		const prefix string = ",\"can_call_from_group\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanCallFromGroup))
+	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
+	{
+		const prefix string = ",\"trending\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Trending))
+	}
+	{
+		const prefix string = ",\"blacklisted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blacklisted))
+	}
+	{
+		const prefix string = ",\"blacklisted_by_me\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BlacklistedByMe))
+	}
+	{
+		const prefix string = ",\"facebook\":"
+		out.RawString(prefix)
+		out.String(string(in.Facebook))
+	}
+	{
+		const prefix string = ",\"facebook_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FacebookName))
+	}
+	{
+		const prefix string = ",\"twitter\":"
+		out.RawString(prefix)
+		out.String(string(in.Twitter))
+	}
+	{
+		const prefix string = ",\"instagram\":"
+		out.RawString(prefix)
+		out.String(string(in.Instagram))
+	}
+	{
+		const prefix string = ",\"site\":"
+		out.RawString(prefix)
+		out.String(string(in.Site))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"status_audio\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out, in.StatusAudio)
+	}
+	{
+		const prefix string = ",\"last_seen\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object69(out, in.LastSeen)
+	}
+	{
+		const prefix string = ",\"crop_photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object62(out, in.CropPhoto)
+	}
+	{
+		const prefix string = ",\"followers_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowersCount))
+	}
+	{
+		const prefix string = ",\"common_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommonCount))
+	}
+	{
+		const prefix string = ",\"occupation\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object70(out, in.Occupation)
+	}
+	{
+		const prefix string = ",\"career\":"
+		out.RawString(prefix)
+		if in.Career == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v112, v113 := range in.Career {
+				if v112 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object71(out, v113)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"military\":"
+		out.RawString(prefix)
+		if in.Military == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v114, v115 := range in.Military {
+				if v114 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object72(out, v115)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"university\":"
+		out.RawString(prefix)
+		out.Int(int(in.University))
+	}
+	{
+		const prefix string = ",\"university_name\":"
+		out.RawString(prefix)
+		out.String(string(in.UniversityName))
+	}
+	{
+		const prefix string = ",\"faculty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Faculty))
+	}
+	{
+		const prefix string = ",\"faculty_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FacultyName))
+	}
+	{
+		const prefix string = ",\"graduation\":"
+		out.RawString(prefix)
+		out.Int(int(in.Graduation))
+	}
+	{
+		const prefix string = ",\"education_form\":"
+		out.RawString(prefix)
+		out.String(string(in.EducationForm))
+	}
+	{
+		const prefix string = ",\"education_status\":"
+		out.RawString(prefix)
+		out.String(string(in.EducationStatus))
+	}
+	{
+		const prefix string = ",\"home_town\":"
+		out.RawString(prefix)
+		out.String(string(in.HomeTown))
+	}
+	{
+		const prefix string = ",\"relation\":"
+		out.RawString(prefix)
+		out.Int(int(in.Relation))
+	}
+	{
+		const prefix string = ",\"personal\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object73(out, in.Personal)
+	}
+	{
+		const prefix string = ",\"interests\":"
+		out.RawString(prefix)
+		out.String(string(in.Interests))
+	}
+	{
+		const prefix string = ",\"music\":"
+		out.RawString(prefix)
+		out.String(string(in.Music))
+	}
+	{
+		const prefix string = ",\"activities\":"
+		out.RawString(prefix)
+		out.String(string(in.Activities))
+	}
+	{
+		const prefix string = ",\"movies\":"
+		out.RawString(prefix)
+		out.String(string(in.Movies))
+	}
+	{
+		const prefix string = ",\"tv\":"
+		out.RawString(prefix)
+		out.String(string(in.Tv))
+	}
+	{
+		const prefix string = ",\"books\":"
+		out.RawString(prefix)
+		out.String(string(in.Books))
+	}
+	{
+		const prefix string = ",\"games\":"
+		out.RawString(prefix)
+		out.String(string(in.Games))
+	}
+	{
+		const prefix string = ",\"universities\":"
+		out.RawString(prefix)
+		if in.Universities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v116, v117 := range in.Universities {
+				if v116 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object74(out, v117)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"schools\":"
+		out.RawString(prefix)
+		if in.Schools == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v118, v119 := range in.Schools {
+				if v118 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object75(out, v119)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"about\":"
+		out.RawString(prefix)
+		out.String(string(in.About))
+	}
+	{
+		const prefix string = ",\"relatives\":"
+		out.RawString(prefix)
+		if in.Relatives == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v120, v121 := range in.Relatives {
+				if v120 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object76(out, v121)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"quotes\":"
+		out.RawString(prefix)
+		out.String(string(in.Quotes))
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v122, v123 := range in.Lists {
+				if v122 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v123))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"deactivated\":"
+		out.RawString(prefix)
+		out.String(string(in.Deactivated))
+	}
+	{
+		const prefix string = ",\"wall_default\":"
+		out.RawString(prefix)
+		out.String(string(in.WallDefault))
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.Int(int(in.Timezone))
+	}
+	{
+		const prefix string = ",\"exports\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object77(out, in.Exports)
+	}
+	{
+		const prefix string = ",\"counters\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object78(out, in.Counters)
+	}
+	{
+		const prefix string = ",\"mobile_phone\":"
+		out.RawString(prefix)
+		out.String(string(in.MobilePhone))
+	}
+	{
+		const prefix string = ",\"home_phone\":"
+		out.RawString(prefix)
+		out.String(string(in.HomePhone))
+	}
+	{
+		const prefix string = ",\"found_with\":"
+		out.RawString(prefix)
+		out.Int(int(in.FoundWith))
+	}
+	{
+		const prefix string = ",\"online_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object79(out, in.OnlineInfo)
+	}
+	{
+		const prefix string = ",\"mutual\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object80(out, in.Mutual)
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"relation_partner\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object81(out, in.RelationPartner)
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"skype\":"
+		out.RawString(prefix)
+		out.String(string(in.Skype))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object81(in *jlexer.Lexer, out *object.UsersPersonal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "alcohol":
+			out.Alcohol = int(in.Int())
+		case "inspired_by":
+			out.InspiredBy = string(in.String())
+		case "langs":
+			if in.IsNull() {
+				in.Skip()
+				out.Langs = nil
+			} else {
+				in.Delim('[')
+				if out.Langs == nil {
+					if !in.IsDelim(']') {
+						out.Langs = make([]string, 0, 4)
+					} else {
+						out.Langs = []string{}
+					}
+				} else {
+					out.Langs = (out.Langs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v124 string
+					v124 = string(in.String())
+					out.Langs = append(out.Langs, v124)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "life_main":
+			out.LifeMain = int(in.Int())
+		case "people_main":
+			out.PeopleMain = int(in.Int())
+		case "political":
+			out.Political = int(in.Int())
+		case "religion":
+			out.Religion = string(in.String())
+		case "smoking":
+			out.Smoking = int(in.Int())
+		case "religion_id":
+			out.ReligionID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object73(out *jwriter.Writer, in object.UsersPersonal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"alcohol\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Alcohol))
+	}
+	{
+		const prefix string = ",\"inspired_by\":"
+		out.RawString(prefix)
+		out.String(string(in.InspiredBy))
+	}
+	{
+		const prefix string = ",\"langs\":"
+		out.RawString(prefix)
+		if in.Langs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v125, v126 := range in.Langs {
+				if v125 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v126))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"life_main\":"
+		out.RawString(prefix)
+		out.Int(int(in.LifeMain))
+	}
+	{
+		const prefix string = ",\"people_main\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeopleMain))
+	}
+	{
+		const prefix string = ",\"political\":"
+		out.RawString(prefix)
+		out.Int(int(in.Political))
+	}
+	{
+		const prefix string = ",\"religion\":"
+		out.RawString(prefix)
+		out.String(string(in.Religion))
+	}
+	{
+		const prefix string = ",\"smoking\":"
+		out.RawString(prefix)
+		out.Int(int(in.Smoking))
+	}
+	{
+		const prefix string = ",\"religion_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReligionID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object80(in *jlexer.Lexer, out *object.UsersUserMin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "deactivated":
+			out.Deactivated = string(in.String())
+		case "first_name":
+			out.FirstName = string(in.String())
+		case "hidden":
+			out.Hidden = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "last_name":
+			out.LastName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object81(out *jwriter.Writer, in object.UsersUserMin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"deactivated\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Deactivated))
+	}
+	{
+		const prefix string = ",\"first_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"hidden\":"
+		out.RawString(prefix)
+		out.Int(int(in.Hidden))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"last_name\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object79(in *jlexer.Lexer, out *object.FriendsRequestsMutual) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]int, 0, 8)
+					} else {
+						out.Users = []int{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v127 int
+					v127 = int(in.Int())
+					out.Users = append(out.Users, v127)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object80(out *jwriter.Writer, in object.FriendsRequestsMutual) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v128, v129 := range in.Users {
+				if v128 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v129))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object78(in *jlexer.Lexer, out *object.UsersOnlineInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "app_id":
+			out.AppID = int(in.Int())
+		case "last_seen":
+			out.LastSeen = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "visible":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Visible).UnmarshalJSON(data))
+			}
+		case "is_online":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsOnline).UnmarshalJSON(data))
+			}
+		case "is_mobile":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMobile).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object79(out *jwriter.Writer, in object.UsersOnlineInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"app_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AppID))
+	}
+	{
+		const prefix string = ",\"last_seen\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastSeen))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"visible\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Visible))
+	}
+	{
+		const prefix string = ",\"is_online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOnline))
+	}
+	{
+		const prefix string = ",\"is_mobile\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMobile))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object77(in *jlexer.Lexer, out *object.UsersUserCounters) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "albums":
+			out.Albums = int(in.Int())
+		case "audios":
+			out.Audios = int(in.Int())
+		case "followers":
+			out.Followers = int(in.Int())
+		case "friends":
+			out.Friends = int(in.Int())
+		case "gifts":
+			out.Gifts = int(in.Int())
+		case "groups":
+			out.Groups = int(in.Int())
+		case "notes":
+			out.Notes = int(in.Int())
+		case "online_friends":
+			out.OnlineFriends = int(in.Int())
+		case "pages":
+			out.Pages = int(in.Int())
+		case "photos":
+			out.Photos = int(in.Int())
+		case "subscriptions":
+			out.Subscriptions = int(in.Int())
+		case "user_photos":
+			out.UserPhotos = int(in.Int())
+		case "user_videos":
+			out.UserVideos = int(in.Int())
+		case "videos":
+			out.Videos = int(in.Int())
+		case "mutual_friends":
+			out.MutualFriends = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object78(out *jwriter.Writer, in object.UsersUserCounters) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Albums))
+	}
+	{
+		const prefix string = ",\"audios\":"
+		out.RawString(prefix)
+		out.Int(int(in.Audios))
+	}
+	{
+		const prefix string = ",\"followers\":"
+		out.RawString(prefix)
+		out.Int(int(in.Followers))
+	}
+	{
+		const prefix string = ",\"friends\":"
+		out.RawString(prefix)
+		out.Int(int(in.Friends))
+	}
+	{
+		const prefix string = ",\"gifts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Gifts))
+	}
+	{
+		const prefix string = ",\"groups\":"
+		out.RawString(prefix)
+		out.Int(int(in.Groups))
+	}
+	{
+		const prefix string = ",\"notes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Notes))
+	}
+	{
+		const prefix string = ",\"online_friends\":"
+		out.RawString(prefix)
+		out.Int(int(in.OnlineFriends))
+	}
+	{
+		const prefix string = ",\"pages\":"
+		out.RawString(prefix)
+		out.Int(int(in.Pages))
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		out.Int(int(in.Photos))
+	}
+	{
+		const prefix string = ",\"subscriptions\":"
+		out.RawString(prefix)
+		out.Int(int(in.Subscriptions))
+	}
+	{
+		const prefix string = ",\"user_photos\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserPhotos))
+	}
+	{
+		const prefix string = ",\"user_videos\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserVideos))
+	}
+	{
+		const prefix string = ",\"videos\":"
+		out.RawString(prefix)
+		out.Int(int(in.Videos))
+	}
+	{
+		const prefix string = ",\"mutual_friends\":"
+		out.RawString(prefix)
+		out.Int(int(in.MutualFriends))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object76(in *jlexer.Lexer, out *object.UsersExports) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "facebook":
+			out.Facebook = int(in.Int())
+		case "livejournal":
+			out.Livejournal = int(in.Int())
+		case "twitter":
+			out.Twitter = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object77(out *jwriter.Writer, in object.UsersExports) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"facebook\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Facebook))
+	}
+	{
+		const prefix string = ",\"livejournal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Livejournal))
+	}
+	{
+		const prefix string = ",\"twitter\":"
+		out.RawString(prefix)
+		out.Int(int(in.Twitter))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object75(in *jlexer.Lexer, out *object.UsersRelative) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object76(out *jwriter.Writer, in object.UsersRelative) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object74(in *jlexer.Lexer, out *object.UsersSchool) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "city":
+			out.City = int(in.Int())
+		case "class":
+			out.Class = string(in.String())
+		case "country":
+			out.Country = int(in.Int())
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = int(in.Int())
+		case "type_str":
+			out.TypeStr = string(in.String())
+		case "year_from":
+			out.YearFrom = int(in.Int())
+		case "year_graduated":
+			out.YearGraduated = int(in.Int())
+		case "year_to":
+			out.YearTo = int(in.Int())
+		case "speciality":
+			out.Speciality = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object75(out *jwriter.Writer, in object.UsersSchool) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.City))
+	}
+	{
+		const prefix string = ",\"class\":"
+		out.RawString(prefix)
+		out.String(string(in.Class))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.Int(int(in.Country))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Int(int(in.Type))
+	}
+	{
+		const prefix string = ",\"type_str\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeStr))
+	}
+	{
+		const prefix string = ",\"year_from\":"
+		out.RawString(prefix)
+		out.Int(int(in.YearFrom))
+	}
+	{
+		const prefix string = ",\"year_graduated\":"
+		out.RawString(prefix)
+		out.Int(int(in.YearGraduated))
+	}
+	{
+		const prefix string = ",\"year_to\":"
+		out.RawString(prefix)
+		out.Int(int(in.YearTo))
+	}
+	if in.Speciality != "" {
+		const prefix string = ",\"speciality\":"
+		out.RawString(prefix)
+		out.String(string(in.Speciality))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object73(in *jlexer.Lexer, out *object.UsersUniversity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chair":
+			out.Chair = int(in.Int())
+		case "chair_name":
+			out.ChairName = string(in.String())
+		case "city":
+			out.City = int(in.Int())
+		case "country":
+			out.Country = int(in.Int())
+		case "education_form":
+			out.EducationForm = string(in.String())
+		case "education_status":
+			out.EducationStatus = string(in.String())
+		case "faculty":
+			out.Faculty = int(in.Int())
+		case "faculty_name":
+			out.FacultyName = string(in.String())
+		case "graduation":
+			out.Graduation = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object74(out *jwriter.Writer, in object.UsersUniversity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chair\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Chair))
+	}
+	{
+		const prefix string = ",\"chair_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ChairName))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Int(int(in.City))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.Int(int(in.Country))
+	}
+	{
+		const prefix string = ",\"education_form\":"
+		out.RawString(prefix)
+		out.String(string(in.EducationForm))
+	}
+	{
+		const prefix string = ",\"education_status\":"
+		out.RawString(prefix)
+		out.String(string(in.EducationStatus))
+	}
+	{
+		const prefix string = ",\"faculty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Faculty))
+	}
+	{
+		const prefix string = ",\"faculty_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FacultyName))
+	}
+	{
+		const prefix string = ",\"graduation\":"
+		out.RawString(prefix)
+		out.Int(int(in.Graduation))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object72(in *jlexer.Lexer, out *object.UsersMilitary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "country_id":
+			out.CountryID = int(in.Int())
+		case "from":
+			out.From = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "unit":
+			out.Unit = string(in.String())
+		case "unit_id":
+			out.UnitID = int(in.Int())
+		case "until":
+			out.Until = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object72(out *jwriter.Writer, in object.UsersMilitary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"country_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.CountryID))
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.Int(int(in.From))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"unit_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnitID))
+	}
+	{
+		const prefix string = ",\"until\":"
+		out.RawString(prefix)
+		out.Int(int(in.Until))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object71(in *jlexer.Lexer, out *object.UsersCareer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "city_id":
+			out.CityID = int(in.Int())
+		case "city_name":
+			out.CityName = string(in.String())
+		case "company":
+			out.Company = string(in.String())
+		case "country_id":
+			out.CountryID = int(in.Int())
+		case "from":
+			out.From = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "position":
+			out.Position = string(in.String())
+		case "until":
+			out.Until = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object71(out *jwriter.Writer, in object.UsersCareer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"city_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.CityID))
+	}
+	{
+		const prefix string = ",\"city_name\":"
+		out.RawString(prefix)
+		out.String(string(in.CityName))
+	}
+	{
+		const prefix string = ",\"company\":"
+		out.RawString(prefix)
+		out.String(string(in.Company))
+	}
+	{
+		const prefix string = ",\"country_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CountryID))
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.Int(int(in.From))
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.String(string(in.Position))
+	}
+	{
+		const prefix string = ",\"until\":"
+		out.RawString(prefix)
+		out.Int(int(in.Until))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object70(in *jlexer.Lexer, out *object.UsersOccupation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = float64(in.Float64())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object70(out *jwriter.Writer, in object.UsersOccupation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object69(in *jlexer.Lexer, out *object.UsersLastSeen) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "platform":
+			out.Platform = int(in.Int())
+		case "time":
+			out.Time = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object69(out *jwriter.Writer, in object.UsersLastSeen) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"platform\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Platform))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int(int(in.Time))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object48(in *jlexer.Lexer, out *object.PollsFriend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object48(out *jwriter.Writer, in object.PollsFriend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object47(in *jlexer.Lexer, out *object.PollsBackground) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "angle":
+			out.Angle = int(in.Int())
+		case "color":
+			out.Color = string(in.String())
+		case "points":
+			if in.IsNull() {
+				in.Skip()
+				out.Points = nil
+			} else {
+				in.Delim('[')
+				if out.Points == nil {
+					if !in.IsDelim(']') {
+						out.Points = make([]struct {
+							Position float64 `json:"position"`
+							Color    string  `json:"color"`
+						}, 0, 2)
+					} else {
+						out.Points = []struct {
+							Position float64 `json:"position"`
+							Color    string  `json:"color"`
+						}{}
+					}
+				} else {
+					out.Points = (out.Points)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v130 struct {
+						Position float64 `json:"position"`
+						Color    string  `json:"color"`
+					}
+					easyjsonCce3d1beDecode2(in, &v130)
+					out.Points = append(out.Points, v130)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object47(out *jwriter.Writer, in object.PollsBackground) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"angle\":"
+		out.RawString(prefix)
+		out.Int(int(in.Angle))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	{
+		const prefix string = ",\"points\":"
+		out.RawString(prefix)
+		if in.Points == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v131, v132 := range in.Points {
+				if v131 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncode2(out, v132)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecode2(in *jlexer.Lexer, out *struct {
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = float64(in.Float64())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncode2(out *jwriter.Writer, in struct {
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Position))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object46(in *jlexer.Lexer, out *object.PollsAnswer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "rate":
+			out.Rate = float64(in.Float64())
+		case "text":
+			out.Text = string(in.String())
+		case "votes":
+			out.Votes = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object46(out *jwriter.Writer, in object.PollsAnswer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rate))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"votes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Votes))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in *jlexer.Lexer, out *object.PhotosPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "album_id":
+			out.AlbumID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]object.PhotosImage, 0, 1)
+					} else {
+						out.Images = []object.PhotosImage{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v133 object.PhotosImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v133).UnmarshalJSON(data))
+					}
+					out.Images = append(out.Images, v133)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lat":
+			out.Lat = float64(in.Float64())
+		case "long":
+			out.Long = float64(in.Float64())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "width":
+			out.Width = int(in.Int())
+		case "can_upload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanUpload).UnmarshalJSON(data))
+			}
+		case "comments_disabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CommentsDisabled).UnmarshalJSON(data))
+			}
+		case "thumb_is_last":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ThumbIsLast).UnmarshalJSON(data))
+			}
+		case "upload_by_admins_only":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UploadByAdminsOnly).UnmarshalJSON(data))
+			}
+		case "has_tags":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasTags).UnmarshalJSON(data))
+			}
+		case "created":
+			out.Created = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "privacy_comment":
+			if in.IsNull() {
+				in.Skip()
+				out.PrivacyComment = nil
+			} else {
+				in.Delim('[')
+				if out.PrivacyComment == nil {
+					if !in.IsDelim(']') {
+						out.PrivacyComment = make([]string, 0, 4)
+					} else {
+						out.PrivacyComment = []string{}
+					}
+				} else {
+					out.PrivacyComment = (out.PrivacyComment)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v134 string
+					v134 = string(in.String())
+					out.PrivacyComment = append(out.PrivacyComment, v134)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "privacy_view":
+			if in.IsNull() {
+				in.Skip()
+				out.PrivacyView = nil
+			} else {
+				in.Delim('[')
+				if out.PrivacyView == nil {
+					if !in.IsDelim(']') {
+						out.PrivacyView = make([]string, 0, 4)
+					} else {
+						out.PrivacyView = []string{}
+					}
+				} else {
+					out.PrivacyView = (out.PrivacyView)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v135 string
+					v135 = string(in.String())
+					out.PrivacyView = append(out.PrivacyView, v135)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "size":
+			out.Size = int(in.Int())
+		case "sizes":
+			if in.IsNull() {
+				in.Skip()
+				out.Sizes = nil
+			} else {
+				in.Delim('[')
+				if out.Sizes == nil {
+					if !in.IsDelim(']') {
+						out.Sizes = make([]object.PhotosPhotoSizes, 0, 1)
+					} else {
+						out.Sizes = []object.PhotosPhotoSizes{}
+					}
+				} else {
+					out.Sizes = (out.Sizes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v136 object.PhotosPhotoSizes
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v136).UnmarshalJSON(data))
+					}
+					out.Sizes = append(out.Sizes, v136)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "thumb_id":
+			out.ThumbID = int(in.Int())
+		case "thumb_src":
+			out.ThumbSrc = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "updated":
+			out.Updated = int(in.Int())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out *jwriter.Writer, in object.PhotosPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v137, v138 := range in.Images {
+				if v137 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object82(out, v138)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lat\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Lat))
+	}
+	{
+		const prefix string = ",\"long\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Long))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"can_upload\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanUpload))
+	}
+	{
+		const prefix string = ",\"comments_disabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CommentsDisabled))
+	}
+	{
+		const prefix string = ",\"thumb_is_last\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ThumbIsLast))
+	}
+	{
+		const prefix string = ",\"upload_by_admins_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.UploadByAdminsOnly))
+	}
+	{
+		const prefix string = ",\"has_tags\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasTags))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"privacy_comment\":"
+		out.RawString(prefix)
+		if in.PrivacyComment == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v139, v140 := range in.PrivacyComment {
+				if v139 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v140))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"privacy_view\":"
+		out.RawString(prefix)
+		if in.PrivacyView == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v141, v142 := range in.PrivacyView {
+				if v141 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v142))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"sizes\":"
+		out.RawString(prefix)
+		if in.Sizes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v143, v144 := range in.Sizes {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object83(out, v144)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"thumb_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ThumbID))
+	}
+	{
+		const prefix string = ",\"thumb_src\":"
+		out.RawString(prefix)
+		out.String(string(in.ThumbSrc))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int(int(in.Updated))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object82(in *jlexer.Lexer, out *object.PhotosPhotoSizes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "height":
+			out.Height = float64(in.Float64())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = float64(in.Float64())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object83(out *jwriter.Writer, in object.PhotosPhotoSizes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Height))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Width))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object83(in *jlexer.Lexer, out *object.PhotosImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "height":
+			out.Height = float64(in.Float64())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object82(out *jwriter.Writer, in object.PhotosImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Height))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Width))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object25(in *jlexer.Lexer, out *object.PagesWikipageFull) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			out.Created = int(in.Int())
+		case "creator_id":
+			out.CreatorID = int(in.Int())
+		case "current_user_can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CurrentUserCanEdit).UnmarshalJSON(data))
+			}
+		case "current_user_can_edit_access":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CurrentUserCanEditAccess).UnmarshalJSON(data))
+			}
+		case "edited":
+			out.Edited = int(in.Int())
+		case "editor_id":
+			out.EditorID = int(in.Int())
+		case "page_id":
+			out.PageID = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "html":
+			out.HTML = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "source":
+			out.Source = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "view_url":
+			out.ViewURL = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		case "who_can_edit":
+			out.WhoCanEdit = int(in.Int())
+		case "who_can_view":
+			out.WhoCanView = int(in.Int())
+		case "version_created":
+			out.VersionCreated = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object26(out *jwriter.Writer, in object.PagesWikipageFull) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"current_user_can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CurrentUserCanEdit))
+	}
+	{
+		const prefix string = ",\"current_user_can_edit_access\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CurrentUserCanEditAccess))
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Int(int(in.Edited))
+	}
+	{
+		const prefix string = ",\"editor_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.EditorID))
+	}
+	{
+		const prefix string = ",\"page_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PageID))
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"html\":"
+		out.RawString(prefix)
+		out.String(string(in.HTML))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"view_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ViewURL))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"who_can_edit\":"
+		out.RawString(prefix)
+		out.Int(int(in.WhoCanEdit))
+	}
+	{
+		const prefix string = ",\"who_can_view\":"
+		out.RawString(prefix)
+		out.Int(int(in.WhoCanView))
+	}
+	{
+		const prefix string = ",\"version_created\":"
+		out.RawString(prefix)
+		out.Int(int(in.VersionCreated))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object24(in *jlexer.Lexer, out *object.WallAttachedNote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			out.Comments = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "read_comments":
+			out.ReadComments = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "view_url":
+			out.ViewURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object25(out *jwriter.Writer, in object.WallAttachedNote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"read_comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReadComments))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"view_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ViewURL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object23(in *jlexer.Lexer, out *object.MarketMarketAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "title":
+			out.Title = string(in.String())
+		case "updated_time":
+			out.UpdatedTime = int(in.Int())
+		case "is_main":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsMain).UnmarshalJSON(data))
+			}
+		case "is_hidden":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHidden).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object24(out *jwriter.Writer, in object.MarketMarketAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"updated_time\":"
+		out.RawString(prefix)
+		out.Int(int(in.UpdatedTime))
+	}
+	{
+		const prefix string = ",\"is_main\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMain))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in *jlexer.Lexer, out *object.BaseLink) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "application":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object84(in, &out.Application)
+		case "button":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object85(in, &out.Button)
+		case "button_text":
+			out.ButtonText = string(in.String())
+		case "button_action":
+			out.ButtonAction = string(in.String())
+		case "caption":
+			out.Caption = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in, &out.Video)
+		case "preview_page":
+			out.PreviewPage = string(in.String())
+		case "preview_url":
+			out.PreviewURL = string(in.String())
+		case "product":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object86(in, &out.Product)
+		case "rating":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object87(in, &out.Rating)
+		case "title":
+			out.Title = string(in.String())
+		case "target":
+			out.Target = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out *jwriter.Writer, in object.BaseLink) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"application\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object84(out, in.Application)
+	}
+	{
+		const prefix string = ",\"button\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object85(out, in.Button)
+	}
+	{
+		const prefix string = ",\"button_text\":"
+		out.RawString(prefix)
+		out.String(string(in.ButtonText))
+	}
+	{
+		const prefix string = ",\"button_action\":"
+		out.RawString(prefix)
+		out.String(string(in.ButtonAction))
+	}
+	{
+		const prefix string = ",\"caption\":"
+		out.RawString(prefix)
+		out.String(string(in.Caption))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out, in.Video)
+	}
+	{
+		const prefix string = ",\"preview_page\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviewPage))
+	}
+	{
+		const prefix string = ",\"preview_url\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviewURL))
+	}
+	{
+		const prefix string = ",\"product\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object86(out, in.Product)
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object87(out, in.Rating)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		out.String(string(in.Target))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object87(in *jlexer.Lexer, out *object.BaseLinkRating) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reviews_count":
+			out.ReviewsCount = int(in.Int())
+		case "stars":
+			out.Stars = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object87(out *jwriter.Writer, in object.BaseLinkRating) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reviews_count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ReviewsCount))
+	}
+	{
+		const prefix string = ",\"stars\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Stars))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object86(in *jlexer.Lexer, out *object.BaseLinkProduct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "merchant":
+			out.Merchant = string(in.String())
+		case "orders_count":
+			out.OrdersCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object86(out *jwriter.Writer, in object.BaseLinkProduct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.Price)
+	}
+	{
+		const prefix string = ",\"merchant\":"
+		out.RawString(prefix)
+		out.String(string(in.Merchant))
+	}
+	{
+		const prefix string = ",\"orders_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.OrdersCount))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object85(in *jlexer.Lexer, out *object.BaseLinkButton) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object88(in, &out.Action)
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object85(out *jwriter.Writer, in object.BaseLinkButton) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object88(out, in.Action)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object88(in *jlexer.Lexer, out *object.BaseLinkButtonAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object88(out *jwriter.Writer, in object.BaseLinkButtonAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object84(in *jlexer.Lexer, out *object.BaseLinkApplication) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "app_id":
+			out.AppID = float64(in.Float64())
+		case "store":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object89(in, &out.Store)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object84(out *jwriter.Writer, in object.BaseLinkApplication) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"app_id\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.AppID))
+	}
+	{
+		const prefix string = ",\"store\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object89(out, in.Store)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object89(in *jlexer.Lexer, out *object.BaseLinkApplicationStore) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = float64(in.Float64())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object89(out *jwriter.Writer, in object.BaseLinkApplicationStore) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object21(in *jlexer.Lexer, out *object.WallGraffiti) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "photo_200":
+			out.Photo200 = string(in.String())
+		case "photo_586":
+			out.Photo586 = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "access_key":
+			out.AccessKey = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object21(out *jwriter.Writer, in object.WallGraffiti) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"photo_200\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo200))
+	}
+	{
+		const prefix string = ",\"photo_586\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo586))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix)
+		out.String(string(in.AccessKey))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object20(in *jlexer.Lexer, out *object.EventsEventAttach) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address":
+			out.Address = string(in.String())
+		case "button_text":
+			out.ButtonText = string(in.String())
+		case "friends":
+			if in.IsNull() {
+				in.Skip()
+				out.Friends = nil
+			} else {
+				in.Delim('[')
+				if out.Friends == nil {
+					if !in.IsDelim(']') {
+						out.Friends = make([]int, 0, 8)
+					} else {
+						out.Friends = []int{}
+					}
+				} else {
+					out.Friends = (out.Friends)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v145 int
+					v145 = int(in.Int())
+					out.Friends = append(out.Friends, v145)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = int(in.Int())
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "member_status":
+			out.MemberStatus = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "time":
+			out.Time = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object20(out *jwriter.Writer, in object.EventsEventAttach) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Address != "" {
+		const prefix string = ",\"address\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"button_text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ButtonText))
+	}
+	{
+		const prefix string = ",\"friends\":"
+		out.RawString(prefix)
+		if in.Friends == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v146, v147 := range in.Friends {
+				if v146 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v147))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	if in.MemberStatus != 0 {
+		const prefix string = ",\"member_status\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemberStatus))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.Time != 0 {
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int(int(in.Time))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in *jlexer.Lexer, out *object.DocsDoc) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "date":
+			out.Date = int(in.Int())
+		case "ext":
+			out.Ext = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "is_licensed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsLicensed).UnmarshalJSON(data))
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "preview":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object90(in, &out.Preview)
+		case "size":
+			out.Size = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "type":
+			out.Type = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "src":
+			out.Src = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "waveform":
+			if in.IsNull() {
+				in.Skip()
+				out.Waveform = nil
+			} else {
+				in.Delim('[')
+				if out.Waveform == nil {
+					if !in.IsDelim(']') {
+						out.Waveform = make([]int, 0, 8)
+					} else {
+						out.Waveform = []int{}
+					}
+				} else {
+					out.Waveform = (out.Waveform)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v148 int
+					v148 = int(in.Int())
+					out.Waveform = append(out.Waveform, v148)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "link_ogg":
+			out.LinkOgg = string(in.String())
+		case "link_mp3":
+			out.LinkMp3 = string(in.String())
+		case "transcript":
+			out.Transcript = string(in.String())
+		case "transcript_state":
+			out.TranscriptState = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out *jwriter.Writer, in object.DocsDoc) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.String(string(in.Ext))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"is_licensed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLicensed))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object90(out, in.Preview)
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Int(int(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"src\":"
+		out.RawString(prefix)
+		out.String(string(in.Src))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"waveform\":"
+		out.RawString(prefix)
+		if in.Waveform == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v149, v150 := range in.Waveform {
+				if v149 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v150))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"link_ogg\":"
+		out.RawString(prefix)
+		out.String(string(in.LinkOgg))
+	}
+	{
+		const prefix string = ",\"link_mp3\":"
+		out.RawString(prefix)
+		out.String(string(in.LinkMp3))
+	}
+	{
+		const prefix string = ",\"transcript\":"
+		out.RawString(prefix)
+		out.String(string(in.Transcript))
+	}
+	{
+		const prefix string = ",\"transcript_state\":"
+		out.RawString(prefix)
+		out.String(string(in.TranscriptState))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object90(in *jlexer.Lexer, out *object.DocsDocPreview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object91(in, &out.Photo)
+		case "graffiti":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object92(in, &out.Graffiti)
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object93(in, &out.Video)
+		case "audio_message":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object94(in, &out.AudioMessage)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object90(out *jwriter.Writer, in object.DocsDocPreview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object91(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"graffiti\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object92(out, in.Graffiti)
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object93(out, in.Video)
+	}
+	{
+		const prefix string = ",\"audio_message\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object94(out, in.AudioMessage)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object94(in *jlexer.Lexer, out *object.DocsDocPreviewAudioMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "duration":
+			out.Duration = int(in.Int())
+		case "waveform":
+			if in.IsNull() {
+				in.Skip()
+				out.Waveform = nil
+			} else {
+				in.Delim('[')
+				if out.Waveform == nil {
+					if !in.IsDelim(']') {
+						out.Waveform = make([]int, 0, 8)
+					} else {
+						out.Waveform = []int{}
+					}
+				} else {
+					out.Waveform = (out.Waveform)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v151 int
+					v151 = int(in.Int())
+					out.Waveform = append(out.Waveform, v151)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "link_ogg":
+			out.LinkOgg = string(in.String())
+		case "link_mp3":
+			out.LinkMp3 = string(in.String())
+		case "transcript":
+			out.Transcript = string(in.String())
+		case "transcript_state":
+			out.TranscriptState = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object94(out *jwriter.Writer, in object.DocsDocPreviewAudioMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"waveform\":"
+		out.RawString(prefix)
+		if in.Waveform == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v152, v153 := range in.Waveform {
+				if v152 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v153))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"link_ogg\":"
+		out.RawString(prefix)
+		out.String(string(in.LinkOgg))
+	}
+	{
+		const prefix string = ",\"link_mp3\":"
+		out.RawString(prefix)
+		out.String(string(in.LinkMp3))
+	}
+	{
+		const prefix string = ",\"transcript\":"
+		out.RawString(prefix)
+		out.String(string(in.Transcript))
+	}
+	{
+		const prefix string = ",\"transcript_state\":"
+		out.RawString(prefix)
+		out.String(string(in.TranscriptState))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object93(in *jlexer.Lexer, out *object.DocsDocPreviewVideo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "file_size":
+			out.FileSize = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "src":
+			out.Src = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object93(out *jwriter.Writer, in object.DocsDocPreviewVideo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"file_size\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FileSize))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"src\":"
+		out.RawString(prefix)
+		out.String(string(in.Src))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object92(in *jlexer.Lexer, out *object.DocsDocPreviewGraffiti) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "src":
+			out.Src = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object92(out *jwriter.Writer, in object.DocsDocPreviewGraffiti) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"src\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Src))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object91(in *jlexer.Lexer, out *object.DocsDocPreviewPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sizes":
+			if in.IsNull() {
+				in.Skip()
+				out.Sizes = nil
+			} else {
+				in.Delim('[')
+				if out.Sizes == nil {
+					if !in.IsDelim(']') {
+						out.Sizes = make([]object.DocsDocPreviewPhotoSizes, 0, 1)
+					} else {
+						out.Sizes = []object.DocsDocPreviewPhotoSizes{}
+					}
+				} else {
+					out.Sizes = (out.Sizes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v154 object.DocsDocPreviewPhotoSizes
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object95(in, &v154)
+					out.Sizes = append(out.Sizes, v154)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object91(out *jwriter.Writer, in object.DocsDocPreviewPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sizes\":"
+		out.RawString(prefix[1:])
+		if in.Sizes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v155, v156 := range in.Sizes {
+				if v155 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object95(out, v156)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object95(in *jlexer.Lexer, out *object.DocsDocPreviewPhotoSizes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "height":
+			out.Height = float64(in.Float64())
+		case "src":
+			out.Src = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "width":
+			out.Width = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object95(out *jwriter.Writer, in object.DocsDocPreviewPhotoSizes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Height))
+	}
+	{
+		const prefix string = ",\"src\":"
+		out.RawString(prefix)
+		out.String(string(in.Src))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Width))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in *jlexer.Lexer, out *object.AudioAudio) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "artist":
+			out.Artist = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "is_hq":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHq).UnmarshalJSON(data))
+			}
+		case "is_explicit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExplicit).UnmarshalJSON(data))
+			}
+		case "stories_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesAllowed).UnmarshalJSON(data))
+			}
+		case "short_videos_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ShortVideosAllowed).UnmarshalJSON(data))
+			}
+		case "is_focus_track":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFocusTrack).UnmarshalJSON(data))
+			}
+		case "is_licensed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsLicensed).UnmarshalJSON(data))
+			}
+		case "stories_cover_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesCoverAllowed).UnmarshalJSON(data))
+			}
+		case "lyrics_id":
+			out.LyricsID = int(in.Int())
+		case "album_id":
+			out.AlbumID = int(in.Int())
+		case "genre_id":
+			out.GenreID = int(in.Int())
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "no_search":
+			out.NoSearch = int(in.Int())
+		case "main_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.MainArtists = nil
+			} else {
+				in.Delim('[')
+				if out.MainArtists == nil {
+					if !in.IsDelim(']') {
+						out.MainArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.MainArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.MainArtists = (out.MainArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v157 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v157)
+					out.MainArtists = append(out.MainArtists, v157)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ads":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object96(in, &out.Ads)
+		case "subtitle":
+			out.Subtitle = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out *jwriter.Writer, in object.AudioAudio) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"is_hq\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHq))
+	}
+	{
+		const prefix string = ",\"is_explicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExplicit))
+	}
+	{
+		const prefix string = ",\"stories_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesAllowed))
+	}
+	{
+		const prefix string = ",\"short_videos_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShortVideosAllowed))
+	}
+	{
+		const prefix string = ",\"is_focus_track\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFocusTrack))
+	}
+	{
+		const prefix string = ",\"is_licensed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLicensed))
+	}
+	{
+		const prefix string = ",\"stories_cover_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesCoverAllowed))
+	}
+	{
+		const prefix string = ",\"lyrics_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LyricsID))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"genre_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GenreID))
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"no_search\":"
+		out.RawString(prefix)
+		out.Int(int(in.NoSearch))
+	}
+	{
+		const prefix string = ",\"main_artists\":"
+		out.RawString(prefix)
+		if in.MainArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v158, v159 := range in.MainArtists {
+				if v158 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v159)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ads\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object96(out, in.Ads)
+	}
+	{
+		const prefix string = ",\"subtitle\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtitle))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object96(in *jlexer.Lexer, out *object.AudioAds) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "content_id":
+			out.ContentID = string(in.String())
+		case "duration":
+			out.Duration = string(in.String())
+		case "account_age_type":
+			out.AccountAgeType = string(in.String())
+		case "puid1":
+			out.PUID1 = string(in.String())
+		case "puid22":
+			out.PUID22 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object96(out *jwriter.Writer, in object.AudioAds) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ContentID))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.String(string(in.Duration))
+	}
+	{
+		const prefix string = ",\"account_age_type\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountAgeType))
+	}
+	{
+		const prefix string = ",\"puid1\":"
+		out.RawString(prefix)
+		out.String(string(in.PUID1))
+	}
+	{
+		const prefix string = ",\"puid22\":"
+		out.RawString(prefix)
+		out.String(string(in.PUID22))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object17(in *jlexer.Lexer, out *object.WallAppPost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "photo_130":
+			out.Photo130 = string(in.String())
+		case "photo_604":
+			out.Photo604 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object17(out *jwriter.Writer, in object.WallAppPost) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"photo_130\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo130))
+	}
+	{
+		const prefix string = ",\"photo_604\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo604))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object16(in *jlexer.Lexer, out *object.PhotosPhotoAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			out.Created = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "size":
+			out.Size = int(in.Int())
+		case "thumb":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Thumb)
+		case "title":
+			out.Title = string(in.String())
+		case "updated":
+			out.Updated = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object16(out *jwriter.Writer, in object.PhotosPhotoAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"thumb\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Thumb)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int(int(in.Updated))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object4(in *jlexer.Lexer, out *object.WallPostSource) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "link":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.Link)
+		case "data":
+			out.Data = string(in.String())
+		case "platform":
+			out.Platform = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object4(out *jwriter.Writer, in object.WallPostSource) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.Link)
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.String(string(in.Data))
+	}
+	{
+		const prefix string = ",\"platform\":"
+		out.RawString(prefix)
+		out.String(string(in.Platform))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object3(in *jlexer.Lexer, out *object.WallViews) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object3(out *jwriter.Writer, in object.WallViews) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in *jlexer.Lexer, out *object.BaseRepostsInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "wall_count":
+			out.WallCount = int(in.Int())
+		case "mail_count":
+			out.MailCount = int(in.Int())
+		case "user_reposted":
+			out.UserReposted = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out *jwriter.Writer, in object.BaseRepostsInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"wall_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.WallCount))
+	}
+	{
+		const prefix string = ",\"mail_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MailCount))
+	}
+	{
+		const prefix string = ",\"user_reposted\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserReposted))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in *jlexer.Lexer, out *object.BaseLikesInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "can_like":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanLike).UnmarshalJSON(data))
+			}
+		case "can_publish":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPublish).UnmarshalJSON(data))
+			}
+		case "user_likes":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UserLikes).UnmarshalJSON(data))
+			}
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out *jwriter.Writer, in object.BaseLikesInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"can_like\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.CanLike))
+	}
+	{
+		const prefix string = ",\"can_publish\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPublish))
+	}
+	{
+		const prefix string = ",\"user_likes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.UserLikes))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object(in *jlexer.Lexer, out *object.BaseCommentsInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPost).UnmarshalJSON(data))
+			}
+		case "groups_can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.GroupsCanPost).UnmarshalJSON(data))
+			}
+		case "can_close":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanClose).UnmarshalJSON(data))
+			}
+		case "can_open":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanOpen).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object(out *jwriter.Writer, in object.BaseCommentsInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPost))
+	}
+	{
+		const prefix string = ",\"groups_can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.GroupsCanPost))
+	}
+	{
+		const prefix string = ",\"can_close\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanClose))
+	}
+	{
+		const prefix string = ",\"can_open\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanOpen))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events1(in *jlexer.Lexer, out *WallReplyRestoreObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v160 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v160)
+					out.Attachments = append(out.Attachments, v160)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v161 int
+					v161 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v161)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events1(out *jwriter.Writer, in WallReplyRestoreObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v162, v163 := range in.Attachments {
+				if v162 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v163)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.ParentsStack {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v165))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallReplyRestoreObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallReplyRestoreObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallReplyRestoreObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallReplyRestoreObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events1(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in *jlexer.Lexer, out *object.WallWallCommentDonut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_donut":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDonut).UnmarshalJSON(data))
+			}
+		case "placeholder":
+			out.Placeholder = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out *jwriter.Writer, in object.WallWallCommentDonut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_donut\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsDonut))
+	}
+	{
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		out.String(string(in.Placeholder))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in *jlexer.Lexer, out *object.WallWallCommentThread) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]object.WallWallComment, 0, 0)
+					} else {
+						out.Items = []object.WallWallComment{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v166 object.WallWallComment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object100(in, &v166)
+					out.Items = append(out.Items, v166)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPost).UnmarshalJSON(data))
+			}
+		case "groups_can_post":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.GroupsCanPost).UnmarshalJSON(data))
+			}
+		case "show_reply_button":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ShowReplyButton).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out *jwriter.Writer, in object.WallWallCommentThread) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v167, v168 := range in.Items {
+				if v167 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object100(out, v168)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPost))
+	}
+	{
+		const prefix string = ",\"groups_can_post\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.GroupsCanPost))
+	}
+	{
+		const prefix string = ",\"show_reply_button\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowReplyButton))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object100(in *jlexer.Lexer, out *object.WallWallComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v169 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v169)
+					out.Attachments = append(out.Attachments, v169)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v170 int
+					v170 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v170)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object100(out *jwriter.Writer, in object.WallWallComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v171, v172 := range in.Attachments {
+				if v171 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v172)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v173, v174 := range in.ParentsStack {
+				if v173 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v174))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in *jlexer.Lexer, out *object.WallCommentAttachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "audio":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in, &out.Audio)
+		case "doc":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in, &out.Doc)
+		case "link":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.Link)
+		case "market":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Market).UnmarshalJSON(data))
+			}
+		case "market_market_album":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object23(in, &out.MarketMarketAlbum)
+		case "note":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object24(in, &out.Note)
+		case "page":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object25(in, &out.Page)
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "sticker":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object101(in, &out.Sticker)
+		case "type":
+			out.Type = string(in.String())
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in, &out.Video)
+		case "graffiti":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object21(in, &out.Graffiti)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out *jwriter.Writer, in object.WallCommentAttachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out, in.Audio)
+	}
+	{
+		const prefix string = ",\"doc\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out, in.Doc)
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.Link)
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out, in.Market)
+	}
+	{
+		const prefix string = ",\"market_market_album\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object24(out, in.MarketMarketAlbum)
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object25(out, in.Note)
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object26(out, in.Page)
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"sticker\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object101(out, in.Sticker)
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out, in.Video)
+	}
+	{
+		const prefix string = ",\"graffiti\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object21(out, in.Graffiti)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object101(in *jlexer.Lexer, out *object.BaseSticker) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]object.BaseImage, 0, 1)
+					} else {
+						out.Images = []object.BaseImage{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v175 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v175).UnmarshalJSON(data))
+					}
+					out.Images = append(out.Images, v175)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "images_with_background":
+			if in.IsNull() {
+				in.Skip()
+				out.ImagesWithBackground = nil
+			} else {
+				in.Delim('[')
+				if out.ImagesWithBackground == nil {
+					if !in.IsDelim(']') {
+						out.ImagesWithBackground = make([]object.BaseImage, 0, 1)
+					} else {
+						out.ImagesWithBackground = []object.BaseImage{}
+					}
+				} else {
+					out.ImagesWithBackground = (out.ImagesWithBackground)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v176 object.BaseImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v176).UnmarshalJSON(data))
+					}
+					out.ImagesWithBackground = append(out.ImagesWithBackground, v176)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "product_id":
+			out.ProductID = int(in.Int())
+		case "sticker_id":
+			out.StickerID = int(in.Int())
+		case "is_allowed":
+			out.IsAllowed = bool(in.Bool())
+		case "animation_url":
+			out.AnimationURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object101(out *jwriter.Writer, in object.BaseSticker) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix[1:])
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v177, v178 := range in.Images {
+				if v177 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v178)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"images_with_background\":"
+		out.RawString(prefix)
+		if in.ImagesWithBackground == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v179, v180 := range in.ImagesWithBackground {
+				if v179 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object15(out, v180)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"product_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProductID))
+	}
+	{
+		const prefix string = ",\"sticker_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.StickerID))
+	}
+	{
+		const prefix string = ",\"is_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAllowed))
+	}
+	{
+		const prefix string = ",\"animation_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AnimationURL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events2(in *jlexer.Lexer, out *WallReplyNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v181 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v181)
+					out.Attachments = append(out.Attachments, v181)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v182 int
+					v182 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v182)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events2(out *jwriter.Writer, in WallReplyNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v183, v184 := range in.Attachments {
+				if v183 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v184)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v185, v186 := range in.ParentsStack {
+				if v185 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v186))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallReplyNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallReplyNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallReplyNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallReplyNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events2(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events3(in *jlexer.Lexer, out *WallReplyEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v187 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v187)
+					out.Attachments = append(out.Attachments, v187)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v188 int
+					v188 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v188)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events3(out *jwriter.Writer, in WallReplyEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v189, v190 := range in.Attachments {
+				if v189 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v190)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v191, v192 := range in.ParentsStack {
+				if v191 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v192))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallReplyEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallReplyEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallReplyEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallReplyEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events3(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events4(in *jlexer.Lexer, out *WallReplyDeleteObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "deleter_id":
+			out.DeleterID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events4(out *jwriter.Writer, in WallReplyDeleteObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"deleter_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.DeleterID))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallReplyDeleteObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallReplyDeleteObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallReplyDeleteObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallReplyDeleteObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events4(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events5(in *jlexer.Lexer, out *WallPostNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "created_by":
+			out.CreatedBy = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "reply_owner_id":
+			out.ReplyOwnerID = int(in.Int())
+		case "reply_post_id":
+			out.ReplyPostID = int(in.Int())
+		case "friends_only":
+			out.FriendsOnly = int(in.Int())
+		case "comments":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object(in, &out.Comments)
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "views":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object3(in, &out.Views)
+		case "post_type":
+			out.PostType = string(in.String())
+		case "post_source":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object4(in, &out.PostSource)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallWallpostAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallWallpostAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v193 object.WallWallpostAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object5(in, &v193)
+					out.Attachments = append(out.Attachments, v193)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object6(in, &out.Geo)
+		case "signer_id":
+			out.SignerID = int(in.Int())
+		case "copy_history":
+			if in.IsNull() {
+				in.Skip()
+				out.CopyHistory = nil
+			} else {
+				in.Delim('[')
+				if out.CopyHistory == nil {
+					if !in.IsDelim(']') {
+						out.CopyHistory = make([]object.WallWallpost, 0, 0)
+					} else {
+						out.CopyHistory = []object.WallWallpost{}
+					}
+				} else {
+					out.CopyHistory = (out.CopyHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v194 object.WallWallpost
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object7(in, &v194)
+					out.CopyHistory = append(out.CopyHistory, v194)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "can_pin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanPin).UnmarshalJSON(data))
+			}
+		case "can_delete":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanDelete).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "is_pinned":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsPinned).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_archived":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsArchived).UnmarshalJSON(data))
+			}
+		case "is_deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDeleted).UnmarshalJSON(data))
+			}
+		case "marked_as_ads":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MarkedAsAds).UnmarshalJSON(data))
+			}
+		case "edited":
+			out.Edited = int(in.Int())
+		case "copyright":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object8(in, &out.Copyright)
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v195 int
+					v195 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v195)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object9(in, &out.Donut)
+		case "short_text_rate":
+			out.ShortTextRate = float64(in.Float64())
+		case "carousel_offset":
+			out.CarouselOffset = int(in.Int())
+		case "header":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object10(in, &out.Header)
+		case "hash":
+			out.Hash = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events5(out *jwriter.Writer, in WallPostNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		out.Int(int(in.CreatedBy))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"reply_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyOwnerID))
+	}
+	{
+		const prefix string = ",\"reply_post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyPostID))
+	}
+	{
+		const prefix string = ",\"friends_only\":"
+		out.RawString(prefix)
+		out.Int(int(in.FriendsOnly))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object(out, in.Comments)
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object3(out, in.Views)
+	}
+	{
+		const prefix string = ",\"post_type\":"
+		out.RawString(prefix)
+		out.String(string(in.PostType))
+	}
+	{
+		const prefix string = ",\"post_source\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object4(out, in.PostSource)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v196, v197 := range in.Attachments {
+				if v196 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object5(out, v197)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object6(out, in.Geo)
+	}
+	{
+		const prefix string = ",\"signer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SignerID))
+	}
+	{
+		const prefix string = ",\"copy_history\":"
+		out.RawString(prefix)
+		if in.CopyHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v198, v199 := range in.CopyHistory {
+				if v198 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object7(out, v199)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"can_pin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanPin))
+	}
+	{
+		const prefix string = ",\"can_delete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDelete))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"is_pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPinned))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_archived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsArchived))
+	}
+	{
+		const prefix string = ",\"is_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"marked_as_ads\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MarkedAsAds))
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Int(int(in.Edited))
+	}
+	{
+		const prefix string = ",\"copyright\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object8(out, in.Copyright)
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v200, v201 := range in.ParentsStack {
+				if v200 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v201))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object9(out, in.Donut)
+	}
+	{
+		const prefix string = ",\"short_text_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ShortTextRate))
+	}
+	{
+		const prefix string = ",\"carousel_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.CarouselOffset))
+	}
+	{
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object10(out, in.Header)
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WallPostNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WallPostNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WallPostNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WallPostNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events5(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events6(in *jlexer.Lexer, out *VkpayTransactionObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "amount":
+			out.Amount = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "date":
+			out.Date = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events6(out *jwriter.Writer, in VkpayTransactionObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Int(int(in.Amount))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VkpayTransactionObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VkpayTransactionObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VkpayTransactionObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VkpayTransactionObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events6(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events7(in *jlexer.Lexer, out *VideoNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "adding_date":
+			out.AddingDate = int(in.Int())
+		case "release_date":
+			out.ReleaseDate = int(in.Int())
+		case "can_add":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAdd).UnmarshalJSON(data))
+			}
+		case "can_add_to_faves":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAddToFaves).UnmarshalJSON(data))
+			}
+		case "can_comment":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanComment).UnmarshalJSON(data))
+			}
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		case "can_like":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanLike).UnmarshalJSON(data))
+			}
+		case "can_download":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanDownload).UnmarshalJSON(data))
+			}
+		case "can_repost":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanRepost).UnmarshalJSON(data))
+			}
+		case "can_subscribe":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSubscribe).UnmarshalJSON(data))
+			}
+		case "can_attach_link":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAttachLink).UnmarshalJSON(data))
+			}
+		case "is_favorite":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFavorite).UnmarshalJSON(data))
+			}
+		case "is_private":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsPrivate).UnmarshalJSON(data))
+			}
+		case "is_explicit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExplicit).UnmarshalJSON(data))
+			}
+		case "is_subscribed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSubscribed).UnmarshalJSON(data))
+			}
+		case "added":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Added).UnmarshalJSON(data))
+			}
+		case "repeat":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Repeat).UnmarshalJSON(data))
+			}
+		case "content_restricted":
+			out.ContentRestricted = int(in.Int())
+		case "live":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Live).UnmarshalJSON(data))
+			}
+		case "upcoming":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Upcoming).UnmarshalJSON(data))
+			}
+		case "comments":
+			out.Comments = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "files":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object39(in, &out.Files)
+		case "trailer":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object39(in, &out.Trailer)
+		case "first_frame":
+			if in.IsNull() {
+				in.Skip()
+				out.FirstFrame = nil
+			} else {
+				in.Delim('[')
+				if out.FirstFrame == nil {
+					if !in.IsDelim(']') {
+						out.FirstFrame = make([]object.VideoVideoImage, 0, 1)
+					} else {
+						out.FirstFrame = []object.VideoVideoImage{}
+					}
+				} else {
+					out.FirstFrame = (out.FirstFrame)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v202 object.VideoVideoImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v202).UnmarshalJSON(data))
+					}
+					out.FirstFrame = append(out.FirstFrame, v202)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "image":
+			if in.IsNull() {
+				in.Skip()
+				out.Image = nil
+			} else {
+				in.Delim('[')
+				if out.Image == nil {
+					if !in.IsDelim(']') {
+						out.Image = make([]object.VideoVideoImage, 0, 1)
+					} else {
+						out.Image = []object.VideoVideoImage{}
+					}
+				} else {
+					out.Image = (out.Image)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v203 object.VideoVideoImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v203).UnmarshalJSON(data))
+					}
+					out.Image = append(out.Image, v203)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "height":
+			out.Height = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "photo_130":
+			out.Photo130 = string(in.String())
+		case "photo_320":
+			out.Photo320 = string(in.String())
+		case "photo_640":
+			out.Photo640 = string(in.String())
+		case "photo_800":
+			out.Photo800 = string(in.String())
+		case "photo_1280":
+			out.Photo1280 = string(in.String())
+		case "player":
+			out.Player = string(in.String())
+		case "processing":
+			out.Processing = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "subtitle":
+			out.Subtitle = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		case "width":
+			out.Width = int(in.Int())
+		case "platform":
+			out.Platform = string(in.String())
+		case "local_views":
+			out.LocalViews = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "reposts":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object2(in, &out.Reposts)
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "privacy_view":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object40(in, &out.PrivacyView)
+		case "privacy_comment":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object40(in, &out.PrivacyComment)
+		case "action_button":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object41(in, &out.ActionButton)
+		case "restriction":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object42(in, &out.Restriction)
+		case "content_restricted_message":
+			out.ContentRestrictedMessage = string(in.String())
+		case "main_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.MainArtists = nil
+			} else {
+				in.Delim('[')
+				if out.MainArtists == nil {
+					if !in.IsDelim(']') {
+						out.MainArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.MainArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.MainArtists = (out.MainArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v204 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v204)
+					out.MainArtists = append(out.MainArtists, v204)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "featured_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.FeaturedArtists = nil
+			} else {
+				in.Delim('[')
+				if out.FeaturedArtists == nil {
+					if !in.IsDelim(']') {
+						out.FeaturedArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.FeaturedArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.FeaturedArtists = (out.FeaturedArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v205 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v205)
+					out.FeaturedArtists = append(out.FeaturedArtists, v205)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]object.BaseObjectWithName, 0, 2)
+					} else {
+						out.Genres = []object.BaseObjectWithName{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v206 object.BaseObjectWithName
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object12(in, &v206)
+					out.Genres = append(out.Genres, v206)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ov_id":
+			out.OvID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events7(out *jwriter.Writer, in VideoNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"adding_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.AddingDate))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReleaseDate))
+	}
+	{
+		const prefix string = ",\"can_add\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAdd))
+	}
+	{
+		const prefix string = ",\"can_add_to_faves\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAddToFaves))
+	}
+	{
+		const prefix string = ",\"can_comment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanComment))
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	{
+		const prefix string = ",\"can_like\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanLike))
+	}
+	{
+		const prefix string = ",\"can_download\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDownload))
+	}
+	{
+		const prefix string = ",\"can_repost\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanRepost))
+	}
+	{
+		const prefix string = ",\"can_subscribe\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSubscribe))
+	}
+	{
+		const prefix string = ",\"can_attach_link\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAttachLink))
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"is_explicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExplicit))
+	}
+	{
+		const prefix string = ",\"is_subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribed))
+	}
+	{
+		const prefix string = ",\"added\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Added))
+	}
+	{
+		const prefix string = ",\"repeat\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Repeat))
+	}
+	{
+		const prefix string = ",\"content_restricted\":"
+		out.RawString(prefix)
+		out.Int(int(in.ContentRestricted))
+	}
+	{
+		const prefix string = ",\"live\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Live))
+	}
+	{
+		const prefix string = ",\"upcoming\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Upcoming))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object39(out, in.Files)
+	}
+	if true {
+		const prefix string = ",\"trailer\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object39(out, in.Trailer)
+	}
+	{
+		const prefix string = ",\"first_frame\":"
+		out.RawString(prefix)
+		if in.FirstFrame == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v207, v208 := range in.FirstFrame {
+				if v207 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object40(out, v208)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		if in.Image == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v209, v210 := range in.Image {
+				if v209 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object40(out, v210)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"photo_130\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo130))
+	}
+	{
+		const prefix string = ",\"photo_320\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo320))
+	}
+	{
+		const prefix string = ",\"photo_640\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo640))
+	}
+	{
+		const prefix string = ",\"photo_800\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo800))
+	}
+	{
+		const prefix string = ",\"photo_1280\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo1280))
+	}
+	{
+		const prefix string = ",\"player\":"
+		out.RawString(prefix)
+		out.String(string(in.Player))
+	}
+	{
+		const prefix string = ",\"processing\":"
+		out.RawString(prefix)
+		out.Int(int(in.Processing))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"subtitle\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtitle))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"platform\":"
+		out.RawString(prefix)
+		out.String(string(in.Platform))
+	}
+	{
+		const prefix string = ",\"local_views\":"
+		out.RawString(prefix)
+		out.Int(int(in.LocalViews))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"reposts\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object2(out, in.Reposts)
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"privacy_view\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object41(out, in.PrivacyView)
+	}
+	{
+		const prefix string = ",\"privacy_comment\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object41(out, in.PrivacyComment)
+	}
+	{
+		const prefix string = ",\"action_button\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object42(out, in.ActionButton)
+	}
+	{
+		const prefix string = ",\"restriction\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object43(out, in.Restriction)
+	}
+	{
+		const prefix string = ",\"content_restricted_message\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentRestrictedMessage))
+	}
+	{
+		const prefix string = ",\"main_artists\":"
+		out.RawString(prefix)
+		if in.MainArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v211, v212 := range in.MainArtists {
+				if v211 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v212)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"featured_artists\":"
+		out.RawString(prefix)
+		if in.FeaturedArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v213, v214 := range in.FeaturedArtists {
+				if v213 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v214)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v215, v216 := range in.Genres {
+				if v215 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object12(out, v216)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OvID != "" {
+		const prefix string = ",\"ov_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OvID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events7(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events8(in *jlexer.Lexer, out *VideoCommentRestoreObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v217 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v217)
+					out.Attachments = append(out.Attachments, v217)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v218 int
+					v218 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v218)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events8(out *jwriter.Writer, in VideoCommentRestoreObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v219, v220 := range in.Attachments {
+				if v219 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v220)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v221, v222 := range in.ParentsStack {
+				if v221 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v222))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoCommentRestoreObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoCommentRestoreObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoCommentRestoreObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoCommentRestoreObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events8(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events9(in *jlexer.Lexer, out *VideoCommentNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v223 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v223)
+					out.Attachments = append(out.Attachments, v223)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v224 int
+					v224 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v224)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events9(out *jwriter.Writer, in VideoCommentNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v225, v226 := range in.Attachments {
+				if v225 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v226)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v227, v228 := range in.ParentsStack {
+				if v227 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v228))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoCommentNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoCommentNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoCommentNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoCommentNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events9(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events10(in *jlexer.Lexer, out *VideoCommentEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v229 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v229)
+					out.Attachments = append(out.Attachments, v229)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v230 int
+					v230 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v230)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events10(out *jwriter.Writer, in VideoCommentEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v231, v232 := range in.Attachments {
+				if v231 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v232)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v233, v234 := range in.ParentsStack {
+				if v233 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v234))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoCommentEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoCommentEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoCommentEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoCommentEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events10(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events11(in *jlexer.Lexer, out *VideoCommentDeleteObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "deleter_id":
+			out.DeleterID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events11(out *jwriter.Writer, in VideoCommentDeleteObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"deleter_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.DeleterID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoCommentDeleteObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoCommentDeleteObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoCommentDeleteObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoCommentDeleteObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events11(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events12(in *jlexer.Lexer, out *UserUnblockObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_id":
+			out.AdminID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "by_end_date":
+			out.ByEndDate = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events12(out *jwriter.Writer, in UserUnblockObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"by_end_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.ByEndDate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserUnblockObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserUnblockObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserUnblockObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserUnblockObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events12(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events13(in *jlexer.Lexer, out *UserBlockObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_id":
+			out.AdminID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "unblock_date":
+			out.UnblockDate = int(in.Int())
+		case "reason":
+			out.Reason = int(in.Int())
+		case "comment":
+			out.Comment = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events13(out *jwriter.Writer, in UserBlockObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"unblock_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnblockDate))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.Int(int(in.Reason))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserBlockObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserBlockObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserBlockObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserBlockObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events13(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events14(in *jlexer.Lexer, out *PollVoteNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "poll_id":
+			out.PollID = int(in.Int())
+		case "option_id":
+			out.OptionID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events14(out *jwriter.Writer, in PollVoteNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"poll_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PollID))
+	}
+	{
+		const prefix string = ",\"option_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OptionID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollVoteNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollVoteNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollVoteNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollVoteNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events14(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events15(in *jlexer.Lexer, out *PhotoNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "album_id":
+			out.AlbumID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]object.PhotosImage, 0, 1)
+					} else {
+						out.Images = []object.PhotosImage{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v235 object.PhotosImage
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v235).UnmarshalJSON(data))
+					}
+					out.Images = append(out.Images, v235)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lat":
+			out.Lat = float64(in.Float64())
+		case "long":
+			out.Long = float64(in.Float64())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "width":
+			out.Width = int(in.Int())
+		case "can_upload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanUpload).UnmarshalJSON(data))
+			}
+		case "comments_disabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CommentsDisabled).UnmarshalJSON(data))
+			}
+		case "thumb_is_last":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ThumbIsLast).UnmarshalJSON(data))
+			}
+		case "upload_by_admins_only":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UploadByAdminsOnly).UnmarshalJSON(data))
+			}
+		case "has_tags":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasTags).UnmarshalJSON(data))
+			}
+		case "created":
+			out.Created = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "privacy_comment":
+			if in.IsNull() {
+				in.Skip()
+				out.PrivacyComment = nil
+			} else {
+				in.Delim('[')
+				if out.PrivacyComment == nil {
+					if !in.IsDelim(']') {
+						out.PrivacyComment = make([]string, 0, 4)
+					} else {
+						out.PrivacyComment = []string{}
+					}
+				} else {
+					out.PrivacyComment = (out.PrivacyComment)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v236 string
+					v236 = string(in.String())
+					out.PrivacyComment = append(out.PrivacyComment, v236)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "privacy_view":
+			if in.IsNull() {
+				in.Skip()
+				out.PrivacyView = nil
+			} else {
+				in.Delim('[')
+				if out.PrivacyView == nil {
+					if !in.IsDelim(']') {
+						out.PrivacyView = make([]string, 0, 4)
+					} else {
+						out.PrivacyView = []string{}
+					}
+				} else {
+					out.PrivacyView = (out.PrivacyView)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v237 string
+					v237 = string(in.String())
+					out.PrivacyView = append(out.PrivacyView, v237)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "size":
+			out.Size = int(in.Int())
+		case "sizes":
+			if in.IsNull() {
+				in.Skip()
+				out.Sizes = nil
+			} else {
+				in.Delim('[')
+				if out.Sizes == nil {
+					if !in.IsDelim(']') {
+						out.Sizes = make([]object.PhotosPhotoSizes, 0, 1)
+					} else {
+						out.Sizes = []object.PhotosPhotoSizes{}
+					}
+				} else {
+					out.Sizes = (out.Sizes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v238 object.PhotosPhotoSizes
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v238).UnmarshalJSON(data))
+					}
+					out.Sizes = append(out.Sizes, v238)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "thumb_id":
+			out.ThumbID = int(in.Int())
+		case "thumb_src":
+			out.ThumbSrc = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "updated":
+			out.Updated = int(in.Int())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events15(out *jwriter.Writer, in PhotoNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v239, v240 := range in.Images {
+				if v239 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object82(out, v240)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lat\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Lat))
+	}
+	{
+		const prefix string = ",\"long\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Long))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"can_upload\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanUpload))
+	}
+	{
+		const prefix string = ",\"comments_disabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CommentsDisabled))
+	}
+	{
+		const prefix string = ",\"thumb_is_last\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ThumbIsLast))
+	}
+	{
+		const prefix string = ",\"upload_by_admins_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.UploadByAdminsOnly))
+	}
+	{
+		const prefix string = ",\"has_tags\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasTags))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"privacy_comment\":"
+		out.RawString(prefix)
+		if in.PrivacyComment == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v241, v242 := range in.PrivacyComment {
+				if v241 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v242))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"privacy_view\":"
+		out.RawString(prefix)
+		if in.PrivacyView == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v243, v244 := range in.PrivacyView {
+				if v243 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v244))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"sizes\":"
+		out.RawString(prefix)
+		if in.Sizes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v245, v246 := range in.Sizes {
+				if v245 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object83(out, v246)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"thumb_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ThumbID))
+	}
+	{
+		const prefix string = ",\"thumb_src\":"
+		out.RawString(prefix)
+		out.String(string(in.ThumbSrc))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int(int(in.Updated))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events15(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events16(in *jlexer.Lexer, out *PhotoCommentRestoreObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v247 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v247)
+					out.Attachments = append(out.Attachments, v247)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v248 int
+					v248 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v248)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events16(out *jwriter.Writer, in PhotoCommentRestoreObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v249, v250 := range in.Attachments {
+				if v249 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v250)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v251, v252 := range in.ParentsStack {
+				if v251 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v252))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoCommentRestoreObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoCommentRestoreObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoCommentRestoreObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoCommentRestoreObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events16(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events17(in *jlexer.Lexer, out *PhotoCommentNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v253 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v253)
+					out.Attachments = append(out.Attachments, v253)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v254 int
+					v254 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v254)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events17(out *jwriter.Writer, in PhotoCommentNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v255, v256 := range in.Attachments {
+				if v255 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v256)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v257, v258 := range in.ParentsStack {
+				if v257 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v258))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoCommentNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoCommentNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoCommentNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoCommentNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events17(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events18(in *jlexer.Lexer, out *PhotoCommentEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v259 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v259)
+					out.Attachments = append(out.Attachments, v259)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v260 int
+					v260 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v260)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events18(out *jwriter.Writer, in PhotoCommentEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v261, v262 := range in.Attachments {
+				if v261 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v262)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v263, v264 := range in.ParentsStack {
+				if v263 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v264))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoCommentEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoCommentEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoCommentEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoCommentEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events18(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events19(in *jlexer.Lexer, out *PhotoCommentDeleteObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "deleter_id":
+			out.DeleterID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events19(out *jwriter.Writer, in PhotoCommentDeleteObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"deleter_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.DeleterID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoCommentDeleteObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoCommentDeleteObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoCommentDeleteObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoCommentDeleteObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events19(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events20(in *jlexer.Lexer, out *MessageTypingStateObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "state":
+			out.State = string(in.String())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "to_id":
+			out.ToID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events20(out *jwriter.Writer, in MessageTypingStateObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"to_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ToID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageTypingStateObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageTypingStateObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageTypingStateObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageTypingStateObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events20(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events21(in *jlexer.Lexer, out *MessageReplyObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_author_id":
+			out.AdminAuthorID = int(in.Int())
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object102(in, &out.Action)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.MessagesMessageAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.MessagesMessageAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v265 object.MessagesMessageAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object103(in, &v265)
+					out.Attachments = append(out.Attachments, v265)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "fwd_Messages":
+			if in.IsNull() {
+				in.Skip()
+				out.FwdMessages = nil
+			} else {
+				in.Delim('[')
+				if out.FwdMessages == nil {
+					if !in.IsDelim(']') {
+						out.FwdMessages = make([]object.MessagesMessage, 0, 0)
+					} else {
+						out.FwdMessages = []object.MessagesMessage{}
+					}
+				} else {
+					out.FwdMessages = (out.FwdMessages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v266 object.MessagesMessage
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, &v266)
+					out.FwdMessages = append(out.FwdMessages, v266)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reply_message":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyMessage = nil
+			} else {
+				if out.ReplyMessage == nil {
+					out.ReplyMessage = new(object.MessagesMessage)
+				}
+				easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, out.ReplyMessage)
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object105(in, &out.Geo)
+		case "pinned_at":
+			out.PinnedAt = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "important":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Important).UnmarshalJSON(data))
+			}
+		case "is_hidden":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHidden).UnmarshalJSON(data))
+			}
+		case "is_cropped":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsCropped).UnmarshalJSON(data))
+			}
+		case "is_silent":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSilent).UnmarshalJSON(data))
+			}
+		case "out":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Out).UnmarshalJSON(data))
+			}
+		case "was_listened":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WasListened).UnmarshalJSON(data))
+			}
+		case "keyboard":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object106(in, &out.Keyboard)
+		case "template":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object107(in, &out.Template)
+		case "payload":
+			out.Payload = string(in.String())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "random_id":
+			out.RandomID = int(in.Int())
+		case "ref":
+			out.Ref = string(in.String())
+		case "ref_source":
+			out.RefSource = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "update_time":
+			out.UpdateTime = int(in.Int())
+		case "members_count":
+			out.MembersCount = int(in.Int())
+		case "expire_ttl":
+			out.ExpireTTL = int(in.Int())
+		case "message_tag":
+			out.MessageTag = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events21(out *jwriter.Writer, in MessageReplyObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_author_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminAuthorID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object102(out, in.Action)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v267, v268 := range in.Attachments {
+				if v267 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object103(out, v268)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ConversationMessageID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"fwd_Messages\":"
+		out.RawString(prefix)
+		if in.FwdMessages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v269, v270 := range in.FwdMessages {
+				if v269 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, v270)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reply_message\":"
+		out.RawString(prefix)
+		if in.ReplyMessage == nil {
+			out.RawString("null")
+		} else {
+			easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, *in.ReplyMessage)
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object105(out, in.Geo)
+	}
+	if in.PinnedAt != 0 {
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.Int(int(in.PinnedAt))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"important\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Important))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
+	{
+		const prefix string = ",\"is_cropped\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCropped))
+	}
+	{
+		const prefix string = ",\"is_silent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSilent))
+	}
+	{
+		const prefix string = ",\"out\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Out))
+	}
+	if in.WasListened {
+		const prefix string = ",\"was_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WasListened))
+	}
+	{
+		const prefix string = ",\"keyboard\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object106(out, in.Keyboard)
+	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object107(out, in.Template)
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"random_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.RandomID))
+	}
+	{
+		const prefix string = ",\"ref\":"
+		out.RawString(prefix)
+		out.String(string(in.Ref))
+	}
+	{
+		const prefix string = ",\"ref_source\":"
+		out.RawString(prefix)
+		out.String(string(in.RefSource))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"update_time\":"
+		out.RawString(prefix)
+		out.Int(int(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembersCount))
+	}
+	{
+		const prefix string = ",\"expire_ttl\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpireTTL))
+	}
+	{
+		const prefix string = ",\"message_tag\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageTag))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageReplyObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageReplyObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageReplyObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageReplyObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events21(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object107(in *jlexer.Lexer, out *object.MessagesTemplate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "elements":
+			if in.IsNull() {
+				in.Skip()
+				out.Elements = nil
+			} else {
+				in.Delim('[')
+				if out.Elements == nil {
+					if !in.IsDelim(']') {
+						out.Elements = make([]object.MessagesTemplateElement, 0, 0)
+					} else {
+						out.Elements = []object.MessagesTemplateElement{}
+					}
+				} else {
+					out.Elements = (out.Elements)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v271 object.MessagesTemplateElement
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object108(in, &v271)
+					out.Elements = append(out.Elements, v271)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object107(out *jwriter.Writer, in object.MessagesTemplate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"elements\":"
+		out.RawString(prefix)
+		if in.Elements == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v272, v273 := range in.Elements {
+				if v272 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object108(out, v273)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object108(in *jlexer.Lexer, out *object.MessagesTemplateElement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object109(in, &out.Action)
+		case "description":
+			out.Description = string(in.String())
+		case "photo":
+			if in.IsNull() {
+				in.Skip()
+				out.Photo = nil
+			} else {
+				if out.Photo == nil {
+					out.Photo = new(object.PhotosPhoto)
+				}
+				easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, out.Photo)
+			}
+		case "buttons":
+			if in.IsNull() {
+				in.Skip()
+				out.Buttons = nil
+			} else {
+				in.Delim('[')
+				if out.Buttons == nil {
+					if !in.IsDelim(']') {
+						out.Buttons = make([]object.MessagesKeyboardButton, 0, 0)
+					} else {
+						out.Buttons = []object.MessagesKeyboardButton{}
+					}
+				} else {
+					out.Buttons = (out.Buttons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v274 object.MessagesKeyboardButton
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object110(in, &v274)
+					out.Buttons = append(out.Buttons, v274)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object108(out *jwriter.Writer, in object.MessagesTemplateElement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	if true {
+		const prefix string = ",\"action\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object109(out, in.Action)
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.Photo != nil {
+		const prefix string = ",\"photo\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, *in.Photo)
+	}
+	if len(in.Buttons) != 0 {
+		const prefix string = ",\"buttons\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v275, v276 := range in.Buttons {
+				if v275 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object110(out, v276)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object110(in *jlexer.Lexer, out *object.MessagesKeyboardButton) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object111(in, &out.Action)
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object110(out *jwriter.Writer, in object.MessagesKeyboardButton) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object111(out, in.Action)
+	}
+	if in.Color != "" {
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object111(in *jlexer.Lexer, out *object.MessagesKeyboardButtonAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "app_id":
+			out.AppID = int(in.Int())
+		case "hash":
+			out.Hash = string(in.String())
+		case "label":
+			out.Label = string(in.String())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "payload":
+			out.Payload = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object111(out *jwriter.Writer, in object.MessagesKeyboardButtonAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AppID != 0 {
+		const prefix string = ",\"app_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.AppID))
+	}
+	if in.Hash != "" {
+		const prefix string = ",\"hash\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Hash))
+	}
+	if in.Label != "" {
+		const prefix string = ",\"label\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Label))
+	}
+	if in.OwnerID != 0 {
+		const prefix string = ",\"owner_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.OwnerID))
+	}
+	if in.Payload != "" {
+		const prefix string = ",\"payload\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object109(in *jlexer.Lexer, out *object.MessagesTemplateElementCarouselAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object109(out *jwriter.Writer, in object.MessagesTemplateElementCarouselAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object106(in *jlexer.Lexer, out *object.MessagesKeyboard) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "author_id":
+			out.AuthorID = int(in.Int())
+		case "buttons":
+			if in.IsNull() {
+				in.Skip()
+				out.Buttons = nil
+			} else {
+				in.Delim('[')
+				if out.Buttons == nil {
+					if !in.IsDelim(']') {
+						out.Buttons = make([][]object.MessagesKeyboardButton, 0, 2)
+					} else {
+						out.Buttons = [][]object.MessagesKeyboardButton{}
+					}
+				} else {
+					out.Buttons = (out.Buttons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v277 []object.MessagesKeyboardButton
+					if in.IsNull() {
+						in.Skip()
+						v277 = nil
+					} else {
+						in.Delim('[')
+						if v277 == nil {
+							if !in.IsDelim(']') {
+								v277 = make([]object.MessagesKeyboardButton, 0, 0)
+							} else {
+								v277 = []object.MessagesKeyboardButton{}
+							}
+						} else {
+							v277 = (v277)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v278 object.MessagesKeyboardButton
+							easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object110(in, &v278)
+							v277 = append(v277, v278)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.Buttons = append(out.Buttons, v277)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "one_time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.OneTime).UnmarshalJSON(data))
+			}
+		case "inline":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Inline).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object106(out *jwriter.Writer, in object.MessagesKeyboard) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AuthorID != 0 {
+		const prefix string = ",\"author_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"buttons\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Buttons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v279, v280 := range in.Buttons {
+				if v279 > 0 {
+					out.RawByte(',')
+				}
+				if v280 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v281, v282 := range v280 {
+						if v281 > 0 {
+							out.RawByte(',')
+						}
+						easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object110(out, v282)
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OneTime {
+		const prefix string = ",\"one_time\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OneTime))
+	}
+	if in.Inline {
+		const prefix string = ",\"inline\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Inline))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object105(in *jlexer.Lexer, out *object.BaseMessageGeo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coordinates":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object112(in, &out.Coordinates)
+		case "place":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object13(in, &out.Place)
+		case "showmap":
+			out.Showmap = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object105(out *jwriter.Writer, in object.BaseMessageGeo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coordinates\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object112(out, in.Coordinates)
+	}
+	{
+		const prefix string = ",\"place\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object13(out, in.Place)
+	}
+	{
+		const prefix string = ",\"showmap\":"
+		out.RawString(prefix)
+		out.Int(int(in.Showmap))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object112(in *jlexer.Lexer, out *object.BaseGeoCoordinates) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object112(out *jwriter.Writer, in object.BaseGeoCoordinates) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in *jlexer.Lexer, out *object.MessagesMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_author_id":
+			out.AdminAuthorID = int(in.Int())
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object102(in, &out.Action)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.MessagesMessageAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.MessagesMessageAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v283 object.MessagesMessageAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object103(in, &v283)
+					out.Attachments = append(out.Attachments, v283)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "fwd_Messages":
+			if in.IsNull() {
+				in.Skip()
+				out.FwdMessages = nil
+			} else {
+				in.Delim('[')
+				if out.FwdMessages == nil {
+					if !in.IsDelim(']') {
+						out.FwdMessages = make([]object.MessagesMessage, 0, 0)
+					} else {
+						out.FwdMessages = []object.MessagesMessage{}
+					}
+				} else {
+					out.FwdMessages = (out.FwdMessages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v284 object.MessagesMessage
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, &v284)
+					out.FwdMessages = append(out.FwdMessages, v284)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reply_message":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyMessage = nil
+			} else {
+				if out.ReplyMessage == nil {
+					out.ReplyMessage = new(object.MessagesMessage)
+				}
+				easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, out.ReplyMessage)
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object105(in, &out.Geo)
+		case "pinned_at":
+			out.PinnedAt = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "important":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Important).UnmarshalJSON(data))
+			}
+		case "is_hidden":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHidden).UnmarshalJSON(data))
+			}
+		case "is_cropped":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsCropped).UnmarshalJSON(data))
+			}
+		case "is_silent":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSilent).UnmarshalJSON(data))
+			}
+		case "out":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Out).UnmarshalJSON(data))
+			}
+		case "was_listened":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WasListened).UnmarshalJSON(data))
+			}
+		case "keyboard":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object106(in, &out.Keyboard)
+		case "template":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object107(in, &out.Template)
+		case "payload":
+			out.Payload = string(in.String())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "random_id":
+			out.RandomID = int(in.Int())
+		case "ref":
+			out.Ref = string(in.String())
+		case "ref_source":
+			out.RefSource = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "update_time":
+			out.UpdateTime = int(in.Int())
+		case "members_count":
+			out.MembersCount = int(in.Int())
+		case "expire_ttl":
+			out.ExpireTTL = int(in.Int())
+		case "message_tag":
+			out.MessageTag = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out *jwriter.Writer, in object.MessagesMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_author_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminAuthorID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object102(out, in.Action)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v285, v286 := range in.Attachments {
+				if v285 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object103(out, v286)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ConversationMessageID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"fwd_Messages\":"
+		out.RawString(prefix)
+		if in.FwdMessages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v287, v288 := range in.FwdMessages {
+				if v287 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, v288)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reply_message\":"
+		out.RawString(prefix)
+		if in.ReplyMessage == nil {
+			out.RawString("null")
+		} else {
+			easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, *in.ReplyMessage)
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object105(out, in.Geo)
+	}
+	if in.PinnedAt != 0 {
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.Int(int(in.PinnedAt))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"important\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Important))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
+	{
+		const prefix string = ",\"is_cropped\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCropped))
+	}
+	{
+		const prefix string = ",\"is_silent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSilent))
+	}
+	{
+		const prefix string = ",\"out\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Out))
+	}
+	if in.WasListened {
+		const prefix string = ",\"was_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WasListened))
+	}
+	{
+		const prefix string = ",\"keyboard\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object106(out, in.Keyboard)
+	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object107(out, in.Template)
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"random_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.RandomID))
+	}
+	{
+		const prefix string = ",\"ref\":"
+		out.RawString(prefix)
+		out.String(string(in.Ref))
+	}
+	{
+		const prefix string = ",\"ref_source\":"
+		out.RawString(prefix)
+		out.String(string(in.RefSource))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"update_time\":"
+		out.RawString(prefix)
+		out.Int(int(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembersCount))
+	}
+	{
+		const prefix string = ",\"expire_ttl\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpireTTL))
+	}
+	{
+		const prefix string = ",\"message_tag\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageTag))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object103(in *jlexer.Lexer, out *object.MessagesMessageAttachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "audio":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in, &out.Audio)
+		case "doc":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in, &out.Doc)
+		case "gift":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object113(in, &out.Gift)
+		case "link":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.Link)
+		case "market":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Market).UnmarshalJSON(data))
+			}
+		case "market_market_album":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object23(in, &out.MarketMarketAlbum)
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "sticker":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object101(in, &out.Sticker)
+		case "type":
+			out.Type = string(in.String())
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in, &out.Video)
+		case "wall":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object7(in, &out.Wall)
+		case "wall_reply":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object100(in, &out.WallReply)
+		case "audio_message":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in, &out.AudioMessage)
+		case "graffiti":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object19(in, &out.Graffiti)
+		case "poll":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object27(in, &out.Poll)
+		case "call":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object114(in, &out.Call)
+		case "story":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object115(in, &out.Story)
+		case "podcast":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object30(in, &out.Podcast)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object103(out *jwriter.Writer, in object.MessagesMessageAttachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix[1:])
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out, in.Audio)
+	}
+	{
+		const prefix string = ",\"doc\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out, in.Doc)
+	}
+	{
+		const prefix string = ",\"gift\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object113(out, in.Gift)
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.Link)
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out, in.Market)
+	}
+	{
+		const prefix string = ",\"market_market_album\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object24(out, in.MarketMarketAlbum)
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"sticker\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object101(out, in.Sticker)
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out, in.Video)
+	}
+	{
+		const prefix string = ",\"wall\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object7(out, in.Wall)
+	}
+	{
+		const prefix string = ",\"wall_reply\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object100(out, in.WallReply)
+	}
+	{
+		const prefix string = ",\"audio_message\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out, in.AudioMessage)
+	}
+	{
+		const prefix string = ",\"graffiti\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object19(out, in.Graffiti)
+	}
+	{
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object28(out, in.Poll)
+	}
+	{
+		const prefix string = ",\"call\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object114(out, in.Call)
+	}
+	{
+		const prefix string = ",\"story\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object115(out, in.Story)
+	}
+	{
+		const prefix string = ",\"podcast\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object31(out, in.Podcast)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object115(in *jlexer.Lexer, out *object.StoriesStory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "expires_at":
+			out.ExpiresAt = int(in.Int())
+		case "can_hide":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanHide).UnmarshalJSON(data))
+			}
+		case "can_ask":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAsk).UnmarshalJSON(data))
+			}
+		case "can_ask_anonymous":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanAskAnonymous).UnmarshalJSON(data))
+			}
+		case "can_comment":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanComment).UnmarshalJSON(data))
+			}
+		case "can_reply":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanReply).UnmarshalJSON(data))
+			}
+		case "can_see":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanSee).UnmarshalJSON(data))
+			}
+		case "can_share":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanShare).UnmarshalJSON(data))
+			}
+		case "is_deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsDeleted).UnmarshalJSON(data))
+			}
+		case "is_expired":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExpired).UnmarshalJSON(data))
+			}
+		case "no_sound":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.NoSound).UnmarshalJSON(data))
+			}
+		case "is_restricted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsRestricted).UnmarshalJSON(data))
+			}
+		case "can_use_in_narrative":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanUseInNarrative).UnmarshalJSON(data))
+			}
+		case "seen":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Seen).UnmarshalJSON(data))
+			}
+		case "is_owner_pinned":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsOwnerPinned).UnmarshalJSON(data))
+			}
+		case "is_one_time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsOneTime).UnmarshalJSON(data))
+			}
+		case "is_advice":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsAdvice).UnmarshalJSON(data))
+			}
+		case "need_mute":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.NeedMute).UnmarshalJSON(data))
+			}
+		case "mute_reply":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MuteReply).UnmarshalJSON(data))
+			}
+		case "can_like":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanLike).UnmarshalJSON(data))
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "link":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object116(in, &out.Link)
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "parent_story":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentStory = nil
+			} else {
+				if out.ParentStory == nil {
+					out.ParentStory = new(object.StoriesStory)
+				}
+				easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object115(in, out.ParentStory)
+			}
+		case "parent_story_access_key":
+			out.ParentStoryAccessKey = string(in.String())
+		case "parent_story_id":
+			out.ParentStoryID = int(in.Int())
+		case "parent_story_owner_id":
+			out.ParentStoryOwnerID = int(in.Int())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "replies":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object117(in, &out.Replies)
+		case "type":
+			out.Type = string(in.String())
+		case "video":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object29(in, &out.Video)
+		case "views":
+			out.Views = int(in.Int())
+		case "clickable_stickers":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object118(in, &out.ClickableStickers)
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "likes_count":
+			out.LikesCount = int(in.Int())
+		case "narrative_id":
+			out.NarrativeID = int(in.Int())
+		case "narrative_owner_id":
+			out.NarrativeOwnerID = int(in.Int())
+		case "narrative_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object119(in, &out.NarrativeInfo)
+		case "narratives_count":
+			out.NarrativesCount = int(in.Int())
+		case "first_narrative_title":
+			out.FirstNarrativeTitle = string(in.String())
+		case "questions":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object120(in, &out.Questions)
+		case "reaction_set_id":
+			out.ReactionSetID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object115(out *jwriter.Writer, in object.StoriesStory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpiresAt))
+	}
+	{
+		const prefix string = ",\"can_hide\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanHide))
+	}
+	{
+		const prefix string = ",\"can_ask\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAsk))
+	}
+	{
+		const prefix string = ",\"can_ask_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanAskAnonymous))
+	}
+	{
+		const prefix string = ",\"can_comment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanComment))
+	}
+	{
+		const prefix string = ",\"can_reply\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanReply))
+	}
+	{
+		const prefix string = ",\"can_see\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanSee))
+	}
+	{
+		const prefix string = ",\"can_share\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanShare))
+	}
+	{
+		const prefix string = ",\"is_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"is_expired\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExpired))
+	}
+	{
+		const prefix string = ",\"no_sound\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NoSound))
+	}
+	{
+		const prefix string = ",\"is_restricted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRestricted))
+	}
+	{
+		const prefix string = ",\"can_use_in_narrative\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanUseInNarrative))
+	}
+	{
+		const prefix string = ",\"seen\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Seen))
+	}
+	{
+		const prefix string = ",\"is_owner_pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOwnerPinned))
+	}
+	{
+		const prefix string = ",\"is_one_time\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOneTime))
+	}
+	if in.IsAdvice {
+		const prefix string = ",\"is_advice\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdvice))
+	}
+	{
+		const prefix string = ",\"need_mute\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NeedMute))
+	}
+	{
+		const prefix string = ",\"mute_reply\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MuteReply))
+	}
+	{
+		const prefix string = ",\"can_like\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanLike))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object116(out, in.Link)
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"parent_story\":"
+		out.RawString(prefix)
+		if in.ParentStory == nil {
+			out.RawString("null")
+		} else {
+			easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object115(out, *in.ParentStory)
+		}
+	}
+	{
+		const prefix string = ",\"parent_story_access_key\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentStoryAccessKey))
+	}
+	{
+		const prefix string = ",\"parent_story_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentStoryID))
+	}
+	{
+		const prefix string = ",\"parent_story_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentStoryOwnerID))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object117(out, in.Replies)
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object30(out, in.Video)
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"clickable_stickers\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object118(out, in.ClickableStickers)
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"likes_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.LikesCount))
+	}
+	{
+		const prefix string = ",\"narrative_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.NarrativeID))
+	}
+	{
+		const prefix string = ",\"narrative_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.NarrativeOwnerID))
+	}
+	{
+		const prefix string = ",\"narrative_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object119(out, in.NarrativeInfo)
+	}
+	{
+		const prefix string = ",\"narratives_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.NarrativesCount))
+	}
+	{
+		const prefix string = ",\"first_narrative_title\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstNarrativeTitle))
+	}
+	{
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object120(out, in.Questions)
+	}
+	{
+		const prefix string = ",\"reaction_set_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ReactionSetID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object120(in *jlexer.Lexer, out *object.StoriesQuestions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "new":
+			out.New = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object120(out *jwriter.Writer, in object.StoriesQuestions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"new\":"
+		out.RawString(prefix)
+		out.Int(int(in.New))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object119(in *jlexer.Lexer, out *object.StoriesNarrativeInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "author":
+			out.Author = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object119(out *jwriter.Writer, in object.StoriesNarrativeInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object118(in *jlexer.Lexer, out *object.StoriesClickableStickers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "original_width":
+			out.OriginalWidth = int(in.Int())
+		case "original_height":
+			out.OriginalHeight = int(in.Int())
+		case "clickable_stickers":
+			if in.IsNull() {
+				in.Skip()
+				out.ClickableStickers = nil
+			} else {
+				in.Delim('[')
+				if out.ClickableStickers == nil {
+					if !in.IsDelim(']') {
+						out.ClickableStickers = make([]object.StoriesClickableSticker, 0, 0)
+					} else {
+						out.ClickableStickers = []object.StoriesClickableSticker{}
+					}
+				} else {
+					out.ClickableStickers = (out.ClickableStickers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v289 object.StoriesClickableSticker
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object121(in, &v289)
+					out.ClickableStickers = append(out.ClickableStickers, v289)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object118(out *jwriter.Writer, in object.StoriesClickableStickers) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"original_width\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OriginalWidth))
+	}
+	{
+		const prefix string = ",\"original_height\":"
+		out.RawString(prefix)
+		out.Int(int(in.OriginalHeight))
+	}
+	{
+		const prefix string = ",\"clickable_stickers\":"
+		out.RawString(prefix)
+		if in.ClickableStickers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v290, v291 := range in.ClickableStickers {
+				if v290 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object121(out, v291)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object121(in *jlexer.Lexer, out *object.StoriesClickableSticker) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		case "clickable_area":
+			if in.IsNull() {
+				in.Skip()
+				out.ClickableArea = nil
+			} else {
+				in.Delim('[')
+				if out.ClickableArea == nil {
+					if !in.IsDelim(']') {
+						out.ClickableArea = make([]object.StoriesClickablePoint, 0, 4)
+					} else {
+						out.ClickableArea = []object.StoriesClickablePoint{}
+					}
+				} else {
+					out.ClickableArea = (out.ClickableArea)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v292 object.StoriesClickablePoint
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object122(in, &v292)
+					out.ClickableArea = append(out.ClickableArea, v292)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "style":
+			out.Style = string(in.String())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "sticker_id":
+			out.StickerID = int(in.Int())
+		case "sticker_pack_id":
+			out.StickerPackID = int(in.Int())
+		case "place_id":
+			out.PlaceID = int(in.Int())
+		case "category_id":
+			out.CategoryID = int(in.Int())
+		case "question":
+			out.Question = string(in.String())
+		case "question_button":
+			out.QuestionButton = string(in.String())
+		case "question_default_private":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.QuestionDefaultPrivate).UnmarshalJSON(data))
+			}
+		case "color":
+			out.Color = string(in.String())
+		case "mention":
+			out.Mention = string(in.String())
+		case "hashtag":
+			out.Hashtag = string(in.String())
+		case "link_object":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.LinkObject)
+		case "tooltip_text":
+			out.TooltipText = string(in.String())
+		case "tooltip_text_key":
+			out.TooltipTextKey = string(in.String())
+		case "timestamp_ms":
+			out.TimestampMs = int64(in.Int64())
+		case "date":
+			out.Date = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "subtype":
+			out.Subtype = string(in.String())
+		case "market_item":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MarketItem).UnmarshalJSON(data))
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "story_id":
+			out.StoryID = int(in.Int())
+		case "poll":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object27(in, &out.Poll)
+		case "audio":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object18(in, &out.Audio)
+		case "audio_start_time":
+			out.AudioStartTime = int(in.Int())
+		case "app":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object123(in, &out.App)
+		case "app_context":
+			out.AppContext = string(in.String())
+		case "has_new_interactions":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasNewInteractions).UnmarshalJSON(data))
+			}
+		case "is_broadcast_notify_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsBroadcastNotifyAllowed).UnmarshalJSON(data))
+			}
+		case "emoji":
+			out.Emoji = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "background_style":
+			out.BackgroundStyle = string(in.String())
+		case "alignment":
+			out.Alignment = string(in.String())
+		case "selection_color":
+			out.SelectionColor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object121(out *jwriter.Writer, in object.StoriesClickableSticker) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"clickable_area\":"
+		out.RawString(prefix)
+		if in.ClickableArea == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v293, v294 := range in.ClickableArea {
+				if v293 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object122(out, v294)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Style != "" {
+		const prefix string = ",\"style\":"
+		out.RawString(prefix)
+		out.String(string(in.Style))
+	}
+	if in.PostOwnerID != 0 {
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	if in.PostID != 0 {
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	if in.StickerID != 0 {
+		const prefix string = ",\"sticker_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.StickerID))
+	}
+	if in.StickerPackID != 0 {
+		const prefix string = ",\"sticker_pack_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.StickerPackID))
+	}
+	if in.PlaceID != 0 {
+		const prefix string = ",\"place_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PlaceID))
+	}
+	if in.CategoryID != 0 {
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CategoryID))
+	}
+	if in.Question != "" {
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	if in.QuestionButton != "" {
+		const prefix string = ",\"question_button\":"
+		out.RawString(prefix)
+		out.String(string(in.QuestionButton))
+	}
+	if in.QuestionDefaultPrivate {
+		const prefix string = ",\"question_default_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.QuestionDefaultPrivate))
+	}
+	if in.Color != "" {
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	if in.Mention != "" {
+		const prefix string = ",\"mention\":"
+		out.RawString(prefix)
+		out.String(string(in.Mention))
+	}
+	if in.Hashtag != "" {
+		const prefix string = ",\"hashtag\":"
+		out.RawString(prefix)
+		out.String(string(in.Hashtag))
+	}
+	if true {
+		const prefix string = ",\"link_object\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.LinkObject)
+	}
+	if in.TooltipText != "" {
+		const prefix string = ",\"tooltip_text\":"
+		out.RawString(prefix)
+		out.String(string(in.TooltipText))
+	}
+	if in.TooltipTextKey != "" {
+		const prefix string = ",\"tooltip_text_key\":"
+		out.RawString(prefix)
+		out.String(string(in.TooltipTextKey))
+	}
+	if in.TimestampMs != 0 {
+		const prefix string = ",\"timestamp_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TimestampMs))
+	}
+	if in.Date != "" {
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.String(string(in.Date))
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.Subtype != "" {
+		const prefix string = ",\"subtype\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtype))
+	}
+	if true {
+		const prefix string = ",\"market_item\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out, in.MarketItem)
+	}
+	if in.OwnerID != 0 {
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	if in.StoryID != 0 {
+		const prefix string = ",\"story_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.StoryID))
+	}
+	if true {
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object28(out, in.Poll)
+	}
+	if true {
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object18(out, in.Audio)
+	}
+	if in.AudioStartTime != 0 {
+		const prefix string = ",\"audio_start_time\":"
+		out.RawString(prefix)
+		out.Int(int(in.AudioStartTime))
+	}
+	if true {
+		const prefix string = ",\"app\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object123(out, in.App)
+	}
+	if in.AppContext != "" {
+		const prefix string = ",\"app_context\":"
+		out.RawString(prefix)
+		out.String(string(in.AppContext))
+	}
+	if in.HasNewInteractions {
+		const prefix string = ",\"has_new_interactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasNewInteractions))
+	}
+	if in.IsBroadcastNotifyAllowed {
+		const prefix string = ",\"is_broadcast_notify_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBroadcastNotifyAllowed))
+	}
+	if in.Emoji != "" {
+		const prefix string = ",\"emoji\":"
+		out.RawString(prefix)
+		out.String(string(in.Emoji))
+	}
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.BackgroundStyle != "" {
+		const prefix string = ",\"background_style\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundStyle))
+	}
+	if in.Alignment != "" {
+		const prefix string = ",\"alignment\":"
+		out.RawString(prefix)
+		out.String(string(in.Alignment))
+	}
+	if in.SelectionColor != "" {
+		const prefix string = ",\"selection_color\":"
+		out.RawString(prefix)
+		out.String(string(in.SelectionColor))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object123(in *jlexer.Lexer, out *object.AppsApp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "author_owner_id":
+			out.AuthorOwnerID = int(in.Int())
+		case "author_url":
+			out.AuthorURL = string(in.String())
+		case "banner_1120":
+			out.Banner1120 = string(in.String())
+		case "banner_560":
+			out.Banner560 = string(in.String())
+		case "catalog_position":
+			out.CatalogPosition = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "friends":
+			if in.IsNull() {
+				in.Skip()
+				out.Friends = nil
+			} else {
+				in.Delim('[')
+				if out.Friends == nil {
+					if !in.IsDelim(']') {
+						out.Friends = make([]int, 0, 8)
+					} else {
+						out.Friends = []int{}
+					}
+				} else {
+					out.Friends = (out.Friends)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v295 int
+					v295 = int(in.Int())
+					out.Friends = append(out.Friends, v295)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genre":
+			out.Genre = string(in.String())
+		case "genre_id":
+			out.GenreID = int(in.Int())
+		case "icon_139":
+			out.Icon139 = string(in.String())
+		case "icon_150":
+			out.Icon150 = string(in.String())
+		case "icon_278":
+			out.Icon278 = string(in.String())
+		case "icon_75":
+			out.Icon75 = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "international":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.International).UnmarshalJSON(data))
+			}
+		case "is_in_catalog":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsInCatalog).UnmarshalJSON(data))
+			}
+		case "installed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Installed).UnmarshalJSON(data))
+			}
+		case "push_enabled":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PushEnabled).UnmarshalJSON(data))
+			}
+		case "hide_tabbar":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HideTabbar).UnmarshalJSON(data))
+			}
+		case "is_new":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsNew).UnmarshalJSON(data))
+			}
+		case "new":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.New).UnmarshalJSON(data))
+			}
+		case "is_installed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsInstalled).UnmarshalJSON(data))
+			}
+		case "has_vk_connect":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.HasVkConnect).UnmarshalJSON(data))
+			}
+		case "leaderboard_type":
+			out.LeaderboardType = int(in.Int())
+		case "members_count":
+			out.MembersCount = int(in.Int())
+		case "platform_id":
+			out.PlatformID = int(in.Int())
+		case "published_date":
+			out.PublishedDate = int(in.Int())
+		case "screen_name":
+			out.ScreenName = string(in.String())
+		case "screenshots":
+			if in.IsNull() {
+				in.Skip()
+				out.Screenshots = nil
+			} else {
+				in.Delim('[')
+				if out.Screenshots == nil {
+					if !in.IsDelim(']') {
+						out.Screenshots = make([]object.PhotosPhoto, 0, 0)
+					} else {
+						out.Screenshots = []object.PhotosPhoto{}
+					}
+				} else {
+					out.Screenshots = (out.Screenshots)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v296 object.PhotosPhoto
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &v296)
+					out.Screenshots = append(out.Screenshots, v296)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "section":
+			out.Section = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "icon_16":
+			out.Icon16 = string(in.String())
+		case "icon_576":
+			out.Icon576 = string(in.String())
+		case "screen_orientation":
+			out.ScreenOrientation = object.AppsScreenOrientation(in.Int())
+		case "catalog_banner":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object124(in, &out.CatalogBanner)
+		case "mobile_controls_type":
+			out.MobileControlsType = int(in.Int())
+		case "mobile_view_support_type":
+			out.MobileViewSupportType = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object123(out *jwriter.Writer, in object.AppsApp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"author_owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AuthorOwnerID))
+	}
+	{
+		const prefix string = ",\"author_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorURL))
+	}
+	{
+		const prefix string = ",\"banner_1120\":"
+		out.RawString(prefix)
+		out.String(string(in.Banner1120))
+	}
+	{
+		const prefix string = ",\"banner_560\":"
+		out.RawString(prefix)
+		out.String(string(in.Banner560))
+	}
+	{
+		const prefix string = ",\"catalog_position\":"
+		out.RawString(prefix)
+		out.Int(int(in.CatalogPosition))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"friends\":"
+		out.RawString(prefix)
+		if in.Friends == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v297, v298 := range in.Friends {
+				if v297 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v298))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		out.String(string(in.Genre))
+	}
+	{
+		const prefix string = ",\"genre_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GenreID))
+	}
+	{
+		const prefix string = ",\"icon_139\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon139))
+	}
+	{
+		const prefix string = ",\"icon_150\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon150))
+	}
+	{
+		const prefix string = ",\"icon_278\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon278))
+	}
+	{
+		const prefix string = ",\"icon_75\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon75))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"international\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.International))
+	}
+	{
+		const prefix string = ",\"is_in_catalog\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsInCatalog))
+	}
+	{
+		const prefix string = ",\"installed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Installed))
+	}
+	{
+		const prefix string = ",\"push_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PushEnabled))
+	}
+	{
+		const prefix string = ",\"hide_tabbar\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HideTabbar))
+	}
+	{
+		const prefix string = ",\"is_new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsNew))
+	}
+	{
+		const prefix string = ",\"new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.New))
+	}
+	{
+		const prefix string = ",\"is_installed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsInstalled))
+	}
+	{
+		const prefix string = ",\"has_vk_connect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasVkConnect))
+	}
+	{
+		const prefix string = ",\"leaderboard_type\":"
+		out.RawString(prefix)
+		out.Int(int(in.LeaderboardType))
+	}
+	{
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembersCount))
+	}
+	{
+		const prefix string = ",\"platform_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PlatformID))
+	}
+	{
+		const prefix string = ",\"published_date\":"
+		out.RawString(prefix)
+		out.Int(int(in.PublishedDate))
+	}
+	{
+		const prefix string = ",\"screen_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ScreenName))
+	}
+	{
+		const prefix string = ",\"screenshots\":"
+		out.RawString(prefix)
+		if in.Screenshots == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v299, v300 := range in.Screenshots {
+				if v299 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, v300)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"section\":"
+		out.RawString(prefix)
+		out.String(string(in.Section))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"icon_16\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon16))
+	}
+	{
+		const prefix string = ",\"icon_576\":"
+		out.RawString(prefix)
+		out.String(string(in.Icon576))
+	}
+	{
+		const prefix string = ",\"screen_orientation\":"
+		out.RawString(prefix)
+		out.Int(int(in.ScreenOrientation))
+	}
+	{
+		const prefix string = ",\"catalog_banner\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object124(out, in.CatalogBanner)
+	}
+	{
+		const prefix string = ",\"mobile_controls_type\":"
+		out.RawString(prefix)
+		out.Int(int(in.MobileControlsType))
+	}
+	{
+		const prefix string = ",\"mobile_view_support_type\":"
+		out.RawString(prefix)
+		out.Int(int(in.MobileViewSupportType))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object124(in *jlexer.Lexer, out *object.AppsCatalogBanner) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "background_color":
+			out.BackgroundColor = string(in.String())
+		case "description_color":
+			out.DescriptionColor = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "title_color":
+			out.TitleColor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object124(out *jwriter.Writer, in object.AppsCatalogBanner) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BackgroundColor))
+	}
+	{
+		const prefix string = ",\"description_color\":"
+		out.RawString(prefix)
+		out.String(string(in.DescriptionColor))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"title_color\":"
+		out.RawString(prefix)
+		out.String(string(in.TitleColor))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object122(in *jlexer.Lexer, out *object.StoriesClickablePoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "x":
+			out.X = int(in.Int())
+		case "y":
+			out.Y = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object122(out *jwriter.Writer, in object.StoriesClickablePoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.X))
+	}
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.Int(int(in.Y))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object117(in *jlexer.Lexer, out *object.StoriesReplies) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = int(in.Int())
+		case "new":
+			out.New = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object117(out *jwriter.Writer, in object.StoriesReplies) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"new\":"
+		out.RawString(prefix)
+		out.Int(int(in.New))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object116(in *jlexer.Lexer, out *object.StoriesStoryLink) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object116(out *jwriter.Writer, in object.StoriesStoryLink) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object114(in *jlexer.Lexer, out *object.MessageCall) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "initiator_id":
+			out.InitiatorID = int(in.Int())
+		case "receiver_id":
+			out.ReceiverID = int(in.Int())
+		case "state":
+			out.State = string(in.String())
+		case "time":
+			out.Time = int(in.Int())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "video":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Video).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object114(out *jwriter.Writer, in object.MessageCall) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"initiator_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.InitiatorID))
+	}
+	{
+		const prefix string = ",\"receiver_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceiverID))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int(int(in.Time))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Video))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object113(in *jlexer.Lexer, out *object.GiftsLayout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "thumb_256":
+			out.Thumb256 = string(in.String())
+		case "thumb_48":
+			out.Thumb48 = string(in.String())
+		case "thumb_96":
+			out.Thumb96 = string(in.String())
+		case "stickers_product_id":
+			out.StickersProductID = int(in.Int())
+		case "is_stickers_style":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsStickersStyle).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object113(out *jwriter.Writer, in object.GiftsLayout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"thumb_256\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumb256))
+	}
+	{
+		const prefix string = ",\"thumb_48\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumb48))
+	}
+	{
+		const prefix string = ",\"thumb_96\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumb96))
+	}
+	{
+		const prefix string = ",\"stickers_product_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.StickersProductID))
+	}
+	{
+		const prefix string = ",\"is_stickers_style\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsStickersStyle))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object102(in *jlexer.Lexer, out *object.MessagesMessageAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		case "email":
+			out.Email = string(in.String())
+		case "member_id":
+			out.MemberID = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object125(in, &out.Photo)
+		case "text":
+			out.Text = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object102(out *jwriter.Writer, in object.MessagesMessageAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ConversationMessageID))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"member_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemberID))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object125(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object125(in *jlexer.Lexer, out *object.MessagesMessageActionPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photo_100":
+			out.Photo100 = string(in.String())
+		case "photo_200":
+			out.Photo200 = string(in.String())
+		case "photo_50":
+			out.Photo50 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object125(out *jwriter.Writer, in object.MessagesMessageActionPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photo_100\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Photo100))
+	}
+	{
+		const prefix string = ",\"photo_200\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo200))
+	}
+	{
+		const prefix string = ",\"photo_50\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo50))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events22(in *jlexer.Lexer, out *MessageReadObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "read_message_id":
+			out.ReadMessageID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events22(out *jwriter.Writer, in MessageReadObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"read_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReadMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageReadObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageReadObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageReadObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageReadObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events22(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events23(in *jlexer.Lexer, out *MessageReactionEventObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reacted_id":
+			out.ReactedID = int(in.Int())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "cmid":
+			out.Cmid = int(in.Int())
+		case "reaction_id":
+			out.ReactionID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events23(out *jwriter.Writer, in MessageReactionEventObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reacted_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ReactedID))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"cmid\":"
+		out.RawString(prefix)
+		out.Int(int(in.Cmid))
+	}
+	if in.ReactionID != 0 {
+		const prefix string = ",\"reaction_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReactionID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageReactionEventObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageReactionEventObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageReactionEventObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageReactionEventObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events23(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events24(in *jlexer.Lexer, out *MessageEventObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "event_id":
+			out.EventID = string(in.String())
+		case "payload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Payload).UnmarshalJSON(data))
+			}
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events24(out *jwriter.Writer, in MessageEventObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EventID))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.Raw((in.Payload).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ConversationMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageEventObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageEventObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageEventObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageEventObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events24(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events25(in *jlexer.Lexer, out *MessageEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_author_id":
+			out.AdminAuthorID = int(in.Int())
+		case "action":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object102(in, &out.Action)
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.MessagesMessageAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.MessagesMessageAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v301 object.MessagesMessageAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object103(in, &v301)
+					out.Attachments = append(out.Attachments, v301)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "fwd_Messages":
+			if in.IsNull() {
+				in.Skip()
+				out.FwdMessages = nil
+			} else {
+				in.Delim('[')
+				if out.FwdMessages == nil {
+					if !in.IsDelim(']') {
+						out.FwdMessages = make([]object.MessagesMessage, 0, 0)
+					} else {
+						out.FwdMessages = []object.MessagesMessage{}
+					}
+				} else {
+					out.FwdMessages = (out.FwdMessages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v302 object.MessagesMessage
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, &v302)
+					out.FwdMessages = append(out.FwdMessages, v302)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reply_message":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyMessage = nil
+			} else {
+				if out.ReplyMessage == nil {
+					out.ReplyMessage = new(object.MessagesMessage)
+				}
+				easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object104(in, out.ReplyMessage)
+			}
+		case "geo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object105(in, &out.Geo)
+		case "pinned_at":
+			out.PinnedAt = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "important":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Important).UnmarshalJSON(data))
+			}
+		case "is_hidden":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHidden).UnmarshalJSON(data))
+			}
+		case "is_cropped":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsCropped).UnmarshalJSON(data))
+			}
+		case "is_silent":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsSilent).UnmarshalJSON(data))
+			}
+		case "out":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Out).UnmarshalJSON(data))
+			}
+		case "was_listened":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.WasListened).UnmarshalJSON(data))
+			}
+		case "keyboard":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object106(in, &out.Keyboard)
+		case "template":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object107(in, &out.Template)
+		case "payload":
+			out.Payload = string(in.String())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "random_id":
+			out.RandomID = int(in.Int())
+		case "ref":
+			out.Ref = string(in.String())
+		case "ref_source":
+			out.RefSource = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "update_time":
+			out.UpdateTime = int(in.Int())
+		case "members_count":
+			out.MembersCount = int(in.Int())
+		case "expire_ttl":
+			out.ExpireTTL = int(in.Int())
+		case "message_tag":
+			out.MessageTag = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events25(out *jwriter.Writer, in MessageEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_author_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminAuthorID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object102(out, in.Action)
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v303, v304 := range in.Attachments {
+				if v303 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object103(out, v304)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ConversationMessageID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"fwd_Messages\":"
+		out.RawString(prefix)
+		if in.FwdMessages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v305, v306 := range in.FwdMessages {
+				if v305 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, v306)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reply_message\":"
+		out.RawString(prefix)
+		if in.ReplyMessage == nil {
+			out.RawString("null")
+		} else {
+			easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object104(out, *in.ReplyMessage)
+		}
+	}
+	{
+		const prefix string = ",\"geo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object105(out, in.Geo)
+	}
+	if in.PinnedAt != 0 {
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.Int(int(in.PinnedAt))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"important\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Important))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
+	{
+		const prefix string = ",\"is_cropped\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCropped))
+	}
+	{
+		const prefix string = ",\"is_silent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSilent))
+	}
+	{
+		const prefix string = ",\"out\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Out))
+	}
+	if in.WasListened {
+		const prefix string = ",\"was_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WasListened))
+	}
+	{
+		const prefix string = ",\"keyboard\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object106(out, in.Keyboard)
+	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object107(out, in.Template)
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"random_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.RandomID))
+	}
+	{
+		const prefix string = ",\"ref\":"
+		out.RawString(prefix)
+		out.String(string(in.Ref))
+	}
+	{
+		const prefix string = ",\"ref_source\":"
+		out.RawString(prefix)
+		out.String(string(in.RefSource))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"update_time\":"
+		out.RawString(prefix)
+		out.Int(int(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"members_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembersCount))
+	}
+	{
+		const prefix string = ",\"expire_ttl\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpireTTL))
+	}
+	{
+		const prefix string = ",\"message_tag\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageTag))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events25(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events26(in *jlexer.Lexer, out *MessageDenyObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events26(out *jwriter.Writer, in MessageDenyObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageDenyObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageDenyObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageDenyObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageDenyObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events26(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events27(in *jlexer.Lexer, out *MessageAllowObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events27(out *jwriter.Writer, in MessageAllowObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageAllowObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageAllowObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageAllowObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageAllowObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events27(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events28(in *jlexer.Lexer, out *MarketOrderNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "status":
+			out.Status = object.MarketOrderStatus(in.Int())
+		case "items_count":
+			out.ItemsCount = int(in.Int())
+		case "total_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TotalPrice).UnmarshalJSON(data))
+			}
+		case "display_order_id":
+			out.DisplayOrderID = string(in.String())
+		case "comment":
+			out.Comment = string(in.String())
+		case "merchant_comment":
+			out.MerchantComment = string(in.String())
+		case "weight":
+			out.Weight = int(in.Int())
+		case "is_viewed_by_admin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsViewedByAdmin).UnmarshalJSON(data))
+			}
+		case "cancel_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.CancelInfo)
+		case "preview_order_items":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviewOrderItems = nil
+			} else {
+				in.Delim('[')
+				if out.PreviewOrderItems == nil {
+					if !in.IsDelim(']') {
+						out.PreviewOrderItems = make([]object.MarketOrderItem, 0, 0)
+					} else {
+						out.PreviewOrderItems = []object.MarketOrderItem{}
+					}
+				} else {
+					out.PreviewOrderItems = (out.PreviewOrderItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v307 object.MarketOrderItem
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object126(in, &v307)
+					out.PreviewOrderItems = append(out.PreviewOrderItems, v307)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "price_details":
+			if in.IsNull() {
+				in.Skip()
+				out.PriceDetails = nil
+			} else {
+				in.Delim('[')
+				if out.PriceDetails == nil {
+					if !in.IsDelim(']') {
+						out.PriceDetails = make([]object.MarketPriceDetail, 0, 0)
+					} else {
+						out.PriceDetails = []object.MarketPriceDetail{}
+					}
+				} else {
+					out.PriceDetails = (out.PriceDetails)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v308 object.MarketPriceDetail
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object127(in, &v308)
+					out.PriceDetails = append(out.PriceDetails, v308)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "delivery":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object128(in, &out.Delivery)
+		case "recipient":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object129(in, &out.Recipient)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events28(out *jwriter.Writer, in MarketOrderNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"items_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemsCount))
+	}
+	{
+		const prefix string = ",\"total_price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.TotalPrice)
+	}
+	{
+		const prefix string = ",\"display_order_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayOrderID))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"merchant_comment\":"
+		out.RawString(prefix)
+		out.String(string(in.MerchantComment))
+	}
+	{
+		const prefix string = ",\"weight\":"
+		out.RawString(prefix)
+		out.Int(int(in.Weight))
+	}
+	{
+		const prefix string = ",\"is_viewed_by_admin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsViewedByAdmin))
+	}
+	{
+		const prefix string = ",\"cancel_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.CancelInfo)
+	}
+	{
+		const prefix string = ",\"preview_order_items\":"
+		out.RawString(prefix)
+		if in.PreviewOrderItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v309, v310 := range in.PreviewOrderItems {
+				if v309 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object126(out, v310)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"price_details\":"
+		out.RawString(prefix)
+		if in.PriceDetails == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v311, v312 := range in.PriceDetails {
+				if v311 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object127(out, v312)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"delivery\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object128(out, in.Delivery)
+	}
+	{
+		const prefix string = ",\"recipient\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object129(out, in.Recipient)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketOrderNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketOrderNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketOrderNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketOrderNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events28(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object129(in *jlexer.Lexer, out *object.MarketOrderRecipient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "display_text":
+			out.DisplayText = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object129(out *jwriter.Writer, in object.MarketOrderRecipient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"display_text\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayText))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object128(in *jlexer.Lexer, out *object.MarketOrderDelivery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_number":
+			out.TrackNumber = string(in.String())
+		case "track_link":
+			out.TrackLink = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "delivery_point":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object130(in, &out.DeliveryPoint)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object128(out *jwriter.Writer, in object.MarketOrderDelivery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_number\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TrackNumber))
+	}
+	{
+		const prefix string = ",\"track_link\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackLink))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if true {
+		const prefix string = ",\"delivery_point\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object130(out, in.DeliveryPoint)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object130(in *jlexer.Lexer, out *object.MarketDeliveryPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "external_id":
+			out.ExternalID = string(in.String())
+		case "outpost_only":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.OutpostOnly).UnmarshalJSON(data))
+			}
+		case "cash_only":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CashOnly).UnmarshalJSON(data))
+			}
+		case "address":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object131(in, &out.Address)
+		case "display_title":
+			out.DisplayTitle = string(in.String())
+		case "service_id":
+			out.ServiceID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object130(out *jwriter.Writer, in object.MarketDeliveryPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"external_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ExternalID))
+	}
+	{
+		const prefix string = ",\"outpost_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OutpostOnly))
+	}
+	{
+		const prefix string = ",\"cash_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CashOnly))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object131(out, in.Address)
+	}
+	{
+		const prefix string = ",\"display_title\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayTitle))
+	}
+	{
+		const prefix string = ",\"service_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ServiceID))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object131(in *jlexer.Lexer, out *object.MarketDeliveryPointAddress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "address":
+			out.Address = string(in.String())
+		case "city_id":
+			out.CityID = int(in.Int())
+		case "country_id":
+			out.CountryID = int(in.Int())
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
+		case "phone":
+			out.Phone = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "work_info_status":
+			out.WorkInfoStatus = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object131(out *jwriter.Writer, in object.MarketDeliveryPointAddress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"city_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CityID))
+	}
+	{
+		const prefix string = ",\"country_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CountryID))
+	}
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latitude))
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Longitude))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"work_info_status\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkInfoStatus))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object127(in *jlexer.Lexer, out *object.MarketPriceDetail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "is_accent":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsAccent).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object127(out *jwriter.Writer, in object.MarketPriceDetail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.Price)
+	}
+	if in.IsAccent {
+		const prefix string = ",\"is_accent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAccent))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object126(in *jlexer.Lexer, out *object.MarketOrderItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "quantity":
+			out.Quantity = int(in.Int())
+		case "item":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Item).UnmarshalJSON(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		case "variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]string, 0, 4)
+					} else {
+						out.Variants = []string{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v313 string
+					v313 = string(in.String())
+					out.Variants = append(out.Variants, v313)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object126(out *jwriter.Writer, in object.MarketOrderItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.Price)
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Quantity))
+	}
+	{
+		const prefix string = ",\"item\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object23(out, in.Item)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	{
+		const prefix string = ",\"variants\":"
+		out.RawString(prefix)
+		if in.Variants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v314, v315 := range in.Variants {
+				if v314 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v315))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events29(in *jlexer.Lexer, out *MarketOrderEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "status":
+			out.Status = object.MarketOrderStatus(in.Int())
+		case "items_count":
+			out.ItemsCount = int(in.Int())
+		case "total_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TotalPrice).UnmarshalJSON(data))
+			}
+		case "display_order_id":
+			out.DisplayOrderID = string(in.String())
+		case "comment":
+			out.Comment = string(in.String())
+		case "merchant_comment":
+			out.MerchantComment = string(in.String())
+		case "weight":
+			out.Weight = int(in.Int())
+		case "is_viewed_by_admin":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsViewedByAdmin).UnmarshalJSON(data))
+			}
+		case "cancel_info":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object22(in, &out.CancelInfo)
+		case "preview_order_items":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviewOrderItems = nil
+			} else {
+				in.Delim('[')
+				if out.PreviewOrderItems == nil {
+					if !in.IsDelim(']') {
+						out.PreviewOrderItems = make([]object.MarketOrderItem, 0, 0)
+					} else {
+						out.PreviewOrderItems = []object.MarketOrderItem{}
+					}
+				} else {
+					out.PreviewOrderItems = (out.PreviewOrderItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v316 object.MarketOrderItem
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object126(in, &v316)
+					out.PreviewOrderItems = append(out.PreviewOrderItems, v316)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "price_details":
+			if in.IsNull() {
+				in.Skip()
+				out.PriceDetails = nil
+			} else {
+				in.Delim('[')
+				if out.PriceDetails == nil {
+					if !in.IsDelim(']') {
+						out.PriceDetails = make([]object.MarketPriceDetail, 0, 0)
+					} else {
+						out.PriceDetails = []object.MarketPriceDetail{}
+					}
+				} else {
+					out.PriceDetails = (out.PriceDetails)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v317 object.MarketPriceDetail
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object127(in, &v317)
+					out.PriceDetails = append(out.PriceDetails, v317)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "delivery":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object128(in, &out.Delivery)
+		case "recipient":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object129(in, &out.Recipient)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events29(out *jwriter.Writer, in MarketOrderEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"items_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemsCount))
+	}
+	{
+		const prefix string = ",\"total_price\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object33(out, in.TotalPrice)
+	}
+	{
+		const prefix string = ",\"display_order_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayOrderID))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"merchant_comment\":"
+		out.RawString(prefix)
+		out.String(string(in.MerchantComment))
+	}
+	{
+		const prefix string = ",\"weight\":"
+		out.RawString(prefix)
+		out.Int(int(in.Weight))
+	}
+	{
+		const prefix string = ",\"is_viewed_by_admin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsViewedByAdmin))
+	}
+	{
+		const prefix string = ",\"cancel_info\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object22(out, in.CancelInfo)
+	}
+	{
+		const prefix string = ",\"preview_order_items\":"
+		out.RawString(prefix)
+		if in.PreviewOrderItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v318, v319 := range in.PreviewOrderItems {
+				if v318 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object126(out, v319)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"price_details\":"
+		out.RawString(prefix)
+		if in.PriceDetails == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v320, v321 := range in.PriceDetails {
+				if v320 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object127(out, v321)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"delivery\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object128(out, in.Delivery)
+	}
+	{
+		const prefix string = ",\"recipient\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object129(out, in.Recipient)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketOrderEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketOrderEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketOrderEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketOrderEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events29(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events30(in *jlexer.Lexer, out *MarketCommentRestoreObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v322 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v322)
+					out.Attachments = append(out.Attachments, v322)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v323 int
+					v323 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v323)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events30(out *jwriter.Writer, in MarketCommentRestoreObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v324, v325 := range in.Attachments {
+				if v324 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v325)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v326, v327 := range in.ParentsStack {
+				if v326 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v327))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketCommentRestoreObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketCommentRestoreObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketCommentRestoreObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketCommentRestoreObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events30(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events31(in *jlexer.Lexer, out *MarketCommentNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v328 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v328)
+					out.Attachments = append(out.Attachments, v328)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v329 int
+					v329 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v329)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events31(out *jwriter.Writer, in MarketCommentNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v330, v331 := range in.Attachments {
+				if v330 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v331)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v332, v333 := range in.ParentsStack {
+				if v332 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v333))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketCommentNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketCommentNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketCommentNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketCommentNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events31(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events32(in *jlexer.Lexer, out *MarketCommentEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v334 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v334)
+					out.Attachments = append(out.Attachments, v334)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "deleted":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Deleted).UnmarshalJSON(data))
+			}
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "real_offset":
+			out.RealOffset = int(in.Int())
+		case "reply_to_comment":
+			out.ReplyToComment = int(in.Int())
+		case "reply_to_user":
+			out.ReplyToUser = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "post_id":
+			out.PostID = int(in.Int())
+		case "post_owner_id":
+			out.PostOwnerID = int(in.Int())
+		case "photo_id":
+			out.PhotoID = int(in.Int())
+		case "photo_owner_id":
+			out.PhotoOwnerID = int(in.Int())
+		case "video_id":
+			out.VideoID = int(in.Int())
+		case "video_owner_id":
+			out.VideoOwnerID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		case "market_owner_id":
+			out.MarketOwnerID = int(in.Int())
+		case "parents_stack":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentsStack = nil
+			} else {
+				in.Delim('[')
+				if out.ParentsStack == nil {
+					if !in.IsDelim(']') {
+						out.ParentsStack = make([]int, 0, 8)
+					} else {
+						out.ParentsStack = []int{}
+					}
+				} else {
+					out.ParentsStack = (out.ParentsStack)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v335 int
+					v335 = int(in.Int())
+					out.ParentsStack = append(out.ParentsStack, v335)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "thread":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object98(in, &out.Thread)
+		case "donut":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object99(in, &out.Donut)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events32(out *jwriter.Writer, in MarketCommentEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v336, v337 := range in.Attachments {
+				if v336 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v337)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"real_offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.RealOffset))
+	}
+	{
+		const prefix string = ",\"reply_to_comment\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToComment))
+	}
+	{
+		const prefix string = ",\"reply_to_user\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyToUser))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	{
+		const prefix string = ",\"post_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostOwnerID))
+	}
+	{
+		const prefix string = ",\"photo_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoID))
+	}
+	{
+		const prefix string = ",\"photo_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PhotoOwnerID))
+	}
+	{
+		const prefix string = ",\"video_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoID))
+	}
+	{
+		const prefix string = ",\"video_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.VideoOwnerID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	{
+		const prefix string = ",\"market_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarketOwnerID))
+	}
+	{
+		const prefix string = ",\"parents_stack\":"
+		out.RawString(prefix)
+		if in.ParentsStack == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v338, v339 := range in.ParentsStack {
+				if v338 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v339))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"thread\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object98(out, in.Thread)
+	}
+	{
+		const prefix string = ",\"donut\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object99(out, in.Donut)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketCommentEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketCommentEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketCommentEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketCommentEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events32(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events33(in *jlexer.Lexer, out *MarketCommentDeleteObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "deleter_id":
+			out.DeleterID = int(in.Int())
+		case "item_id":
+			out.ItemID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events33(out *jwriter.Writer, in MarketCommentDeleteObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"deleter_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.DeleterID))
+	}
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ItemID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketCommentDeleteObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketCommentDeleteObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketCommentDeleteObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketCommentDeleteObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events33(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events34(in *jlexer.Lexer, out *LikeRemoveObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "liker_id":
+			out.LikerID = int(in.Int())
+		case "object_type":
+			out.ObjectType = string(in.String())
+		case "object_owner_id":
+			out.ObjectOwnerID = int(in.Int())
+		case "object_id":
+			out.ObjectID = int(in.Int())
+		case "thread_reply_id":
+			out.ThreadReplyID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events34(out *jwriter.Writer, in LikeRemoveObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"liker_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LikerID))
+	}
+	{
+		const prefix string = ",\"object_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ObjectType))
+	}
+	{
+		const prefix string = ",\"object_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ObjectOwnerID))
+	}
+	{
+		const prefix string = ",\"object_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ObjectID))
+	}
+	{
+		const prefix string = ",\"thread_reply_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ThreadReplyID))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LikeRemoveObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LikeRemoveObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LikeRemoveObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LikeRemoveObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events34(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events35(in *jlexer.Lexer, out *LikeAddObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "liker_id":
+			out.LikerID = int(in.Int())
+		case "object_type":
+			out.ObjectType = string(in.String())
+		case "object_owner_id":
+			out.ObjectOwnerID = int(in.Int())
+		case "object_id":
+			out.ObjectID = int(in.Int())
+		case "thread_reply_id":
+			out.ThreadReplyID = int(in.Int())
+		case "post_id":
+			out.PostID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events35(out *jwriter.Writer, in LikeAddObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"liker_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LikerID))
+	}
+	{
+		const prefix string = ",\"object_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ObjectType))
+	}
+	{
+		const prefix string = ",\"object_owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ObjectOwnerID))
+	}
+	{
+		const prefix string = ",\"object_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ObjectID))
+	}
+	{
+		const prefix string = ",\"thread_reply_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ThreadReplyID))
+	}
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PostID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LikeAddObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LikeAddObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LikeAddObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LikeAddObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events35(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events36(in *jlexer.Lexer, out *LeadFormsNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lead_id":
+			out.LeadID = int(in.Int())
+		case "group_id":
+			out.GroupID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "form_id":
+			out.FormID = int(in.Int())
+		case "form_name":
+			out.FormName = string(in.String())
+		case "ad_id":
+			out.AdID = int(in.Int())
+		case "answers":
+			if in.IsNull() {
+				in.Skip()
+				out.Answers = nil
+			} else {
+				in.Delim('[')
+				if out.Answers == nil {
+					if !in.IsDelim(']') {
+						out.Answers = make([]struct {
+							Key      string `json:"key"`
+							Question string `json:"question"`
+							Answer   string `json:"answer"`
+						}, 0, 1)
+					} else {
+						out.Answers = []struct {
+							Key      string `json:"key"`
+							Question string `json:"question"`
+							Answer   string `json:"answer"`
+						}{}
+					}
+				} else {
+					out.Answers = (out.Answers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v340 struct {
+						Key      string `json:"key"`
+						Question string `json:"question"`
+						Answer   string `json:"answer"`
+					}
+					easyjsonCce3d1beDecode3(in, &v340)
+					out.Answers = append(out.Answers, v340)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events36(out *jwriter.Writer, in LeadFormsNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lead_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LeadID))
+	}
+	{
+		const prefix string = ",\"group_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GroupID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"form_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FormID))
+	}
+	{
+		const prefix string = ",\"form_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FormName))
+	}
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AdID))
+	}
+	{
+		const prefix string = ",\"answers\":"
+		out.RawString(prefix)
+		if in.Answers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v341, v342 := range in.Answers {
+				if v341 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncode3(out, v342)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LeadFormsNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeadFormsNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LeadFormsNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeadFormsNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events36(l, v)
+}
+func easyjsonCce3d1beDecode3(in *jlexer.Lexer, out *struct {
+	Key      string `json:"key"`
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "question":
+			out.Question = string(in.String())
+		case "answer":
+			out.Answer = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncode3(out *jwriter.Writer, in struct {
+	Key      string `json:"key"`
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events37(in *jlexer.Lexer, out *GroupOfficersEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_id":
+			out.AdminID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "level_old":
+			out.LevelOld = int(in.Int())
+		case "level_new":
+			out.LevelNew = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events37(out *jwriter.Writer, in GroupOfficersEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admin_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AdminID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"level_old\":"
+		out.RawString(prefix)
+		out.Int(int(in.LevelOld))
+	}
+	{
+		const prefix string = ",\"level_new\":"
+		out.RawString(prefix)
+		out.Int(int(in.LevelNew))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupOfficersEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupOfficersEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupOfficersEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupOfficersEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events37(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events38(in *jlexer.Lexer, out *GroupLeaveObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "self":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Self).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events38(out *jwriter.Writer, in GroupLeaveObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"self\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Self))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupLeaveObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupLeaveObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupLeaveObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupLeaveObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events38(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events39(in *jlexer.Lexer, out *GroupJoinObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "join_type":
+			out.JoinType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events39(out *jwriter.Writer, in GroupJoinObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"join_type\":"
+		out.RawString(prefix)
+		out.String(string(in.JoinType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupJoinObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupJoinObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupJoinObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupJoinObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events39(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events40(in *jlexer.Lexer, out *GroupChangeSettingsObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "Changes":
+			easyjsonCce3d1beDecode4(in, &out.Changes)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events40(out *jwriter.Writer, in GroupChangeSettingsObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"Changes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncode4(out, in.Changes)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupChangeSettingsObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupChangeSettingsObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupChangeSettingsObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupChangeSettingsObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events40(l, v)
+}
+func easyjsonCce3d1beDecode4(in *jlexer.Lexer, out *struct {
+	Title                 Changes    `json:"title"`
+	Description           Changes    `json:"description"`
+	Access                ChangesInt `json:"access"`
+	ScreenName            Changes    `json:"screen_name"`
+	PublicCategory        ChangesInt `json:"public_category"`
+	PublicSubcategory     ChangesInt `json:"public_subcategory"`
+	AgeLimits             ChangesInt `json:"age_limits"`
+	Website               Changes    `json:"website"`
+	StatusDefault         Changes    `json:"status_default"`
+	Wall                  ChangesInt `json:"wall"`
+	Replies               ChangesInt `json:"replies"`
+	Topics                ChangesInt `json:"topics"`
+	Audio                 ChangesInt `json:"audio"`
+	Photos                ChangesInt `json:"photos"`
+	Video                 ChangesInt `json:"video"`
+	Market                ChangesInt `json:"market"`
+	Docs                  ChangesInt `json:"docs"`
+	Messages              ChangesInt `json:"messages"`
+	EventGroupID          ChangesInt `json:"event_group_id"`
+	Links                 Changes    `json:"links"`
+	Email                 Changes    `json:"email"`
+	EventStartDate        ChangesInt `json:"event_start_date::"`
+	EventFinishDate       ChangesInt `json:"event_finish_date:"`
+	Subject               Changes    `json:"subject"`
+	MarketWiki            Changes    `json:"market_wiki"`
+	DisableMarketComments ChangesInt `json:"disable_market_comments"`
+	Phone                 ChangesInt `json:"phone"`
+	CountryID             ChangesInt `json:"country_id"`
+	CityID                ChangesInt `json:"city_id"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			(out.Title).UnmarshalEasyJSON(in)
+		case "description":
+			(out.Description).UnmarshalEasyJSON(in)
+		case "access":
+			(out.Access).UnmarshalEasyJSON(in)
+		case "screen_name":
+			(out.ScreenName).UnmarshalEasyJSON(in)
+		case "public_category":
+			(out.PublicCategory).UnmarshalEasyJSON(in)
+		case "public_subcategory":
+			(out.PublicSubcategory).UnmarshalEasyJSON(in)
+		case "age_limits":
+			(out.AgeLimits).UnmarshalEasyJSON(in)
+		case "website":
+			(out.Website).UnmarshalEasyJSON(in)
+		case "status_default":
+			(out.StatusDefault).UnmarshalEasyJSON(in)
+		case "wall":
+			(out.Wall).UnmarshalEasyJSON(in)
+		case "replies":
+			(out.Replies).UnmarshalEasyJSON(in)
+		case "topics":
+			(out.Topics).UnmarshalEasyJSON(in)
+		case "audio":
+			(out.Audio).UnmarshalEasyJSON(in)
+		case "photos":
+			(out.Photos).UnmarshalEasyJSON(in)
+		case "video":
+			(out.Video).UnmarshalEasyJSON(in)
+		case "market":
+			(out.Market).UnmarshalEasyJSON(in)
+		case "docs":
+			(out.Docs).UnmarshalEasyJSON(in)
+		case "messages":
+			(out.Messages).UnmarshalEasyJSON(in)
+		case "event_group_id":
+			(out.EventGroupID).UnmarshalEasyJSON(in)
+		case "links":
+			(out.Links).UnmarshalEasyJSON(in)
+		case "email":
+			(out.Email).UnmarshalEasyJSON(in)
+		case "event_start_date::":
+			(out.EventStartDate).UnmarshalEasyJSON(in)
+		case "event_finish_date:":
+			(out.EventFinishDate).UnmarshalEasyJSON(in)
+		case "subject":
+			(out.Subject).UnmarshalEasyJSON(in)
+		case "market_wiki":
+			(out.MarketWiki).UnmarshalEasyJSON(in)
+		case "disable_market_comments":
+			(out.DisableMarketComments).UnmarshalEasyJSON(in)
+		case "phone":
+			(out.Phone).UnmarshalEasyJSON(in)
+		case "country_id":
+			(out.CountryID).UnmarshalEasyJSON(in)
+		case "city_id":
+			(out.CityID).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncode4(out *jwriter.Writer, in struct {
+	Title                 Changes    `json:"title"`
+	Description           Changes    `json:"description"`
+	Access                ChangesInt `json:"access"`
+	ScreenName            Changes    `json:"screen_name"`
+	PublicCategory        ChangesInt `json:"public_category"`
+	PublicSubcategory     ChangesInt `json:"public_subcategory"`
+	AgeLimits             ChangesInt `json:"age_limits"`
+	Website               Changes    `json:"website"`
+	StatusDefault         Changes    `json:"status_default"`
+	Wall                  ChangesInt `json:"wall"`
+	Replies               ChangesInt `json:"replies"`
+	Topics                ChangesInt `json:"topics"`
+	Audio                 ChangesInt `json:"audio"`
+	Photos                ChangesInt `json:"photos"`
+	Video                 ChangesInt `json:"video"`
+	Market                ChangesInt `json:"market"`
+	Docs                  ChangesInt `json:"docs"`
+	Messages              ChangesInt `json:"messages"`
+	EventGroupID          ChangesInt `json:"event_group_id"`
+	Links                 Changes    `json:"links"`
+	Email                 Changes    `json:"email"`
+	EventStartDate        ChangesInt `json:"event_start_date::"`
+	EventFinishDate       ChangesInt `json:"event_finish_date:"`
+	Subject               Changes    `json:"subject"`
+	MarketWiki            Changes    `json:"market_wiki"`
+	DisableMarketComments ChangesInt `json:"disable_market_comments"`
+	Phone                 ChangesInt `json:"phone"`
+	CountryID             ChangesInt `json:"country_id"`
+	CityID                ChangesInt `json:"city_id"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		(in.Title).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		(in.Description).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"access\":"
+		out.RawString(prefix)
+		(in.Access).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"screen_name\":"
+		out.RawString(prefix)
+		(in.ScreenName).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"public_category\":"
+		out.RawString(prefix)
+		(in.PublicCategory).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"public_subcategory\":"
+		out.RawString(prefix)
+		(in.PublicSubcategory).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"age_limits\":"
+		out.RawString(prefix)
+		(in.AgeLimits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"website\":"
+		out.RawString(prefix)
+		(in.Website).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status_default\":"
+		out.RawString(prefix)
+		(in.StatusDefault).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"wall\":"
+		out.RawString(prefix)
+		(in.Wall).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		(in.Replies).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"topics\":"
+		out.RawString(prefix)
+		(in.Topics).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		(in.Audio).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		(in.Photos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		(in.Video).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		(in.Market).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"docs\":"
+		out.RawString(prefix)
+		(in.Docs).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		(in.Messages).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"event_group_id\":"
+		out.RawString(prefix)
+		(in.EventGroupID).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"links\":"
+		out.RawString(prefix)
+		(in.Links).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		(in.Email).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"event_start_date::\":"
+		out.RawString(prefix)
+		(in.EventStartDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"event_finish_date:\":"
+		out.RawString(prefix)
+		(in.EventFinishDate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"subject\":"
+		out.RawString(prefix)
+		(in.Subject).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"market_wiki\":"
+		out.RawString(prefix)
+		(in.MarketWiki).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"disable_market_comments\":"
+		out.RawString(prefix)
+		(in.DisableMarketComments).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		(in.Phone).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"country_id\":"
+		out.RawString(prefix)
+		(in.CountryID).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"city_id\":"
+		out.RawString(prefix)
+		(in.CityID).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events41(in *jlexer.Lexer, out *GroupChangePhotoObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "photo":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object26(in, &out.Photo)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events41(out *jwriter.Writer, in GroupChangePhotoObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object27(out, in.Photo)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GroupChangePhotoObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GroupChangePhotoObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GroupChangePhotoObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GroupChangePhotoObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events41(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events42(in *jlexer.Lexer, out *DonutSubscriptionProlongedObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = int(in.Int())
+		case "amount_without_fee":
+			out.AmountWithoutFee = float64(in.Float64())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events42(out *jwriter.Writer, in DonutSubscriptionProlongedObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Amount))
+	}
+	{
+		const prefix string = ",\"amount_without_fee\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AmountWithoutFee))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutSubscriptionProlongedObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutSubscriptionProlongedObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutSubscriptionProlongedObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutSubscriptionProlongedObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events42(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events43(in *jlexer.Lexer, out *DonutSubscriptionPriceChangedObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount_old":
+			out.AmountOld = int(in.Int())
+		case "amount_new":
+			out.AmountNew = int(in.Int())
+		case "amount_diff":
+			out.AmountDiff = float64(in.Float64())
+		case "amount_diff_without_fee":
+			out.AmountDiffWithoutFee = float64(in.Float64())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events43(out *jwriter.Writer, in DonutSubscriptionPriceChangedObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount_old\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.AmountOld))
+	}
+	{
+		const prefix string = ",\"amount_new\":"
+		out.RawString(prefix)
+		out.Int(int(in.AmountNew))
+	}
+	{
+		const prefix string = ",\"amount_diff\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AmountDiff))
+	}
+	{
+		const prefix string = ",\"amount_diff_without_fee\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AmountDiffWithoutFee))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutSubscriptionPriceChangedObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutSubscriptionPriceChangedObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutSubscriptionPriceChangedObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutSubscriptionPriceChangedObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events43(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events44(in *jlexer.Lexer, out *DonutSubscriptionExpiredObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events44(out *jwriter.Writer, in DonutSubscriptionExpiredObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutSubscriptionExpiredObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutSubscriptionExpiredObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutSubscriptionExpiredObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutSubscriptionExpiredObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events44(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events45(in *jlexer.Lexer, out *DonutSubscriptionCreateObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = int(in.Int())
+		case "amount_without_fee":
+			out.AmountWithoutFee = float64(in.Float64())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events45(out *jwriter.Writer, in DonutSubscriptionCreateObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Amount))
+	}
+	{
+		const prefix string = ",\"amount_without_fee\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AmountWithoutFee))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutSubscriptionCreateObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutSubscriptionCreateObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutSubscriptionCreateObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutSubscriptionCreateObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events45(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events46(in *jlexer.Lexer, out *DonutSubscriptionCancelledObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events46(out *jwriter.Writer, in DonutSubscriptionCancelledObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutSubscriptionCancelledObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutSubscriptionCancelledObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutSubscriptionCancelledObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutSubscriptionCancelledObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events46(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events47(in *jlexer.Lexer, out *DonutMoneyWithdrawObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = float64(in.Float64())
+		case "amount_without_fee":
+			out.AmountWithoutFee = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events47(out *jwriter.Writer, in DonutMoneyWithdrawObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Amount))
+	}
+	{
+		const prefix string = ",\"amount_without_fee\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AmountWithoutFee))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutMoneyWithdrawObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutMoneyWithdrawObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutMoneyWithdrawObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutMoneyWithdrawObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events47(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events48(in *jlexer.Lexer, out *DonutMoneyWithdrawErrorObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events48(out *jwriter.Writer, in DonutMoneyWithdrawErrorObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DonutMoneyWithdrawErrorObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DonutMoneyWithdrawErrorObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DonutMoneyWithdrawErrorObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DonutMoneyWithdrawErrorObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events48(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events49(in *jlexer.Lexer, out *ChangesInt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "old_value":
+			out.OldValue = int(in.Int())
+		case "new_value":
+			out.NewValue = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events49(out *jwriter.Writer, in ChangesInt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"old_value\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.OldValue))
+	}
+	{
+		const prefix string = ",\"new_value\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewValue))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChangesInt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChangesInt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChangesInt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChangesInt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events49(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events50(in *jlexer.Lexer, out *Changes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "old_value":
+			out.OldValue = string(in.String())
+		case "new_value":
+			out.NewValue = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events50(out *jwriter.Writer, in Changes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"old_value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OldValue))
+	}
+	{
+		const prefix string = ",\"new_value\":"
+		out.RawString(prefix)
+		out.String(string(in.NewValue))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Changes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Changes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Changes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Changes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events50(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events51(in *jlexer.Lexer, out *BoardPostRestoreObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v343 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v343)
+					out.Attachments = append(out.Attachments, v343)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events51(out *jwriter.Writer, in BoardPostRestoreObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v344, v345 := range in.Attachments {
+				if v344 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v345)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardPostRestoreObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardPostRestoreObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardPostRestoreObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardPostRestoreObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events51(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events52(in *jlexer.Lexer, out *BoardPostNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v346 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v346)
+					out.Attachments = append(out.Attachments, v346)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events52(out *jwriter.Writer, in BoardPostNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v347, v348 := range in.Attachments {
+				if v347 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v348)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardPostNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardPostNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardPostNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardPostNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events52(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events53(in *jlexer.Lexer, out *BoardPostEditObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]object.WallCommentAttachment, 0, 0)
+					} else {
+						out.Attachments = []object.WallCommentAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v349 object.WallCommentAttachment
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object97(in, &v349)
+					out.Attachments = append(out.Attachments, v349)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			out.Date = int(in.Int())
+		case "from_id":
+			out.FromID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "likes":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object1(in, &out.Likes)
+		case "can_edit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CanEdit).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events53(out *jwriter.Writer, in BoardPostEditObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix[1:])
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v350, v351 := range in.Attachments {
+				if v350 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object97(out, v351)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"from_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object1(out, in.Likes)
+	}
+	{
+		const prefix string = ",\"can_edit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanEdit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardPostEditObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardPostEditObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardPostEditObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardPostEditObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events53(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events54(in *jlexer.Lexer, out *BoardPostDeleteObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "topic_owner_id":
+			out.TopicOwnerID = int(in.Int())
+		case "topic_id":
+			out.TopicID = int(in.Int())
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events54(out *jwriter.Writer, in BoardPostDeleteObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"topic_owner_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TopicOwnerID))
+	}
+	{
+		const prefix string = ",\"topic_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TopicID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardPostDeleteObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardPostDeleteObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardPostDeleteObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardPostDeleteObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events54(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events55(in *jlexer.Lexer, out *AudioNewObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_key":
+			out.AccessKey = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		case "artist":
+			out.Artist = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "date":
+			out.Date = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "is_hq":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsHq).UnmarshalJSON(data))
+			}
+		case "is_explicit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsExplicit).UnmarshalJSON(data))
+			}
+		case "stories_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesAllowed).UnmarshalJSON(data))
+			}
+		case "short_videos_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ShortVideosAllowed).UnmarshalJSON(data))
+			}
+		case "is_focus_track":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsFocusTrack).UnmarshalJSON(data))
+			}
+		case "is_licensed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.IsLicensed).UnmarshalJSON(data))
+			}
+		case "stories_cover_allowed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StoriesCoverAllowed).UnmarshalJSON(data))
+			}
+		case "lyrics_id":
+			out.LyricsID = int(in.Int())
+		case "album_id":
+			out.AlbumID = int(in.Int())
+		case "genre_id":
+			out.GenreID = int(in.Int())
+		case "track_code":
+			out.TrackCode = string(in.String())
+		case "no_search":
+			out.NoSearch = int(in.Int())
+		case "main_artists":
+			if in.IsNull() {
+				in.Skip()
+				out.MainArtists = nil
+			} else {
+				in.Delim('[')
+				if out.MainArtists == nil {
+					if !in.IsDelim(']') {
+						out.MainArtists = make([]object.AudioAudioArtist, 0, 1)
+					} else {
+						out.MainArtists = []object.AudioAudioArtist{}
+					}
+				} else {
+					out.MainArtists = (out.MainArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v352 object.AudioAudioArtist
+					easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object43(in, &v352)
+					out.MainArtists = append(out.MainArtists, v352)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ads":
+			easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Object96(in, &out.Ads)
+		case "subtitle":
+			out.Subtitle = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events55(out *jwriter.Writer, in AudioNewObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessKey))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Int(int(in.Date))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"is_hq\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHq))
+	}
+	{
+		const prefix string = ",\"is_explicit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsExplicit))
+	}
+	{
+		const prefix string = ",\"stories_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesAllowed))
+	}
+	{
+		const prefix string = ",\"short_videos_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShortVideosAllowed))
+	}
+	{
+		const prefix string = ",\"is_focus_track\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFocusTrack))
+	}
+	{
+		const prefix string = ",\"is_licensed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLicensed))
+	}
+	{
+		const prefix string = ",\"stories_cover_allowed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StoriesCoverAllowed))
+	}
+	{
+		const prefix string = ",\"lyrics_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.LyricsID))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"genre_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.GenreID))
+	}
+	{
+		const prefix string = ",\"track_code\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackCode))
+	}
+	{
+		const prefix string = ",\"no_search\":"
+		out.RawString(prefix)
+		out.Int(int(in.NoSearch))
+	}
+	{
+		const prefix string = ",\"main_artists\":"
+		out.RawString(prefix)
+		if in.MainArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v353, v354 := range in.MainArtists {
+				if v353 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object44(out, v354)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ads\":"
+		out.RawString(prefix)
+		easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Object96(out, in.Ads)
+	}
+	{
+		const prefix string = ",\"subtitle\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtitle))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AudioNewObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AudioNewObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AudioNewObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AudioNewObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events55(l, v)
+}
+func easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events56(in *jlexer.Lexer, out *AppPayloadObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "app_id":
+			out.AppID = int(in.Int())
+		case "payload":
+			out.Payload = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events56(out *jwriter.Writer, in AppPayloadObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"app_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AppID))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AppPayloadObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AppPayloadObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCce3d1beEncodeGithubComSevereCloudVksdkV2Events56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AppPayloadObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AppPayloadObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCce3d1beDecodeGithubComSevereCloudVksdkV2Events56(l, v)
+}
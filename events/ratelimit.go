@@ -0,0 +1,72 @@
+package events // import "github.com/SevereCloud/vksdk/v2/events"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit returns a middleware that limits the dispatch to n events per
+// second, so handlers that call VK API do not exceed the API limits after
+// a burst of events.
+//
+// Events are delayed evenly. The limit is shared by all goroutines. If n is
+// zero or negative, events are not limited.
+//
+//	lp.Use(events.RateLimit(api.LimitGroupToken))
+func RateLimit(n int) func(Handler) Handler {
+	if n <= 0 {
+		return func(next Handler) Handler {
+			return next
+		}
+	}
+
+	l := &limiter{
+		interval: time.Second / time.Duration(n),
+	}
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, e GroupEvent) error {
+			if err := l.wait(ctx); err != nil {
+				return err
+			}
+
+			return next(ctx, e)
+		}
+	}
+}
+
+type limiter struct {
+	interval time.Duration
+	next     time.Time
+	mux      sync.Mutex
+}
+
+// wait blocks until the next event is allowed or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	l.mux.Lock()
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	l.mux.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.Use(events.RateLimit(20))
+
+	var handled int
+
+	fl.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled++
+	})
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		err := fl.Handler(context.Background(), events.GroupEvent{Type: "test"})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 5, handled)
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*200)
+}
+
+func TestRateLimit_noLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, -1} {
+		fl := events.NewFuncList()
+		fl.Use(events.RateLimit(n))
+
+		var handled int
+
+		fl.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+			handled++
+		})
+
+		start := time.Now()
+
+		for i := 0; i < 5; i++ {
+			err := fl.Handler(context.Background(), events.GroupEvent{Type: "test"})
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, 5, handled)
+		assert.Less(t, time.Since(start), time.Millisecond*100)
+	}
+}
+
+func TestRateLimit_Context(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.Use(events.RateLimit(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	assert.NoError(t, fl.Handler(ctx, events.GroupEvent{Type: "test"}))
+
+	cancel()
+
+	assert.ErrorIs(t, fl.Handler(ctx, events.GroupEvent{Type: "test"}), context.Canceled)
+}
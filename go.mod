@@ -1,10 +1,20 @@
 module github.com/SevereCloud/vksdk/v2
 
-go 1.16
+go 1.18
 
 require (
 	github.com/gorilla/schema v1.2.0
 	github.com/gorilla/websocket v1.4.2
-	github.com/stretchr/testify v1.7.0
+	github.com/mailru/easyjson v0.7.7
+	github.com/stretchr/testify v1.7.1
 	golang.org/x/text v0.3.7
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/kr/pretty v0.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
@@ -0,0 +1,25 @@
+package internal // import "github.com/SevereCloud/vksdk/v2/internal"
+
+import (
+	"math/rand"
+	"time"
+)
+
+// BackoffDelay returns the delay before the attempt: a random duration
+// between zero and min * 2^(attempt-1), but no more than max (exponential
+// backoff with full jitter).
+func BackoffDelay(attempt int, min, max time.Duration) time.Duration {
+	d := max
+
+	if attempt < 64 {
+		if exp := min << uint(attempt-1); exp > 0 && exp < max {
+			d = exp
+		}
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(d))) // nolint:gosec
+}
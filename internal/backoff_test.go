@@ -0,0 +1,31 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+
+	f := func(attempt int, max time.Duration) {
+		t.Helper()
+
+		for i := 0; i < 100; i++ {
+			d := internal.BackoffDelay(attempt, time.Second, 5*time.Second)
+			assert.GreaterOrEqual(t, d, time.Duration(0))
+			assert.Less(t, d, max)
+		}
+	}
+
+	f(1, time.Second)
+	f(2, 2*time.Second)
+	f(3, 4*time.Second)
+	f(4, 5*time.Second)
+	f(100, 5*time.Second)
+
+	assert.Equal(t, time.Duration(0), internal.BackoffDelay(1, 0, 0))
+}
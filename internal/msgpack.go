@@ -0,0 +1,266 @@
+package internal // import "github.com/SevereCloud/vksdk/v2/internal"
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// ErrMsgpack is returned for invalid or unsupported MessagePack data.
+var ErrMsgpack = errors.New("msgpack: invalid data")
+
+// MsgpackToJSON converts the MessagePack value to JSON. Binary values are
+// converted to base64 strings, map keys are converted to strings.
+//
+// See https://github.com/msgpack/msgpack/blob/master/spec.md
+func MsgpackToJSON(data []byte) ([]byte, error) {
+	d := msgpackDecoder{data: data}
+
+	if err := d.value(false); err != nil {
+		return nil, err
+	}
+
+	if d.pos != len(d.data) {
+		return nil, ErrMsgpack
+	}
+
+	return d.buf, nil
+}
+
+type msgpackDecoder struct {
+	data []byte
+	pos  int
+	buf  []byte
+}
+
+func (d *msgpackDecoder) next(n int) ([]byte, error) {
+	if n < 0 || len(d.data)-d.pos < n {
+		return nil, ErrMsgpack
+	}
+
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+
+	return b, nil
+}
+
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	b, err := d.next(n)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	default:
+		return binary.BigEndian.Uint64(b), nil
+	}
+}
+
+func (d *msgpackDecoder) int(n int) (int64, error) {
+	u, err := d.uint(n)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n {
+	case 1:
+		return int64(int8(u)), nil
+	case 2:
+		return int64(int16(u)), nil
+	case 4:
+		return int64(int32(u)), nil
+	default:
+		return int64(u), nil
+	}
+}
+
+// value appends the next value as JSON. If key is true, the value is
+// written as a string.
+func (d *msgpackDecoder) value(key bool) error { // nolint:gocyclo
+	b, err := d.next(1)
+	if err != nil {
+		return err
+	}
+
+	switch c := b[0]; {
+	case c <= 0x7f:
+		return d.number(strconv.AppendInt(nil, int64(c), 10), key)
+	case c >= 0xe0:
+		return d.number(strconv.AppendInt(nil, int64(int8(c)), 10), key)
+	case c >= 0x80 && c <= 0x8f:
+		return d.mapValue(int(c&0x0f), key)
+	case c >= 0x90 && c <= 0x9f:
+		return d.array(int(c&0x0f), key)
+	case c >= 0xa0 && c <= 0xbf:
+		return d.str(int(c&0x1f), false)
+	case c == 0xc0:
+		return d.literal("null", key)
+	case c == 0xc2:
+		return d.literal("false", key)
+	case c == 0xc3:
+		return d.literal("true", key)
+	case c >= 0xc4 && c <= 0xc6:
+		n, err := d.uint(1 << (c - 0xc4))
+		if err != nil {
+			return err
+		}
+
+		return d.str(int(n), true)
+	case c == 0xca:
+		u, err := d.uint(4)
+		if err != nil {
+			return err
+		}
+
+		return d.float(float64(math.Float32frombits(uint32(u))), 32, key)
+	case c == 0xcb:
+		u, err := d.uint(8)
+		if err != nil {
+			return err
+		}
+
+		return d.float(math.Float64frombits(u), 64, key)
+	case c >= 0xcc && c <= 0xcf:
+		u, err := d.uint(1 << (c - 0xcc))
+		if err != nil {
+			return err
+		}
+
+		return d.number(strconv.AppendUint(nil, u, 10), key)
+	case c >= 0xd0 && c <= 0xd3:
+		i, err := d.int(1 << (c - 0xd0))
+		if err != nil {
+			return err
+		}
+
+		return d.number(strconv.AppendInt(nil, i, 10), key)
+	case c >= 0xd9 && c <= 0xdb:
+		n, err := d.uint(1 << (c - 0xd9))
+		if err != nil {
+			return err
+		}
+
+		return d.str(int(n), false)
+	case c == 0xdc || c == 0xdd:
+		n, err := d.uint(2 << (c - 0xdc))
+		if err != nil {
+			return err
+		}
+
+		return d.array(int(n), key)
+	case c == 0xde || c == 0xdf:
+		n, err := d.uint(2 << (c - 0xde))
+		if err != nil {
+			return err
+		}
+
+		return d.mapValue(int(n), key)
+	}
+
+	// extension types are not used by VK API
+	return ErrMsgpack
+}
+
+func (d *msgpackDecoder) literal(s string, key bool) error {
+	if key {
+		d.buf = strconv.AppendQuote(d.buf, s)
+	} else {
+		d.buf = append(d.buf, s...)
+	}
+
+	return nil
+}
+
+func (d *msgpackDecoder) number(b []byte, key bool) error {
+	return d.literal(string(b), key)
+}
+
+func (d *msgpackDecoder) float(f float64, bitSize int, key bool) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return ErrMsgpack
+	}
+
+	return d.number(strconv.AppendFloat(nil, f, 'g', -1, bitSize), key)
+}
+
+func (d *msgpackDecoder) str(n int, isBinary bool) error {
+	b, err := d.next(n)
+	if err != nil {
+		return err
+	}
+
+	var s string
+	if isBinary {
+		s = base64.StdEncoding.EncodeToString(b)
+	} else {
+		s = string(b)
+	}
+
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	d.buf = append(d.buf, quoted...)
+
+	return nil
+}
+
+func (d *msgpackDecoder) array(n int, key bool) error {
+	if key {
+		return ErrMsgpack
+	}
+
+	d.buf = append(d.buf, '[')
+
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			d.buf = append(d.buf, ',')
+		}
+
+		if err := d.value(false); err != nil {
+			return err
+		}
+	}
+
+	d.buf = append(d.buf, ']')
+
+	return nil
+}
+
+func (d *msgpackDecoder) mapValue(n int, key bool) error {
+	if key {
+		return ErrMsgpack
+	}
+
+	d.buf = append(d.buf, '{')
+
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			d.buf = append(d.buf, ',')
+		}
+
+		if err := d.value(true); err != nil {
+			return err
+		}
+
+		d.buf = append(d.buf, ':')
+
+		if err := d.value(false); err != nil {
+			return err
+		}
+	}
+
+	d.buf = append(d.buf, '}')
+
+	return nil
+}
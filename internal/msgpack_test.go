@@ -0,0 +1,60 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgpackToJSON(t *testing.T) {
+	t.Parallel()
+
+	f := func(data []byte, want string) {
+		t.Helper()
+
+		got, err := internal.MsgpackToJSON(data)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, want, string(got))
+		}
+	}
+
+	f([]byte{0xc0}, `null`)
+	f([]byte{0xc2}, `false`)
+	f([]byte{0xc3}, `true`)
+	f([]byte{0x7f}, `127`)
+	f([]byte{0xff}, `-1`)
+	f([]byte{0xcc, 0xff}, `255`)
+	f([]byte{0xcd, 0x01, 0x00}, `256`)
+	f([]byte{0xce, 0x00, 0x01, 0x00, 0x00}, `65536`)
+	f([]byte{0xcf, 0, 0, 0, 1, 0, 0, 0, 0}, `4294967296`)
+	f([]byte{0xd0, 0x80}, `-128`)
+	f([]byte{0xd1, 0xff, 0x00}, `-256`)
+	f([]byte{0xd2, 0xff, 0xff, 0xff, 0xff}, `-1`)
+	f([]byte{0xd3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, `-2`)
+	f([]byte{0xca, 0x3f, 0xc0, 0x00, 0x00}, `1.5`)
+	f([]byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, `1.5`)
+	f([]byte{0xa3, 'a', '"', 'b'}, `"a\"b"`)
+	f([]byte{0xd9, 0x02, 0xd0, 0xb9}, `"й"`)
+	f([]byte{0xc4, 0x02, 0x01, 0x02}, `"AQI="`)
+	f([]byte{0x92, 0x01, 0xa1, 'a'}, `[1,"a"]`)
+	f([]byte{0xdc, 0x00, 0x00}, `[]`)
+	f([]byte{0x82, 0xa1, 'a', 0x01, 0x02, 0x90}, `{"a":1,"2":[]}`)
+	f([]byte{0xde, 0x00, 0x01, 0xc3, 0x80}, `{"true":{}}`)
+
+	fail := func(data []byte) {
+		t.Helper()
+
+		_, err := internal.MsgpackToJSON(data)
+		assert.ErrorIs(t, err, internal.ErrMsgpack)
+	}
+
+	fail(nil)
+	fail([]byte{0xa3, 'a'})
+	fail([]byte{0x92, 0x01})
+	fail([]byte{0x81, 0x90, 0x01})
+	fail([]byte{0xc1})
+	fail([]byte{0xd4, 0x01, 0x01})
+	fail([]byte{0xcb, 0x7f, 0xf8, 0, 0, 0, 0, 0, 1})
+	fail([]byte{0x01, 0x02})
+}
@@ -28,6 +28,7 @@ const (
 	CallbackRetryCounterKey
 	CallbackRetryAfterKey
 	CallbackRemove
+	EventObjectKey
 )
 
 // ContextClient return *http.Client.
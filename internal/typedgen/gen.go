@@ -0,0 +1,377 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// schemaType is a JSON schema of a value.
+type schemaType struct {
+	Ref         string                 `json:"$ref"`
+	Type        json.RawMessage        `json:"type"`
+	Description string                 `json:"description"`
+	Items       *schemaType            `json:"items"`
+	Properties  map[string]*schemaType `json:"properties"`
+}
+
+// typeName returns the type of the schema. If there are several types, the
+// first one is returned.
+func (t *schemaType) typeName() string {
+	var name string
+	if err := json.Unmarshal(t.Type, &name); err == nil {
+		return name
+	}
+
+	var names []string
+	if err := json.Unmarshal(t.Type, &names); err == nil && len(names) > 0 {
+		return names[0]
+	}
+
+	return ""
+}
+
+type parameter struct {
+	schemaType
+
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+}
+
+type method struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  []parameter            `json:"parameters"`
+	Responses   map[string]*schemaType `json:"responses"`
+}
+
+type definitions struct {
+	Definitions map[string]*schemaType `json:"definitions"`
+}
+
+type generator struct {
+	defs      map[string]*schemaType
+	types     bytes.Buffer
+	methods   bytes.Buffer
+	generated map[string]bool
+	queue     []string
+	raw       bool
+}
+
+// generate returns the formatted Go code of the methods and the types that
+// they use.
+func generate(pkg string, methodsJSON, responsesJSON, objectsJSON []byte) ([]byte, error) {
+	var schema struct {
+		Methods []method `json:"methods"`
+	}
+
+	if err := json.Unmarshal(methodsJSON, &schema); err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		defs:      make(map[string]*schemaType),
+		generated: make(map[string]bool),
+	}
+
+	for file, data := range map[string][]byte{
+		"responses": responsesJSON,
+		"objects":   objectsJSON,
+	} {
+		var d definitions
+		if err := json.Unmarshal(data, &d); err != nil {
+			return nil, err
+		}
+
+		for name, t := range d.Definitions {
+			g.defs[file+"/"+name] = t
+		}
+	}
+
+	sort.Slice(schema.Methods, func(i, j int) bool {
+		return schema.Methods[i].Name < schema.Methods[j].Name
+	})
+
+	for _, m := range schema.Methods {
+		g.writeMethod(m)
+	}
+
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		g.writeDefinition(name)
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by gen from vk-api-schema; DO NOT EDIT.\n\n")
+	buf.WriteString("package " + pkg + "\n\n")
+	buf.WriteString("import (\n")
+
+	if g.raw {
+		buf.WriteString("\t\"encoding/json\"\n\n")
+	}
+
+	buf.WriteString("\t\"github.com/SevereCloud/vksdk/v2/api\"\n)\n")
+	buf.Write(g.methods.Bytes())
+	buf.Write(g.types.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+func (g *generator) writeMethod(m method) {
+	name := goName(m.Name)
+	request := name + "Request"
+
+	fmt.Fprintf(&g.methods, "\n// %s is the request of %s.\n", request, m.Name)
+	if len(m.Parameters) == 0 {
+		fmt.Fprintf(&g.methods, "type %s struct{}\n", request)
+	} else {
+		fmt.Fprintf(&g.methods, "type %s struct {\n", request)
+	}
+
+	fields := make(map[string]bool)
+
+	for _, p := range m.Parameters {
+		field := uniqueName(goName(p.Name), fields)
+		tag := p.Name
+
+		if !p.Required {
+			tag += ",omitempty"
+		}
+
+		writeComment(&g.methods, "\t", p.Description)
+		fmt.Fprintf(&g.methods, "\t%s %s `vk:%q`\n", field, g.goType(&p.schemaType), tag)
+	}
+
+	if len(m.Parameters) > 0 {
+		g.methods.WriteString("}\n")
+	}
+
+	response := "json.RawMessage"
+
+	if r, ok := m.Responses["response"]; ok {
+		response = g.goType(r)
+	} else {
+		g.raw = true
+	}
+
+	description := m.Description
+	if description == "" {
+		description = "calls " + m.Name + "."
+	}
+
+	g.methods.WriteString("\n")
+	writeComment(&g.methods, "", name+" "+lowerFirst(description))
+	fmt.Fprintf(&g.methods, "//\n// https://vk.com/dev/%s\n", m.Name)
+	fmt.Fprintf(&g.methods, "func %s(vk *api.VK, req %s) (response %s, err error) {\n", name, request, response)
+	fmt.Fprintf(&g.methods, "\terr = vk.RequestUnmarshal(%q, &response, api.ParamsFrom(req))\n", m.Name)
+	g.methods.WriteString("\treturn\n}\n")
+}
+
+// writeDefinition writes the named type of the definition.
+func (g *generator) writeDefinition(key string) {
+	name := goName(key[strings.IndexByte(key, '/')+1:])
+
+	t, ok := g.defs[key]
+	if !ok {
+		g.raw = true
+
+		fmt.Fprintf(&g.types, "\n// %s is not described in the schema.\n", name)
+		fmt.Fprintf(&g.types, "type %s = json.RawMessage\n", name)
+
+		return
+	}
+
+	t = unwrapResponse(key, t)
+	typ := g.goType(t)
+
+	g.types.WriteString("\n")
+
+	switch {
+	case t.Description != "":
+		writeComment(&g.types, "", name+" "+lowerFirst(t.Description))
+	case strings.HasPrefix(typ, "struct"):
+		fmt.Fprintf(&g.types, "// %s struct.\n", name)
+	default:
+		fmt.Fprintf(&g.types, "// %s type.\n", name)
+	}
+
+	fmt.Fprintf(&g.types, "type %s %s\n", name, typ)
+}
+
+// refKey returns the key of the definition of the ref, for example,
+// objects.json#/definitions/users_user is objects/users_user.
+func refKey(ref string) string {
+	file := "objects"
+	if i := strings.IndexByte(ref, '#'); i > 0 {
+		file = strings.TrimSuffix(filepath.Base(ref[:i]), ".json")
+	}
+
+	return file + "/" + ref[strings.LastIndexByte(ref, '/')+1:]
+}
+
+// unwrapResponse returns the response property of the responses, which
+// wraps them.
+func unwrapResponse(key string, t *schemaType) *schemaType {
+	if r, ok := t.Properties["response"]; ok && strings.HasPrefix(key, "responses/") {
+		return r
+	}
+
+	return t
+}
+
+// goType returns the Go type of the schema and adds the definitions, to
+// which it refers, to the queue.
+func (g *generator) goType(t *schemaType) string {
+	if t.Ref != "" {
+		key := refKey(t.Ref)
+
+		// the response that refers to the object is replaced by the object
+		if def, ok := g.defs[key]; ok && unwrapResponse(key, def).Ref != "" {
+			return g.goType(unwrapResponse(key, def))
+		}
+
+		if !g.generated[key] {
+			g.generated[key] = true
+			g.queue = append(g.queue, key)
+		}
+
+		return goName(key[strings.IndexByte(key, '/')+1:])
+	}
+
+	switch t.typeName() {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "string":
+		return "string"
+	case "array":
+		if t.Items == nil {
+			g.raw = true
+			return "[]json.RawMessage"
+		}
+
+		return "[]" + g.goType(t.Items)
+	case "object":
+		if len(t.Properties) > 0 {
+			return g.structType(t)
+		}
+	}
+
+	g.raw = true
+
+	return "json.RawMessage"
+}
+
+func (g *generator) structType(t *schemaType) string {
+	names := make([]string, 0, len(t.Properties))
+	for name := range t.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+
+	buf.WriteString("struct {\n")
+
+	fields := make(map[string]bool)
+
+	for _, name := range names {
+		p := t.Properties[name]
+
+		writeComment(&buf, "\t", p.Description)
+		fmt.Fprintf(&buf, "\t%s %s `json:%q`\n", uniqueName(goName(name), fields), g.goType(p), name)
+	}
+
+	buf.WriteString("}")
+
+	return buf.String()
+}
+
+// initialisms are written in upper case.
+var initialisms = map[string]string{ // nolint:gochecknoglobals
+	"api":  "API",
+	"html": "HTML",
+	"http": "HTTP",
+	"id":   "ID",
+	"ids":  "IDs",
+	"ip":   "IP",
+	"json": "JSON",
+	"sms":  "SMS",
+	"uri":  "URI",
+	"url":  "URL",
+	"utf":  "UTF",
+}
+
+// goName returns the exported Go name of the snake case name or the name
+// of the method.
+func goName(name string) string {
+	var b strings.Builder
+
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if s, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(s)
+			continue
+		}
+
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "N" + s
+	}
+
+	return s
+}
+
+// uniqueName returns the name that is not in names.
+func uniqueName(name string, names map[string]bool) string {
+	unique := name
+
+	for i := 2; names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+
+	names[unique] = true
+
+	return unique
+}
+
+// writeComment writes the description as a comment ending with a period.
+func writeComment(buf *bytes.Buffer, indent, description string) {
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		return
+	}
+
+	if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+
+	buf.WriteString(indent + "// " + description + "\n")
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	if len(r) > 0 {
+		r[0] = unicode.ToLower(r[0])
+	}
+
+	return string(r)
+}
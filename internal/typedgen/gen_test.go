@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	read := func(name string) []byte {
+		t.Helper()
+
+		b, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return b
+	}
+
+	code, err := generate(
+		"typed",
+		read("schema/methods.json"),
+		read("schema/responses.json"),
+		read("schema/objects.json"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, string(read("methods_gen.golden")), string(code))
+
+	_, err = generate("typed", []byte("{"), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	f := func(name, want string) {
+		t.Helper()
+
+		assert.Equal(t, want, goName(name))
+	}
+
+	f("users.get", "UsersGet")
+	f("utils.getServerTime", "UtilsGetServerTime")
+	f("user_ids", "UserIDs")
+	f("photo_50", "Photo50")
+	f("2fa_required", "N2faRequired")
+	f("owner_id", "OwnerID")
+}
@@ -0,0 +1,43 @@
+// Command typedgen generates typed requests and responses of VK API methods
+// from vk-api-schema.
+//
+//	go run ./internal/typedgen -schema path/to/vk-api-schema -out methods_gen.go
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	schema := flag.String("schema", "", "path to vk-api-schema")
+	out := flag.String("out", "methods_gen.go", "output file")
+	pkg := flag.String("pkg", "typed", "package name")
+	flag.Parse()
+
+	if *schema == "" {
+		log.Fatal("typedgen: -schema is required")
+	}
+
+	var files [3][]byte
+
+	for i, name := range []string{"methods.json", "responses.json", "objects.json"} {
+		b, err := os.ReadFile(filepath.Join(*schema, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		files[i] = b
+	}
+
+	code, err := generate(*pkg, files[0], files[1], files[2])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, code, 0o644); err != nil { // nolint:gosec
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"context"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/internal"
+)
+
+// Backoff configures retries of requests to the longpoll server that failed
+// due to transport errors.
+// The delays grow exponentially from Min to Max with full jitter, like the
+// delays of api.Retry.
+type Backoff struct {
+	// MaxAttempts is the maximum number of attempts per request.
+	// Zero or one disables retries.
+	MaxAttempts int
+	Min         time.Duration
+	Max         time.Duration
+}
+
+// Delay returns the delay before the attempt.
+func (b Backoff) Delay(attempt int) time.Duration {
+	return internal.BackoffDelay(attempt, b.Min, b.Max)
+}
+
+// sleep pauses the current goroutine for at least the duration d or until
+// ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
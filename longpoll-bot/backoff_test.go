@@ -0,0 +1,88 @@
+package longpoll
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBackoff_Delay(t *testing.T) {
+	t.Parallel()
+
+	b := Backoff{
+		Min: time.Second,
+		Max: time.Second * 5,
+	}
+
+	f := func(attempt int, max time.Duration) {
+		t.Helper()
+
+		for i := 0; i < 100; i++ {
+			d := b.Delay(attempt)
+			assert.GreaterOrEqual(t, d, time.Duration(0))
+			assert.Less(t, d, max)
+		}
+	}
+
+	f(1, time.Second)
+	f(2, time.Second*2)
+	f(3, time.Second*4)
+	f(4, time.Second*5)
+	f(100, time.Second*5)
+
+	assert.Equal(t, time.Duration(0), Backoff{}.Delay(1))
+}
+
+func TestLongPoll_do(t *testing.T) {
+	t.Parallel()
+
+	errTransport := errors.New("transport")
+
+	f := func(maxAttempts, failures, wantAttempts int, wantErr bool) {
+		t.Helper()
+
+		attempts := 0
+		lp := &LongPoll{
+			Client: &http.Client{
+				Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+					if attempts <= failures {
+						return nil, errTransport
+					}
+
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				}),
+			},
+			Backoff: Backoff{
+				MaxAttempts: maxAttempts,
+				Min:         time.Millisecond,
+				Max:         time.Millisecond * 10,
+			},
+		}
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+
+		resp, err := lp.do(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		assert.Equal(t, wantErr, err != nil)
+		assert.Equal(t, wantAttempts, attempts)
+	}
+
+	f(0, 0, 1, false)
+	f(0, 1, 1, true)
+	f(3, 2, 3, false)
+	f(3, 3, 3, true)
+}
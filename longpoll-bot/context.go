@@ -3,10 +3,10 @@ package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
 import (
 	"context"
 
-	"github.com/SevereCloud/vksdk/v2/internal"
+	"github.com/SevereCloud/vksdk/v2/events"
 )
 
 // TsFromContext returns the ts from context.
-func TsFromContext(ctx context.Context) int {
-	return ctx.Value(internal.LongPollTsKey).(int)
+func TsFromContext(ctx context.Context) string {
+	return events.TsFromContext(ctx)
 }
@@ -12,7 +12,8 @@ import (
 func TestTsFromContext(t *testing.T) {
 	t.Parallel()
 
-	const ts = 123
+	const ts = "123"
 	ctx := context.WithValue(context.Background(), internal.LongPollTsKey, ts)
 	assert.Equal(t, ts, longpoll.TsFromContext(ctx))
+	assert.Equal(t, "", longpoll.TsFromContext(context.Background()))
 }
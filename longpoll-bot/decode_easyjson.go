@@ -0,0 +1,88 @@
+//go:build easyjson
+// +build easyjson
+
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// fastDecoding is true if the response is decoded with the generated
+// decoders.
+const fastDecoding = true
+
+// decodeResponseFast decodes the response in strict mode without the raw
+// updates, using the decoders generated by easyjson.
+func decodeResponseFast(reader io.Reader) (response Response, err error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return response, err
+	}
+
+	in := jlexer.Lexer{Data: data}
+
+	in.Delim('{')
+
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+
+		switch key {
+		case "failed":
+			response.Failed = in.Int()
+		case "updates":
+			response.Updates = decodeUpdatesFast(&in)
+		case "ts":
+			// can be a number in the response with "failed" field: {"ts":8,"failed":1}
+			// or string, e.g. {"ts":"8","updates":[]}
+			switch ts := in.Interface().(type) {
+			case float64:
+				response.Ts = strconv.Itoa(int(ts))
+			case string:
+				response.Ts = ts
+			default:
+				in.AddError(fmt.Errorf("%w of ts: %v", ErrUnexpectedValue, ts))
+			}
+		default:
+			in.SkipRecursive()
+		}
+
+		in.WantComma()
+	}
+
+	in.Delim('}')
+	in.Consumed()
+
+	return response, in.Error()
+}
+
+func decodeUpdatesFast(in *jlexer.Lexer) []events.GroupEvent {
+	if in.IsNull() {
+		in.Skip()
+
+		return nil
+	}
+
+	updates := []events.GroupEvent{}
+
+	in.Delim('[')
+
+	for !in.IsDelim(']') {
+		var event events.GroupEvent
+
+		event.UnmarshalEasyJSON(in)
+		updates = append(updates, event)
+
+		in.WantComma()
+	}
+
+	in.Delim(']')
+
+	return updates
+}
@@ -0,0 +1,15 @@
+//go:build !easyjson
+// +build !easyjson
+
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import "io"
+
+// fastDecoding is true if the response is decoded with the generated
+// decoders. Build with the easyjson tag to enable it.
+const fastDecoding = false
+
+// decodeResponseFast is never called without the easyjson tag.
+func decodeResponseFast(io.Reader) (Response, error) {
+	panic("longpoll: built without easyjson tag")
+}
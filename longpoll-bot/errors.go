@@ -1,14 +1,30 @@
 package longpoll
 
 import (
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/events"
 )
 
+// ErrFixedServer is returned when the longpoll server set by WithServer
+// requires to update the server.
+var ErrFixedServer = errors.New("longpoll: server is fixed and cannot be updated")
+
+// ErrUnexpectedValue is returned when a field of the longpoll response has
+// an unexpected type.
+var ErrUnexpectedValue = errors.New("longpoll: unexpected value")
+
 // Failed struct.
 type Failed struct {
 	Code int
 }
 
+// FailedError is returned when the longpoll server responds with an unknown
+// failed code.
+type FailedError = Failed
+
 // Error returns the message of a Failed.
 func (e Failed) Error() string {
 	return fmt.Sprintf(
@@ -16,3 +32,138 @@ func (e Failed) Error() string {
 		e.Code,
 	)
 }
+
+// Is reports whether target is Failed with the same code.
+//
+//	errors.Is(err, &longpoll.Failed{Code: 4})
+func (e Failed) Is(target error) bool {
+	switch t := target.(type) {
+	case *Failed:
+		return t.Code == e.Code
+	case Failed:
+		return t.Code == e.Code
+	}
+
+	return false
+}
+
+// TransportError is returned when the request to the longpoll server failed.
+type TransportError struct {
+	Err error
+}
+
+// Error returns the message of a TransportError.
+func (e TransportError) Error() string {
+	return "longpoll: transport: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e TransportError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when the response of the longpoll server cannot
+// be decoded.
+type DecodeError struct {
+	Err error
+}
+
+// Error returns the message of a DecodeError.
+func (e DecodeError) Error() string {
+	return "longpoll: decode: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// HandlerError is returned when the handling of the event failed.
+type HandlerError struct {
+	Event events.GroupEvent
+	Err   error
+}
+
+// Error returns the message of a HandlerError.
+func (e HandlerError) Error() string {
+	return fmt.Sprintf(
+		"longpoll: handler %s: %v",
+		e.Event.Type,
+		e.Err,
+	)
+}
+
+// Unwrap returns the underlying error.
+func (e HandlerError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError is returned when the longpoll server responds with
+// an unexpected HTTP status.
+type StatusError struct {
+	StatusCode int
+}
+
+// Error returns the message of a StatusError.
+func (e StatusError) Error() string {
+	return fmt.Sprintf(
+		"longpoll: unexpected status %d",
+		e.StatusCode,
+	)
+}
+
+// Is reports whether target is StatusError with the same status code.
+func (e StatusError) Is(target error) bool {
+	switch t := target.(type) {
+	case *StatusError:
+		return t.StatusCode == e.StatusCode
+	case StatusError:
+		return t.StatusCode == e.StatusCode
+	}
+
+	return false
+}
+
+// PanicError struct.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error returns the message of a PanicError.
+func (e PanicError) Error() string {
+	return fmt.Sprintf(
+		"longpoll: panic in handler: %v",
+		e.Value,
+	)
+}
+
+// ErrorAction determines what LongPoll does after an error.
+type ErrorAction int
+
+// ErrorAction list.
+const (
+	// ErrorActionStop stops polling and returns the error from Run.
+	ErrorActionStop ErrorAction = iota
+	// ErrorActionRetry repeats the failed request or the event handling.
+	// The request or the handling is repeated with the delay of Backoff up
+	// to its MaxAttempts, after which the error stops polling.
+	ErrorActionRetry
+	// ErrorActionSkip ignores the error and continues polling, after the
+	// delay of Backoff if the request failed. If the error was returned by
+	// the handler, the event is skipped.
+	ErrorActionSkip
+)
+
+// HandlerPolicy defines what to do with errors returned by the handler of
+// an event. The policy takes precedence over OnError:
+//
+//   - ErrorActionStop stops polling and returns the error from Run;
+//   - ErrorActionSkip logs the error and skips the event;
+//   - ErrorActionRetry repeats the handling up to MaxAttempts times with
+//     Delay between attempts, after which the error is passed to OnError.
+type HandlerPolicy struct {
+	Action      ErrorAction
+	MaxAttempts int
+	Delay       time.Duration
+}
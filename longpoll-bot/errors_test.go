@@ -1,8 +1,11 @@
 package longpoll_test
 
 import (
+	"errors"
+	"io"
 	"testing"
 
+	"github.com/SevereCloud/vksdk/v2/events"
 	"github.com/SevereCloud/vksdk/v2/longpoll-bot"
 	"github.com/stretchr/testify/assert"
 )
@@ -13,3 +16,72 @@ func TestFailed_Error(t *testing.T) {
 	err := longpoll.Failed{1}
 	assert.EqualError(t, err, "longpoll: failed code 1")
 }
+
+func TestPanicError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := longpoll.PanicError{Value: "test"}
+	assert.EqualError(t, err, "longpoll: panic in handler: test")
+}
+
+func TestStatusError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := longpoll.StatusError{403}
+	assert.EqualError(t, err, "longpoll: unexpected status 403")
+}
+
+func TestFailed_Is(t *testing.T) {
+	t.Parallel()
+
+	var err error = &longpoll.Failed{4}
+
+	assert.True(t, errors.Is(err, &longpoll.Failed{4}))
+	assert.True(t, errors.Is(err, longpoll.FailedError{4}))
+	assert.False(t, errors.Is(err, &longpoll.Failed{5}))
+
+	var failed *longpoll.FailedError
+
+	assert.True(t, errors.As(err, &failed))
+	assert.Equal(t, 4, failed.Code)
+}
+
+func TestStatusError_Is(t *testing.T) {
+	t.Parallel()
+
+	var err error = &longpoll.StatusError{403}
+
+	assert.True(t, errors.Is(err, &longpoll.StatusError{403}))
+	assert.True(t, errors.Is(err, longpoll.StatusError{403}))
+	assert.False(t, errors.Is(err, &longpoll.StatusError{500}))
+}
+
+func TestTransportError(t *testing.T) {
+	t.Parallel()
+
+	var err error = &longpoll.TransportError{io.EOF}
+
+	assert.EqualError(t, err, "longpoll: transport: EOF")
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestDecodeError(t *testing.T) {
+	t.Parallel()
+
+	var err error = &longpoll.DecodeError{io.ErrUnexpectedEOF}
+
+	assert.EqualError(t, err, "longpoll: decode: unexpected EOF")
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestHandlerError(t *testing.T) {
+	t.Parallel()
+
+	var err error = &longpoll.HandlerError{
+		Event: events.GroupEvent{Type: events.EventMessageNew},
+		Err:   io.EOF,
+	}
+
+	assert.EqualError(t, err, "longpoll: handler message_new: EOF")
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -12,7 +12,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"runtime/debug"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/SevereCloud/vksdk/v2"
 	"github.com/SevereCloud/vksdk/v2/api"
@@ -20,11 +24,103 @@ import (
 	"github.com/SevereCloud/vksdk/v2/internal"
 )
 
+// maxServerUpdates is the maximum number of server updates in a row after
+// the server rejects the key.
+const maxServerUpdates = 3
+
 // Response struct.
 type Response struct {
 	Ts      string              `json:"ts"`
 	Updates []events.GroupEvent `json:"updates"`
 	Failed  int                 `json:"failed"`
+
+	// raw contains the untouched JSON of updates if raw handlers are set.
+	raw []json.RawMessage
+}
+
+// rawUpdate returns the untouched JSON of the i-th update.
+func (resp Response) rawUpdate(i int) json.RawMessage {
+	if i < len(resp.raw) {
+		return resp.raw[i]
+	}
+
+	return nil
+}
+
+// slice returns the response with the updates from i to j.
+func (resp Response) slice(i, j int) Response {
+	batch := resp
+	batch.Updates = resp.Updates[i:j]
+	batch.raw = nil
+
+	if len(resp.raw) >= j {
+		batch.raw = resp.raw[i:j]
+	}
+
+	return batch
+}
+
+// FailedStrategy defines what to do with an unknown failed code of the
+// longpoll server.
+type FailedStrategy int
+
+// FailedStrategy list.
+const (
+	// FailedStrategyAbort returns Failed error, which stops polling unless
+	// OnError handles it.
+	FailedStrategyAbort FailedStrategy = iota
+
+	// FailedStrategyUpdateServer gets a new server, key and ts.
+	FailedStrategyUpdateServer
+
+	// FailedStrategyBackoff waits for the Backoff delay and repeats the
+	// request with the same ts. The delay grows with the number of failed
+	// requests in a row.
+	FailedStrategyBackoff
+)
+
+// OverflowPolicy defines what to do with updates of one response beyond
+// MaxUpdates.
+type OverflowPolicy int
+
+// OverflowPolicy list.
+const (
+	// OverflowQueue handles the updates beyond the limit in the next batches
+	// before the next request to the longpoll server.
+	OverflowQueue OverflowPolicy = iota
+
+	// OverflowDrop drops the updates beyond the limit.
+	OverflowDrop
+
+	// OverflowBlock handles the updates in batches and waits for each batch
+	// to be handled before dispatching the next one. Without goroutines it is
+	// the same as OverflowQueue.
+	OverflowBlock
+)
+
+// LeaderElector elects the instance that polls the longpoll server, so
+// several replicas of the bot can run in standby mode.
+type LeaderElector interface {
+	// Campaign blocks until the instance becomes the leader or ctx is done.
+	// The returned context must be done when the leadership is lost.
+	Campaign(ctx context.Context) (context.Context, error)
+	// Resign gives up the leadership.
+	Resign(ctx context.Context) error
+}
+
+// Logger is used to log internal behavior of the longpoll at debug level.
+// It is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// TsStorage persists ts, so that after restart the longpoll continues
+// from the last handled response.
+type TsStorage interface {
+	// Get returns the saved ts. An empty ts means that nothing is saved.
+	Get(groupID int) (string, error)
+	// Set saves ts after all events of the response are handled.
+	Set(groupID int, ts string) error
 }
 
 // LongPoll struct.
@@ -36,9 +132,84 @@ type LongPoll struct {
 	Wait    int
 	VK      *api.VK
 	Client  *http.Client
-	cancel  context.CancelFunc
+	Backoff Backoff
+
+	// Version is the version of Bots Long Poll API. It is passed to
+	// groups.getLongPollServer and to the longpoll server. If empty, the
+	// version of VK is used and the server uses the version from the
+	// community settings.
+	Version string
+
+	// fixedServer is set by WithServer.
+	fixedServer bool
 
-	funcFullResponseList []func(Response)
+	// MaxUpdates limits the number of updates from one response that are
+	// handled at once. Zero means no limit.
+	MaxUpdates int
+
+	// Overflow specifies what to do with the updates beyond MaxUpdates.
+	Overflow OverflowPolicy
+
+	// UnknownFailedStrategy specifies what to do with failed codes that
+	// are unknown to the SDK.
+	UnknownFailedStrategy FailedStrategy
+	unknownFailed         int
+
+	// pollFailures is the number of failed poll cycles in a row.
+	pollFailures int
+
+	// Tolerant enables the tolerant decoding of responses: unknown fields,
+	// values of unexpected types and updates that cannot be decoded are
+	// logged and skipped instead of failing the poll cycle.
+	Tolerant bool
+
+	// RequestTimeoutExtra is added to Wait to get the timeout of a request
+	// to the longpoll server. Zero means no timeout.
+	RequestTimeoutExtra time.Duration
+
+	mux      sync.Mutex
+	cancel   context.CancelFunc
+	running  bool
+	lastPoll time.Time
+	lastErr  error
+	updates  chan events.GroupEvent
+
+	resume      chan struct{}
+	cancelCheck context.CancelFunc
+
+	// TsStorage specifies an optional storage of ts.
+	TsStorage TsStorage
+
+	// WAL specifies an optional write-ahead log of updates.
+	WAL WAL
+
+	// Metrics specifies an optional receiver of measurements.
+	Metrics Metrics
+
+	// Tracer specifies an optional tracer of poll cycles and events.
+	Tracer Tracer
+
+	// LeaderElector specifies an optional elector of the instance that
+	// polls the server when several replicas of the bot are running.
+	LeaderElector LeaderElector
+
+	// Logger specifies an optional logger of poll cycles, failed codes,
+	// server updates and errors.
+	Logger Logger
+
+	funcFullResponseList  []func(Response)
+	funcFullResponseEList []func(Response) error
+	funcError             func(error) ErrorAction
+	funcPanic             func(events.GroupEvent, *PanicError)
+	funcDeadLetter        func(context.Context, events.GroupEvent, json.RawMessage, error)
+	defaultHandlerPolicy  *HandlerPolicy
+	handlerPolicies       map[events.EventType]HandlerPolicy
+	funcRawList           []func(context.Context, events.GroupEvent, json.RawMessage)
+	goroutines            int
+
+	// shared is the FuncList of the Manager. Its handlers are called after
+	// the handlers of the longpoll.
+	shared *events.FuncList
 
 	events.FuncList
 }
@@ -48,16 +219,21 @@ type LongPoll struct {
 // The LongPoll will use the http.DefaultClient.
 // This means that if the http.DefaultClient is modified by other components
 // of your application the modifications will be picked up by the SDK as well.
-func NewLongPoll(vk *api.VK, groupID int) (*LongPoll, error) {
-	lp := &LongPoll{
-		VK:      vk,
-		GroupID: groupID,
-		Wait:    25,
-		Client:  http.DefaultClient,
+//
+// The default settings can be changed by options:
+//
+//	lp, err := longpoll.NewLongPoll(vk, groupID,
+//		longpoll.WithWait(90),
+//		longpoll.WithGoroutines(8),
+//	)
+func NewLongPoll(vk *api.VK, groupID int, opts ...Option) (*LongPoll, error) {
+	lp := newLongPoll(vk, groupID, opts)
+
+	if lp.fixedServer {
+		return lp, nil
 	}
-	lp.FuncList = *events.NewFuncList()
 
-	err := lp.updateServer(true)
+	err := lp.updateServer(context.Background(), true)
 
 	return lp, err
 }
@@ -67,28 +243,56 @@ func NewLongPoll(vk *api.VK, groupID int) (*LongPoll, error) {
 // The LongPoll will use the http.DefaultClient.
 // This means that if the http.DefaultClient is modified by other components
 // of your application the modifications will be picked up by the SDK as well.
-func NewLongPollCommunity(vk *api.VK) (*LongPoll, error) {
+func NewLongPollCommunity(vk *api.VK, opts ...Option) (*LongPoll, error) {
 	resp, err := vk.GroupsGetByID(nil)
 	if err != nil {
 		return nil, err
 	}
 
+	lp := newLongPoll(vk, resp[0].ID, opts)
+
+	if lp.fixedServer {
+		return lp, nil
+	}
+
+	err = lp.updateServer(context.Background(), true)
+
+	return lp, err
+}
+
+func newLongPoll(vk *api.VK, groupID int, opts []Option) *LongPoll {
 	lp := &LongPoll{
 		VK:      vk,
-		GroupID: resp[0].ID,
+		GroupID: groupID,
 		Wait:    25,
 		Client:  http.DefaultClient,
+		Backoff: Backoff{
+			MaxAttempts: 5,
+			Min:         time.Second,
+			Max:         time.Minute,
+		},
+		RequestTimeoutExtra: 10 * time.Second,
 	}
 	lp.FuncList = *events.NewFuncList()
 
-	err = lp.updateServer(true)
+	for _, opt := range opts {
+		opt(lp)
+	}
 
-	return lp, err
+	return lp
 }
 
-func (lp *LongPoll) updateServer(updateTs bool) error {
+func (lp *LongPoll) updateServer(ctx context.Context, updateTs bool) error {
+	if lp.fixedServer {
+		return ErrFixedServer
+	}
+
 	params := api.Params{
 		"group_id": lp.GroupID,
+	}.WithContext(ctx)
+
+	if lp.Version != "" {
+		params["v"] = lp.Version
 	}
 
 	serverSetting, err := lp.VK.GroupsGetLongPollServer(params)
@@ -103,34 +307,156 @@ func (lp *LongPoll) updateServer(updateTs bool) error {
 		lp.Ts = serverSetting.Ts
 	}
 
+	lp.logf("longpoll: server updated, ts=%s", lp.Ts)
+
 	return nil
 }
 
 func (lp *LongPoll) check(ctx context.Context) (response Response, err error) {
-	u := fmt.Sprintf("%s?act=a_check&key=%s&ts=%s&wait=%d", lp.Server, lp.Key, lp.Ts, lp.Wait)
+	if lp.Metrics != nil {
+		start := time.Now()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return response, err
+		defer func() {
+			lp.Metrics.ObservePoll(time.Since(start), len(response.Updates), err)
+		}()
 	}
 
-	resp, err := lp.Client.Do(req)
+	resp, err := lp.request(ctx)
 	if err != nil {
 		return response, err
 	}
 	defer resp.Body.Close()
 
-	response, err = parseResponse(resp.Body)
+	var warnf func(format string, v ...interface{})
+	if lp.Tolerant {
+		warnf = lp.logf
+	}
+
+	response, err = decodeResponse(resp.Body, len(lp.funcRawList) > 0, warnf)
 	if err != nil {
-		return response, err
+		return response, &DecodeError{Err: err}
 	}
 
-	err = lp.checkResponse(response)
+	err = lp.checkResponse(ctx, response)
 
 	return response, err
 }
 
+// request sends the request to the longpoll server. If the server rejects
+// the key, the server is updated, no more than maxServerUpdates times.
+func (lp *LongPoll) request(ctx context.Context) (*http.Response, error) {
+	for updates := 0; ; updates++ {
+		u := fmt.Sprintf("%s?act=a_check&key=%s&ts=%s&wait=%d", lp.Server, lp.Key, lp.Ts, lp.Wait)
+		if lp.Version != "" {
+			u += "&v=" + url.QueryEscape(lp.Version)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := lp.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden ||
+			updates >= maxServerUpdates {
+			return nil, &StatusError{resp.StatusCode}
+		}
+
+		lp.logf("longpoll: status %d, updating server", resp.StatusCode)
+
+		if err := lp.updateServer(ctx, false); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do sends the request, retrying transport errors according to lp.Backoff.
+func (lp *LongPoll) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := lp.doAttempt(req)
+		if err == nil || attempt >= lp.Backoff.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := lp.Backoff.Delay(attempt)
+		lp.logf("longpoll: attempt %d failed, retry in %v: %v", attempt, delay, err)
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// doAttempt sends the request with the timeout Wait + RequestTimeoutExtra.
+func (lp *LongPoll) doAttempt(req *http.Request) (*http.Response, error) {
+	if lp.RequestTimeoutExtra <= 0 {
+		resp, err := lp.Client.Do(req)
+		if err != nil {
+			return nil, &TransportError{Err: err}
+		}
+
+		return resp, nil
+	}
+
+	timeout := time.Duration(lp.Wait)*time.Second + lp.RequestTimeoutExtra
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+
+	resp, err := lp.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, &TransportError{Err: err}
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelBody cancels the context of the request when the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
 func parseResponse(reader io.Reader) (response Response, err error) {
+	return decodeResponse(reader, false, nil)
+}
+
+// decodeResponse decodes the response. If withRaw is true, the untouched
+// JSON of updates is saved.
+//
+// If warnf is not nil, the response is decoded in tolerant mode: unknown
+// fields, values of unexpected types and updates that cannot be decoded
+// are reported with warnf and skipped instead of failing the decoding.
+func decodeResponse(
+	reader io.Reader,
+	withRaw bool,
+	warnf func(format string, v ...interface{}),
+) (response Response, err error) {
+	if fastDecoding && !withRaw && warnf == nil {
+		return decodeResponseFast(reader)
+	}
+
 	decoder := json.NewDecoder(reader)
 	for decoder.More() {
 		token, err := decoder.Token()
@@ -149,13 +475,35 @@ func parseResponse(reader io.Reader) (response Response, err error) {
 
 		switch t {
 		case "failed":
-			raw, err := decoder.Token()
+			var value interface{}
+
+			err = decoder.Decode(&value)
 			if err != nil {
 				return response, err
 			}
 
-			response.Failed = int(raw.(float64))
+			response.Failed, err = decodeFailed(value, warnf != nil)
+			if err != nil {
+				if warnf == nil {
+					return response, err
+				}
+
+				warnf("longpoll: %v", err)
+			}
 		case "updates":
+			if withRaw || warnf != nil {
+				response.raw, response.Updates, err = decodeUpdates(decoder, warnf)
+				if err != nil {
+					return response, err
+				}
+
+				if !withRaw {
+					response.raw = nil
+				}
+
+				continue
+			}
+
 			var updates []events.GroupEvent
 
 			err = decoder.Decode(&updates)
@@ -167,49 +515,161 @@ func parseResponse(reader io.Reader) (response Response, err error) {
 		case "ts":
 			// can be a number in the response with "failed" field: {"ts":8,"failed":1}
 			// or string, e.g. {"ts":"8","updates":[]}
-			rawTs, err := decoder.Token()
+			var value interface{}
+
+			err = decoder.Decode(&value)
 			if err != nil {
 				return response, err
 			}
 
-			if ts, isNumber := rawTs.(float64); isNumber {
+			switch ts := value.(type) {
+			case float64:
 				response.Ts = strconv.Itoa(int(ts))
-			} else {
-				response.Ts = rawTs.(string)
+			case string:
+				response.Ts = ts
+			default:
+				err = fmt.Errorf("%w of ts: %v", ErrUnexpectedValue, value)
+				if warnf == nil {
+					return response, err
+				}
+
+				warnf("longpoll: %v", err)
+			}
+		default:
+			var value json.RawMessage
+
+			err = decoder.Decode(&value)
+			if err != nil {
+				return response, err
+			}
+
+			if warnf != nil {
+				warnf("longpoll: unknown field %q in response", t)
 			}
 		}
 	}
 
-	return response, err
+	return response, nil
 }
 
-func (lp *LongPoll) checkResponse(response Response) (err error) {
+// decodeFailed decodes the failed code. In tolerant mode the code can be
+// a string.
+func decodeFailed(value interface{}, tolerant bool) (int, error) {
+	switch failed := value.(type) {
+	case float64:
+		return int(failed), nil
+	case string:
+		if code, err := strconv.Atoi(failed); tolerant && err == nil {
+			return code, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w of failed: %v", ErrUnexpectedValue, value)
+}
+
+// decodeUpdates decodes the updates one by one. If warnf is not nil, the
+// updates that cannot be decoded are skipped.
+func decodeUpdates(
+	decoder *json.Decoder,
+	warnf func(format string, v ...interface{}),
+) ([]json.RawMessage, []events.GroupEvent, error) {
+	var raw []json.RawMessage
+
+	err := decoder.Decode(&raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updates := make([]events.GroupEvent, 0, len(raw))
+	decoded := raw[:0]
+
+	for i := range raw {
+		var event events.GroupEvent
+
+		err = json.Unmarshal(raw[i], &event)
+		if err != nil {
+			if warnf == nil {
+				return nil, nil, err
+			}
+
+			warnf("longpoll: skipped update: %v", err)
+
+			continue
+		}
+
+		updates = append(updates, event)
+		decoded = append(decoded, raw[i])
+	}
+
+	return decoded, updates, nil
+}
+
+func (lp *LongPoll) checkResponse(ctx context.Context, response Response) (err error) {
+	if response.Failed != 0 {
+		lp.logf("longpoll: failed code %d", response.Failed)
+
+		if lp.Metrics != nil {
+			lp.Metrics.ObserveFailed(response.Failed)
+		}
+	}
+
 	switch response.Failed {
 	case 0:
 		lp.Ts = response.Ts
+		lp.unknownFailed = 0
 	case 1:
 		lp.Ts = response.Ts
 	case 2:
-		err = lp.updateServer(false)
+		err = lp.updateServer(ctx, false)
 	case 3:
-		err = lp.updateServer(true)
+		err = lp.updateServer(ctx, true)
 	default:
-		err = &Failed{response.Failed}
+		err = lp.handleUnknownFailed(ctx, response.Failed)
 	}
 
 	return
 }
 
+// handleUnknownFailed applies UnknownFailedStrategy to the unknown failed
+// code.
+func (lp *LongPoll) handleUnknownFailed(ctx context.Context, code int) error {
+	lp.unknownFailed++
+
+	switch lp.UnknownFailedStrategy {
+	case FailedStrategyUpdateServer:
+		return lp.updateServer(ctx, true)
+	case FailedStrategyBackoff:
+		return sleep(ctx, lp.Backoff.Delay(lp.unknownFailed))
+	default:
+		return &Failed{code}
+	}
+}
+
 func (lp *LongPoll) autoSetting(ctx context.Context) error {
+	if lp.fixedServer {
+		return nil
+	}
+
 	params := api.Params{
 		"group_id":    lp.GroupID,
 		"enabled":     true,
 		"api_version": vksdk.API,
 	}.WithContext(ctx)
+
+	if lp.Version != "" {
+		params["api_version"] = lp.Version
+	}
+
 	for _, event := range lp.ListEvents() {
 		params[string(event)] = true
 	}
 
+	if lp.shared != nil {
+		for _, event := range lp.shared.ListEvents() {
+			params[string(event)] = true
+		}
+	}
+
 	// Updating LongPoll settings
 	_, err := lp.VK.GroupsSetLongPollSettings(params)
 
@@ -222,47 +682,631 @@ func (lp *LongPoll) Run() error {
 }
 
 // RunWithContext handler.
+//
+// The poll loop stops when ctx is canceled or Shutdown is called. After
+// Shutdown it returns nil, after cancellation of ctx it returns ctx.Err(),
+// so it can be used with errgroup and similar lifecycles.
 func (lp *LongPoll) RunWithContext(ctx context.Context) error {
 	return lp.run(ctx)
 }
 
-func (lp *LongPoll) run(ctx context.Context) error {
-	ctx, lp.cancel = context.WithCancel(ctx)
+func (lp *LongPoll) run(parent context.Context) (err error) {
+	ctx, cancel := context.WithCancel(parent)
+
+	lp.mux.Lock()
+	lp.cancel = cancel
+	lp.running = true
+	lp.mux.Unlock()
+
+	defer func() {
+		cancel()
+
+		lp.mux.Lock()
+		lp.running = false
+
+		if lp.updates != nil {
+			close(lp.updates)
+			lp.updates = nil
+		}
+
+		lp.mux.Unlock()
+
+		if err != nil {
+			lp.setError(err)
+		}
+	}()
 
 	if err := lp.autoSetting(ctx); err != nil {
 		return err
 	}
 
+	if lp.LeaderElector == nil {
+		return lp.lead(ctx, parent)
+	}
+
 	for {
-		select {
-		case _, ok := <-ctx.Done():
-			if !ok {
-				return nil
+		leaderCtx, err := lp.LeaderElector.Campaign(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return parent.Err()
 			}
+
+			return err
+		}
+
+		lp.logf("longpoll: elected as leader")
+
+		err = lp.lead(leaderCtx, parent)
+
+		if err := lp.LeaderElector.Resign(context.Background()); err != nil {
+			lp.logf("longpoll: resign: %v", err)
+		}
+
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+
+		lp.logf("longpoll: leadership lost")
+	}
+}
+
+// lead restores ts, replays unprocessed updates and polls until ctx is
+// done.
+func (lp *LongPoll) lead(ctx, parent context.Context) error {
+	if err := lp.restoreTs(); err != nil {
+		return err
+	}
+
+	if err := lp.replay(ctx); err != nil {
+		return err
+	}
+
+	if lp.goroutines < 1 {
+		return lp.poll(ctx, parent, nil)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pool := newWorkerPool(lp.goroutines, lp.handle, cancel)
+	err := lp.poll(ctx, parent, pool)
+
+	pool.close()
+
+	if poolErr := pool.Err(); poolErr != nil {
+		return poolErr
+	}
+
+	return err
+}
+
+func (lp *LongPoll) poll(ctx, parent context.Context, pool *workerPool) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return parent.Err()
 		default:
-			resp, err := lp.check(ctx)
-			if err != nil {
+			cycleCtx, end := lp.startPoll(ctx)
+			stop, err := lp.cycle(cycleCtx, parent, pool)
+
+			end(err)
+
+			if stop {
+				return err
+			}
+		}
+	}
+}
+
+// cycle requests updates and dispatches them. It returns stop=true if
+// polling must be stopped with the returned error.
+func (lp *LongPoll) cycle(ctx, parent context.Context, pool *workerPool) (bool, error) {
+	checkCtx, cancelCheck, err := lp.checkContext(ctx)
+	if err != nil {
+		return true, parent.Err()
+	}
+
+	resp, err := lp.check(checkCtx)
+	paused := checkCtx.Err() != nil
+
+	cancelCheck()
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return true, parent.Err()
+		}
+
+		// the request was aborted by Pause
+		if paused {
+			return false, nil
+		}
+
+		return lp.pollFailed(ctx, parent, err)
+	}
+
+	lp.pollFailures = 0
+
+	lp.mux.Lock()
+	lp.lastPoll = time.Now()
+	lp.mux.Unlock()
+
+	lp.logf("longpoll: ts=%s updates=%d", resp.Ts, len(resp.Updates))
+
+	eventCtx := context.WithValue(ctx, internal.LongPollTsKey, resp.Ts)
+
+	if err := lp.process(eventCtx, resp, pool); err != nil {
+		return true, err
+	}
+
+	return false, nil
+}
+
+// pollFailed applies OnError to the error of the poll cycle and waits with
+// the delay of Backoff before the next request. ErrorActionRetry stops
+// polling after Backoff.MaxAttempts failures in a row.
+func (lp *LongPoll) pollFailed(ctx, parent context.Context, err error) (bool, error) {
+	action := lp.errorAction(err)
+	if action == ErrorActionStop {
+		return true, err
+	}
+
+	lp.pollFailures++
+
+	if action == ErrorActionRetry && lp.pollFailures >= lp.Backoff.MaxAttempts {
+		return true, err
+	}
+
+	if sleep(ctx, lp.Backoff.Delay(lp.pollFailures)) != nil {
+		return true, parent.Err()
+	}
+
+	return false, err
+}
+
+// startPoll starts the span of the poll cycle.
+func (lp *LongPoll) startPoll(ctx context.Context) (context.Context, func(error)) {
+	if lp.Tracer == nil {
+		return ctx, func(error) {}
+	}
+
+	return lp.Tracer.StartPoll(ctx)
+}
+
+// batches splits the updates of the response by MaxUpdates according to the
+// overflow policy.
+func (lp *LongPoll) batches(resp Response) []Response {
+	n := len(resp.Updates)
+	if lp.MaxUpdates <= 0 || n <= lp.MaxUpdates {
+		return []Response{resp}
+	}
+
+	if lp.Overflow == OverflowDrop {
+		lp.logf("longpoll: dropped %d updates", n-lp.MaxUpdates)
+
+		return []Response{resp.slice(0, lp.MaxUpdates)}
+	}
+
+	batches := make([]Response, 0, (n+lp.MaxUpdates-1)/lp.MaxUpdates)
+
+	for i := 0; i < n; i += lp.MaxUpdates {
+		j := i + lp.MaxUpdates
+		if j > n {
+			j = n
+		}
+
+		batches = append(batches, resp.slice(i, j))
+	}
+
+	return batches
+}
+
+// process handles the updates of the response and completes it.
+func (lp *LongPoll) process(ctx context.Context, resp Response, pool *workerPool) error {
+	if lp.WAL != nil && len(resp.Updates) > 0 {
+		err := lp.WAL.Append(WALEntry{GroupID: lp.GroupID, Ts: resp.Ts, Updates: resp.Updates})
+		if err != nil && lp.errorAction(err) == ErrorActionStop {
+			return err
+		}
+	}
+
+	batches := lp.batches(resp)
+
+	if pool != nil {
+		for i, batch := range batches {
+			done := func() error { return nil }
+			if i == len(batches)-1 {
+				done = func() error { return lp.complete(ctx, resp) }
+			}
+
+			wait := pool.dispatch(ctx, batch, done)
+			if lp.Overflow == OverflowBlock {
+				<-wait
+			}
+		}
+
+		return nil
+	}
+
+	for _, batch := range batches {
+		for i, event := range batch.Updates {
+			if err := lp.handle(ctx, event, batch.rawUpdate(i)); err != nil {
 				return err
 			}
+		}
+	}
 
-			ctx = context.WithValue(ctx, internal.LongPollTsKey, resp.Ts)
+	return lp.complete(ctx, resp)
+}
 
-			for _, event := range resp.Updates {
-				err = lp.Handler(ctx, event)
-				if err != nil {
-					return err
+// checkContext waits while the longpoll is paused and returns the context
+// of the next request, that is canceled by Pause.
+func (lp *LongPoll) checkContext(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	for {
+		lp.mux.Lock()
+		resume := lp.resume
+
+		if resume == nil {
+			checkCtx, cancel := context.WithCancel(ctx)
+			lp.cancelCheck = cancel
+			lp.mux.Unlock()
+
+			return checkCtx, cancel, nil
+		}
+
+		lp.mux.Unlock()
+
+		select {
+		case <-resume:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+func (lp *LongPoll) restoreTs() error {
+	if lp.TsStorage == nil {
+		return nil
+	}
+
+	ts, err := lp.TsStorage.Get(lp.GroupID)
+	if err != nil {
+		return err
+	}
+
+	if ts != "" {
+		lp.Ts = ts
+	}
+
+	return nil
+}
+
+// complete is called after all events of the response are handled.
+func (lp *LongPoll) complete(ctx context.Context, resp Response) error {
+	if lp.TsStorage != nil && resp.Ts != "" {
+		err := lp.TsStorage.Set(lp.GroupID, resp.Ts)
+		if err != nil && lp.errorAction(err) == ErrorActionStop {
+			return err
+		}
+	}
+
+	if lp.WAL != nil && len(resp.Updates) > 0 {
+		err := lp.WAL.Done(lp.GroupID, resp.Ts)
+		if err != nil && lp.errorAction(err) == ErrorActionStop {
+			return err
+		}
+	}
+
+	for _, f := range lp.funcFullResponseList {
+		f(resp)
+	}
+
+	for _, f := range lp.funcFullResponseEList {
+		for attempt := 1; ; attempt++ {
+			err := f(resp)
+			if err == nil {
+				break
+			}
+
+			action := lp.errorAction(err)
+			if action == ErrorActionSkip {
+				break
+			}
+
+			if action == ErrorActionRetry && attempt < lp.Backoff.MaxAttempts {
+				// the response is abandoned on shutdown
+				if sleep(ctx, lp.Backoff.Delay(attempt)) != nil {
+					return nil
 				}
+
+				continue
 			}
 
-			for _, f := range lp.funcFullResponseList {
-				f(resp)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lp *LongPoll) handle(ctx context.Context, event events.GroupEvent, raw json.RawMessage) error {
+	// the event is abandoned on shutdown
+	if !lp.sendUpdate(ctx, event) {
+		return nil
+	}
+
+	policy, hasPolicy := lp.handlerPolicy(event.Type)
+
+	for attempt := 1; ; attempt++ {
+		err := lp.handleEvent(ctx, event, raw)
+		if err == nil {
+			return nil
+		}
+
+		var handlerErr *HandlerError
+
+		isHandlerErr := errors.As(err, &handlerErr)
+		if hasPolicy && isHandlerErr {
+			switch policy.Action {
+			case ErrorActionStop:
+				lp.setError(err)
+				lp.logf("longpoll: %v", err)
+				lp.deadLetter(ctx, event, raw, err)
+
+				return err
+			case ErrorActionSkip:
+				lp.setError(err)
+				lp.logf("longpoll: %v, event skipped", err)
+				lp.deadLetter(ctx, event, raw, err)
+
+				return nil
+			case ErrorActionRetry:
+				if attempt < policy.MaxAttempts {
+					lp.setError(err)
+					lp.logf("longpoll: %v, attempt %d", err, attempt)
+
+					// the event is abandoned on shutdown
+					if sleep(ctx, policy.Delay) != nil {
+						return nil
+					}
+
+					continue
+				}
 			}
 		}
+
+		action := lp.errorAction(err)
+		if action == ErrorActionRetry {
+			maxAttempts := lp.Backoff.MaxAttempts
+			if hasPolicy && policy.Action == ErrorActionRetry {
+				maxAttempts = policy.MaxAttempts
+			}
+
+			if attempt < maxAttempts {
+				// the event is abandoned on shutdown
+				if sleep(ctx, lp.Backoff.Delay(attempt)) != nil {
+					return nil
+				}
+
+				continue
+			}
+		}
+
+		if isHandlerErr {
+			lp.deadLetter(ctx, event, raw, err)
+		}
+
+		if action == ErrorActionSkip {
+			return nil
+		}
+
+		return err
+	}
+}
+
+// sendUpdate sends the event to the channel of Updates, once per event
+// regardless of retries of handlers. It returns false if ctx is done.
+func (lp *LongPoll) sendUpdate(ctx context.Context, event events.GroupEvent) bool {
+	lp.mux.Lock()
+	updates := lp.updates
+	lp.mux.Unlock()
+
+	if updates == nil {
+		return true
+	}
+
+	select {
+	case updates <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// deadLetter passes the event that failed to be handled to the dead-letter
+// handler.
+func (lp *LongPoll) deadLetter(ctx context.Context, event events.GroupEvent, raw json.RawMessage, err error) {
+	if lp.funcDeadLetter != nil {
+		lp.funcDeadLetter(ctx, event, raw, err)
+	}
+}
+
+func (lp *LongPoll) handleEvent(
+	ctx context.Context,
+	event events.GroupEvent,
+	raw json.RawMessage,
+) (err error) {
+	if lp.Metrics != nil {
+		start := time.Now()
+
+		defer func() {
+			lp.Metrics.ObserveHandler(event.Type, time.Since(start), err)
+		}()
+	}
+
+	if lp.Tracer != nil {
+		var end func(error)
+
+		ctx, end = lp.Tracer.StartEvent(ctx, event)
+
+		defer func() {
+			end(err)
+		}()
+	}
+
+	if lp.funcPanic != nil {
+		defer func() {
+			if v := recover(); v != nil {
+				lp.funcPanic(event, &PanicError{
+					Value: v,
+					Stack: debug.Stack(),
+				})
+			}
+		}()
+	}
+
+	if len(lp.funcRawList) > 0 {
+		rawCtx := context.WithValue(ctx, internal.GroupIDKey, event.GroupID)
+		rawCtx = context.WithValue(rawCtx, internal.EventIDKey, event.EventID)
+
+		for _, f := range lp.funcRawList {
+			f(rawCtx, event, raw)
+		}
+	}
+
+	if err := lp.Handler(ctx, event); err != nil {
+		return &HandlerError{Event: event, Err: err}
+	}
+
+	if lp.shared != nil {
+		if err := lp.shared.Handler(ctx, event); err != nil {
+			return &HandlerError{Event: event, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// handlerPolicy returns the policy for handler errors of the event type.
+func (lp *LongPoll) handlerPolicy(eventType events.EventType) (HandlerPolicy, bool) {
+	if policy, ok := lp.handlerPolicies[eventType]; ok {
+		return policy, true
+	}
+
+	if lp.defaultHandlerPolicy != nil {
+		return *lp.defaultHandlerPolicy, true
+	}
+
+	return HandlerPolicy{}, false
+}
+
+func (lp *LongPoll) errorAction(err error) ErrorAction {
+	lp.setError(err)
+	lp.logf("longpoll: %v", err)
+
+	if lp.funcError == nil {
+		return ErrorActionStop
+	}
+
+	return lp.funcError(err)
+}
+
+func (lp *LongPoll) logf(format string, args ...interface{}) {
+	if lp.Logger != nil {
+		lp.Logger.Printf(format, args...)
+	}
+}
+
+func (lp *LongPoll) setError(err error) {
+	lp.mux.Lock()
+	lp.lastErr = err
+	lp.mux.Unlock()
+}
+
+// Pause stops requests to the longpoll server, keeping the server, key and
+// ts. The current request is aborted, its events will be received after
+// Resume.
+func (lp *LongPoll) Pause() {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	if lp.resume != nil {
+		return
+	}
+
+	lp.resume = make(chan struct{})
+
+	if lp.cancelCheck != nil {
+		lp.cancelCheck()
+	}
+}
+
+// Resume resumes requests to the longpoll server after Pause.
+func (lp *LongPoll) Resume() {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	if lp.resume != nil {
+		close(lp.resume)
+		lp.resume = nil
+	}
+}
+
+// IsPaused reports whether the longpoll is paused.
+func (lp *LongPoll) IsPaused() bool {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	return lp.resume != nil
+}
+
+// Updates returns a channel that receives all events, as an alternative
+// to handlers.
+//
+// Must be called before Run. The channel is closed when Run returns.
+// Events are sent synchronously, so the channel must be read, otherwise
+// polling is blocked.
+func (lp *LongPoll) Updates() <-chan events.GroupEvent {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	if lp.updates == nil {
+		lp.updates = make(chan events.GroupEvent)
 	}
+
+	return lp.updates
+}
+
+// IsRunning reports whether the longpoll is running.
+func (lp *LongPoll) IsRunning() bool {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	return lp.running
+}
+
+// LastPollTime returns the time of the last successful request to the
+// longpoll server.
+func (lp *LongPoll) LastPollTime() time.Time {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	return lp.lastPoll
+}
+
+// LastError returns the last error, including errors that did not stop
+// the longpoll.
+func (lp *LongPoll) LastError() error {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
+	return lp.lastErr
 }
 
 // Shutdown gracefully shuts down the longpoll without interrupting any active connections.
 func (lp *LongPoll) Shutdown() {
+	lp.mux.Lock()
+	defer lp.mux.Unlock()
+
 	if lp.cancel != nil {
 		lp.cancel()
 	}
@@ -272,3 +1316,90 @@ func (lp *LongPoll) Shutdown() {
 func (lp *LongPoll) FullResponse(f func(Response)) {
 	lp.funcFullResponseList = append(lp.funcFullResponseList, f)
 }
+
+// FullResponseE handler.
+//
+// The handler is called after FullResponse handlers. The returned error is
+// passed to OnError and stops the longpoll by default.
+func (lp *LongPoll) FullResponseE(f func(Response) error) {
+	lp.funcFullResponseEList = append(lp.funcFullResponseEList, f)
+}
+
+// Goroutines sets the number of goroutines that handle events concurrently.
+//
+// Events of one response may be handled in any order, but FullResponse
+// handlers are called only after all events of the response are handled
+// and in the order of responses, so ts can be saved safely. By default
+// events are handled sequentially.
+func (lp *LongPoll) Goroutines(n int) {
+	lp.goroutines = n
+}
+
+// OnError sets the error handler.
+//
+// The handler is called for every transport or handler error and the
+// returned ErrorAction determines whether Run stops, retries or skips.
+// By default any error stops Run.
+func (lp *LongPoll) OnError(f func(error) ErrorAction) {
+	lp.funcError = f
+}
+
+// SetHandlerPolicy sets the policy for errors returned by handlers of events.
+// If no event types are specified, the policy is applied to all events
+// that have no own policy.
+//
+//	lp.SetHandlerPolicy(longpoll.HandlerPolicy{Action: longpoll.ErrorActionSkip})
+//	lp.SetHandlerPolicy(longpoll.HandlerPolicy{
+//		Action:      longpoll.ErrorActionRetry,
+//		MaxAttempts: 3,
+//		Delay:       time.Second,
+//	}, events.EventMessageNew)
+func (lp *LongPoll) SetHandlerPolicy(policy HandlerPolicy, eventTypes ...events.EventType) {
+	if len(eventTypes) == 0 {
+		lp.defaultHandlerPolicy = &policy
+
+		return
+	}
+
+	if lp.handlerPolicies == nil {
+		lp.handlerPolicies = make(map[events.EventType]HandlerPolicy)
+	}
+
+	for _, eventType := range eventTypes {
+		lp.handlerPolicies[eventType] = policy
+	}
+}
+
+// OnPanic enables recovery of panics in event handlers.
+//
+// The recovered panic is passed to f and the event is skipped, so a panic
+// does not stop the longpoll. Panics of handlers invoked in a goroutine by
+// Goroutine(true) or events.DispatchAsync are passed to f too.
+func (lp *LongPoll) OnPanic(f func(events.GroupEvent, *PanicError)) {
+	lp.funcPanic = f
+
+	lp.FuncList.OnAsyncPanic(func(e events.GroupEvent, v interface{}) {
+		f(e, &PanicError{
+			Value: v,
+			Stack: debug.Stack(),
+		})
+	})
+}
+
+// OnRaw handler.
+//
+// The handler receives every event with the untouched JSON of the update,
+// so it can handle event types or fields that the SDK does not model yet.
+func (lp *LongPoll) OnRaw(f func(context.Context, events.GroupEvent, json.RawMessage)) {
+	lp.funcRawList = append(lp.funcRawList, f)
+}
+
+// OnDeadLetter handler.
+//
+// The handler receives the event that is skipped or stops the longpoll
+// because its handler returned an error after all retries, so the event
+// can be persisted and replayed later. The raw JSON of the update is nil
+// unless raw handlers are set.
+func (lp *LongPoll) OnDeadLetter(f func(ctx context.Context, event events.GroupEvent, raw json.RawMessage, err error)) {
+	lp.funcDeadLetter = f
+}
@@ -2,10 +2,17 @@ package longpoll
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,6 +21,1016 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const GID = 123456
+
+// newTestLongPoll returns LongPoll with a fake longpoll server.
+func newTestLongPoll(t *testing.T, handler http.HandlerFunc) *LongPoll {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	vk := api.NewVK("")
+	vk.Handler = func(method string, params ...api.Params) (api.Response, error) {
+		if method == "groups.getLongPollServer" {
+			return api.Response{
+				Response: []byte(`{"key":"new","server":"` + server.URL + `","ts":"1"}`),
+			}, nil
+		}
+
+		return api.Response{Response: []byte(`1`)}, nil
+	}
+
+	lp := &LongPoll{
+		VK:       vk,
+		Server:   server.URL,
+		Ts:       "1",
+		Wait:     1,
+		Client:   server.Client(),
+		FuncList: *events.NewFuncList(),
+	}
+
+	return lp
+}
+
+func TestLongPoll_RunWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cancel", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+
+		err := lp.RunWithContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("shutdown in-flight", func(t *testing.T) {
+		t.Parallel()
+
+		started := make(chan struct{}, 1)
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			started <- struct{}{}
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second * 5):
+			}
+		})
+		lp.Wait = 25
+
+		go func() {
+			<-started
+			lp.Shutdown()
+		}()
+
+		start := time.Now()
+
+		assert.NoError(t, lp.RunWithContext(context.Background()))
+		assert.Less(t, time.Since(start), time.Second*5)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.RunWithContext(context.Background()))
+	})
+}
+
+func TestLongPoll_RequestTimeoutExtra(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	})
+	lp.Wait = 0
+	lp.RequestTimeoutExtra = time.Millisecond * 100
+
+	start := time.Now()
+	err := lp.Run()
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second*5)
+}
+
+func TestLongPoll_updateServerOnStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("key") != "new" {
+				http.Error(w, "", http.StatusForbidden)
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, "new", lp.Key)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+
+			http.Error(w, "", http.StatusForbidden)
+		})
+
+		assert.Equal(t, &StatusError{http.StatusForbidden}, lp.Run())
+		assert.Equal(t, maxServerUpdates+1, requests)
+	})
+
+	t.Run("unexpected status", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "", http.StatusBadGateway)
+		})
+
+		assert.Equal(t, &StatusError{http.StatusBadGateway}, lp.Run())
+	})
+}
+
+func TestLongPoll_errorTypes(t *testing.T) {
+	t.Parallel()
+
+	f := func(handler http.HandlerFunc, target interface{}) {
+		t.Helper()
+
+		lp := newTestLongPoll(t, handler)
+		assert.ErrorAs(t, lp.Run(), target)
+	}
+
+	var (
+		decodeErr  *DecodeError
+		handlerErr *HandlerError
+		failedErr  *FailedError
+	)
+
+	f(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[}`))
+	}, &decodeErr)
+	f(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"message_new","object":""}]}`))
+	}, &handlerErr)
+	f(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"failed":4}`))
+	}, &failedErr)
+
+	assert.Equal(t, events.EventType(events.EventMessageNew), handlerErr.Event.Type)
+	assert.Equal(t, 4, failedErr.Code)
+
+	var transportErr *TransportError
+
+	lp := newTestLongPoll(t, nil)
+	lp.Server = "http://127.0.0.1:0"
+	assert.ErrorAs(t, lp.Run(), &transportErr)
+}
+
+func TestLongPoll_SetHandlerPolicy(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler error")
+
+	f := func(failures int, setPolicy func(lp *LongPoll)) (int, error) {
+		t.Helper()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+		})
+		setPolicy(lp)
+
+		attempts := 0
+
+		lp.Use(func(next events.Handler) events.Handler {
+			return func(ctx context.Context, e events.GroupEvent) error {
+				attempts++
+				if attempts <= failures {
+					return errHandler
+				}
+
+				return next(ctx, e)
+			}
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		return attempts, lp.Run()
+	}
+
+	attempts, err := f(10, func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionSkip})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts, err = f(2, func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionRetry, MaxAttempts: 3, Delay: time.Millisecond})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	attempts, err = f(10, func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionRetry, MaxAttempts: 2})
+	})
+	assert.ErrorIs(t, err, errHandler)
+	assert.Equal(t, 2, attempts)
+
+	attempts, err = f(10, func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionSkip})
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionStop}, "test")
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionSkip
+		})
+	})
+	assert.ErrorIs(t, err, errHandler)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestLongPoll_OnDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler error")
+
+	f := func(setPolicy func(lp *LongPoll)) (int, []error) {
+		t.Helper()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+		})
+		setPolicy(lp)
+
+		attempts := 0
+
+		lp.Use(func(next events.Handler) events.Handler {
+			return func(ctx context.Context, e events.GroupEvent) error {
+				attempts++
+
+				return errHandler
+			}
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		var errs []error
+
+		lp.OnDeadLetter(func(ctx context.Context, e events.GroupEvent, raw json.RawMessage, err error) {
+			assert.Equal(t, events.EventType("test"), e.Type)
+
+			errs = append(errs, err)
+		})
+
+		_ = lp.Run()
+
+		return attempts, errs
+	}
+
+	attempts, errs := f(func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionRetry, MaxAttempts: 3})
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionSkip
+		})
+	})
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], errHandler)
+
+	attempts, errs = f(func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionSkip})
+	})
+	assert.Equal(t, 1, attempts)
+	assert.Len(t, errs, 1)
+
+	attempts, errs = f(func(lp *LongPoll) {})
+	assert.Equal(t, 1, attempts)
+	assert.Len(t, errs, 1)
+}
+
+func TestLongPoll_UnknownFailedStrategy(t *testing.T) {
+	t.Parallel()
+
+	f := func(strategy FailedStrategy) (int, error) {
+		t.Helper()
+
+		var requests int32
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) <= 2 {
+				_, _ = w.Write([]byte(`{"failed":42}`))
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"ts":"3","updates":[]}`))
+		})
+		lp.UnknownFailedStrategy = strategy
+		lp.Backoff = Backoff{Min: time.Millisecond, Max: time.Millisecond}
+
+		keys := 0
+		handler := lp.VK.Handler
+
+		lp.VK.Handler = func(method string, params ...api.Params) (api.Response, error) {
+			if method == "groups.getLongPollServer" {
+				keys++
+			}
+
+			return handler(method, params...)
+		}
+
+		lp.FullResponse(func(resp Response) {
+			if resp.Failed == 0 {
+				lp.Shutdown()
+			}
+		})
+
+		return keys, lp.Run()
+	}
+
+	_, err := f(FailedStrategyAbort)
+	assert.Equal(t, &Failed{42}, err)
+
+	keys, err := f(FailedStrategyUpdateServer)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, keys)
+
+	keys, err = f(FailedStrategyBackoff)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, keys)
+}
+
+type testLeaderElector struct {
+	mux       sync.Mutex
+	campaigns int
+	resigns   int
+	revoke    context.CancelFunc
+}
+
+func (e *testLeaderElector) Campaign(ctx context.Context) (context.Context, error) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.campaigns++
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.revoke = cancel
+
+	return leaderCtx, nil
+}
+
+func (e *testLeaderElector) Resign(ctx context.Context) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.resigns++
+
+	return nil
+}
+
+func TestLongPoll_LeaderElector(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		ts := atomic.AddInt32(&requests, 1) + 1
+		_, _ = w.Write([]byte(`{"ts":"` + strconv.Itoa(int(ts)) + `","updates":[]}`))
+	})
+	storage := &memoryTsStorage{ts: map[int]string{}}
+	elector := &testLeaderElector{}
+
+	lp.TsStorage = storage
+	lp.LeaderElector = elector
+
+	lp.FullResponse(func(resp Response) {
+		elector.mux.Lock()
+		defer elector.mux.Unlock()
+
+		if elector.campaigns < 3 {
+			elector.revoke()
+		} else {
+			lp.Shutdown()
+		}
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, 3, elector.campaigns)
+	assert.Equal(t, 3, elector.resigns)
+	assert.Equal(t, "4", lp.Ts)
+}
+
+func TestLongPoll_OnError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retry", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				_, _ = w.Write([]byte(`{"failed":4}`))
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+
+		lp.Backoff = Backoff{MaxAttempts: 2}
+
+		var errs []error
+
+		lp.OnError(func(err error) ErrorAction {
+			errs = append(errs, err)
+			return ErrorActionRetry
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, []error{&Failed{4}}, errs)
+	})
+
+	t.Run("retries stop", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int32
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		lp.Backoff = Backoff{MaxAttempts: 3, Min: time.Millisecond, Max: time.Millisecond}
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionRetry
+		})
+
+		assert.Equal(t, &StatusError{http.StatusInternalServerError}, lp.Run())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("skip backoff", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int32
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		lp.Backoff = Backoff{Min: time.Second, Max: time.Second}
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionSkip
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, lp.RunWithContext(ctx), context.DeadlineExceeded)
+		assert.Less(t, atomic.LoadInt32(&requests), int32(20))
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"message_new","object":""}]}`))
+		})
+
+		var skipped int
+
+		lp.OnError(func(err error) ErrorAction {
+			skipped++
+			return ErrorActionSkip
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, 1, skipped)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"failed":4}`))
+		})
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionStop
+		})
+
+		assert.Error(t, lp.Run())
+	})
+}
+
+func TestLongPoll_Goroutines(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		var requests int32
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			ts := atomic.AddInt32(&requests, 1) + 1
+			_, _ = w.Write([]byte(`{"ts":"` + strconv.Itoa(int(ts)) +
+				`","updates":[{"type":"test","object":{}},{"type":"test","object":{}}]}`))
+		})
+		lp.Goroutines(2)
+
+		var (
+			handled int32
+			mux     sync.Mutex
+			tsList  []string
+		)
+
+		lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+			time.Sleep(time.Millisecond * 10)
+			atomic.AddInt32(&handled, 1)
+		})
+		lp.FullResponse(func(resp Response) {
+			mux.Lock()
+			defer mux.Unlock()
+
+			tsList = append(tsList, resp.Ts)
+			if len(tsList) == 3 {
+				lp.Shutdown()
+			}
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, []string{"2", "3", "4"}, tsList[:3])
+		assert.Equal(t, int32(len(tsList)*2), atomic.LoadInt32(&handled))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"message_new","object":""}]}`))
+		})
+		lp.Goroutines(2)
+
+		assert.Error(t, lp.Run())
+	})
+}
+
+func TestLongPoll_MaxUpdates(t *testing.T) {
+	t.Parallel()
+
+	f := func(goroutines int, policy OverflowPolicy, expected int32) {
+		t.Helper()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[` + strings.Repeat(`{"type":"test","object":{}},`, 4) +
+				`{"type":"test","object":{}}]}`))
+		})
+		lp.Goroutines(goroutines)
+		lp.MaxUpdates = 2
+		lp.Overflow = policy
+
+		var handled int32
+
+		lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+			atomic.AddInt32(&handled, 1)
+		})
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, "2", lp.Ts)
+		assert.Equal(t, expected, atomic.LoadInt32(&handled))
+	}
+
+	f(0, OverflowQueue, 5)
+	f(0, OverflowDrop, 2)
+	f(0, OverflowBlock, 5)
+	f(2, OverflowQueue, 5)
+	f(2, OverflowDrop, 2)
+	f(2, OverflowBlock, 5)
+}
+
+func TestLongPoll_OnPanic(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}},{"type":"test","object":{}}]}`))
+	})
+
+	var (
+		handled int
+		panics  []*PanicError
+	)
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled++
+		if handled == 1 {
+			panic("test")
+		}
+	})
+	lp.OnPanic(func(e events.GroupEvent, err *PanicError) {
+		assert.Equal(t, events.EventType("test"), e.Type)
+		panics = append(panics, err)
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, 2, handled)
+
+	if assert.Len(t, panics, 1) {
+		assert.Equal(t, "test", panics[0].Value)
+		assert.NotEmpty(t, panics[0].Stack)
+	}
+}
+
+func TestLongPoll_OnPanic_goroutine(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+	})
+	lp.Goroutine(true)
+
+	panics := make(chan *PanicError, 1)
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		panic("test")
+	})
+	lp.OnPanic(func(e events.GroupEvent, err *PanicError) {
+		assert.Equal(t, events.EventType("test"), e.Type)
+		panics <- err
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+
+	err := <-panics
+	assert.Equal(t, "test", err.Value)
+	assert.NotEmpty(t, err.Stack)
+}
+
+type testMetrics struct {
+	mux      sync.Mutex
+	polls    []int
+	failed   []int
+	handlers []events.EventType
+	errs     int
+}
+
+func (m *testMetrics) ObservePoll(d time.Duration, updates int, err error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.polls = append(m.polls, updates)
+}
+
+func (m *testMetrics) ObserveFailed(code int) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.failed = append(m.failed, code)
+}
+
+func (m *testMetrics) ObserveHandler(eventType events.EventType, d time.Duration, err error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.handlers = append(m.handlers, eventType)
+
+	if err != nil {
+		m.errs++
+	}
+}
+
+func TestLongPoll_Metrics(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			_, _ = w.Write([]byte(`{"ts":3,"failed":1}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ts":"4","updates":[{"type":"test","object":{}},{"type":"message_new","object":""}]}`))
+	})
+
+	metrics := &testMetrics{}
+	lp.Metrics = metrics
+
+	lp.OnError(func(err error) ErrorAction {
+		return ErrorActionSkip
+	})
+	lp.FullResponse(func(resp Response) {
+		if resp.Ts == "4" {
+			lp.Shutdown()
+		}
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, []int{0, 2}, metrics.polls)
+	assert.Equal(t, []int{1}, metrics.failed)
+	assert.Equal(t, []events.EventType{"test", events.EventMessageNew}, metrics.handlers)
+	assert.Equal(t, 1, metrics.errs)
+}
+
+func TestLongPoll_OnRaw(t *testing.T) {
+	t.Parallel()
+
+	const update = `{"type":"unknown_event","group_id":1,"event_id":"abc","object":{"new_field":1}}`
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[` + update + `]}`))
+	})
+
+	var raws []string
+
+	lp.OnRaw(func(ctx context.Context, e events.GroupEvent, raw json.RawMessage) {
+		assert.Equal(t, events.EventType("unknown_event"), e.Type)
+		assert.Equal(t, 1, events.GroupIDFromContext(ctx))
+		assert.Equal(t, "abc", events.EventIDFromContext(ctx))
+
+		raws = append(raws, string(raw))
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, []string{update}, raws)
+}
+
+func TestLongPoll_State(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			_, _ = w.Write([]byte(`{"failed":4}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+	})
+
+	assert.False(t, lp.IsRunning())
+	assert.True(t, lp.LastPollTime().IsZero())
+	assert.NoError(t, lp.LastError())
+
+	lp.OnError(func(err error) ErrorAction {
+		return ErrorActionSkip
+	})
+	lp.FullResponse(func(resp Response) {
+		assert.True(t, lp.IsRunning())
+		assert.False(t, lp.LastPollTime().IsZero())
+		assert.Equal(t, &Failed{4}, lp.LastError())
+
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.False(t, lp.IsRunning())
+}
+
+func TestLongPoll_Updates(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}},{"type":"test2","object":{}}]}`))
+	})
+
+	updates := lp.Updates()
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- lp.Run()
+	}()
+
+	var types []events.EventType
+
+	for e := range updates {
+		types = append(types, e.Type)
+		if len(types) == 2 {
+			lp.Shutdown()
+		}
+	}
+
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, []events.EventType{"test", "test2"}, types)
+}
+
+func TestLongPoll_Updates_retry(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+	})
+	lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionRetry, MaxAttempts: 3})
+
+	var attempts int32
+
+	lp.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				return errors.New("handler")
+			}
+
+			return next(ctx, e)
+		}
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	updates := lp.Updates()
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- lp.Run()
+	}()
+
+	var types []events.EventType
+
+	for e := range updates {
+		types = append(types, e.Type)
+	}
+
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.Equal(t, []events.EventType{"test"}, types)
+}
+
+func TestLongPoll_Logger(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			_, _ = w.Write([]byte(`{"ts":3,"failed":1}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ts":"4","updates":[]}`))
+	})
+
+	var buf strings.Builder
+
+	lp.Logger = log.New(&buf, "", 0)
+	lp.FullResponse(func(resp Response) {
+		if resp.Ts == "4" {
+			lp.Shutdown()
+		}
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, "longpoll: failed code 1\n"+
+		"longpoll: ts=3 updates=0\n"+
+		"longpoll: ts=4 updates=0\n", buf.String())
+}
+
+func TestLongPoll_Pause(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requests int32
+		started  = make(chan struct{}, 1)
+	)
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			started <- struct{}{}
+			<-r.Context().Done()
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- lp.Run()
+	}()
+
+	<-started
+	lp.Pause()
+	assert.True(t, lp.IsPaused())
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	assert.Equal(t, "1", lp.Ts)
+
+	lp.Resume()
+	assert.False(t, lp.IsPaused())
+
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+type memoryTsStorage struct {
+	ts     map[int]string
+	getErr error
+	setErr error
+}
+
+func (s *memoryTsStorage) Get(groupID int) (string, error) {
+	return s.ts[groupID], s.getErr
+}
+
+func (s *memoryTsStorage) Set(groupID int, ts string) error {
+	s.ts[groupID] = ts
+
+	return s.setErr
+}
+
+func TestLongPoll_TsStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		var gotTs string
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			gotTs = r.URL.Query().Get("ts")
+			_, _ = w.Write([]byte(`{"ts":"43","updates":[]}`))
+		})
+		lp.GroupID = GID
+		storage := &memoryTsStorage{ts: map[int]string{GID: "42"}}
+		lp.TsStorage = storage
+		lp.FullResponse(func(resp Response) {
+			lp.Shutdown()
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, "42", gotTs)
+		assert.Equal(t, "43", storage.ts[GID])
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {})
+		lp.TsStorage = &memoryTsStorage{getErr: errors.New("get")}
+
+		assert.EqualError(t, lp.Run(), "get")
+	})
+
+	t.Run("set error", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"43","updates":[]}`))
+		})
+		lp.TsStorage = &memoryTsStorage{ts: map[int]string{}, setErr: errors.New("set")}
+
+		assert.EqualError(t, lp.Run(), "set")
+	})
+}
+
 func TestLongPoll_Shutdown(t *testing.T) {
 	t.Parallel()
 
@@ -66,6 +1083,128 @@ func TestLongPoll_Shutdown(t *testing.T) {
 	}
 }
 
+func TestLongPoll_FullResponseE(t *testing.T) {
+	t.Parallel()
+
+	errSave := errors.New("save error")
+
+	t.Run("stop", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+		lp.FullResponseE(func(resp Response) error {
+			return errSave
+		})
+
+		assert.ErrorIs(t, lp.Run(), errSave)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+
+		lp.Backoff = Backoff{MaxAttempts: 2}
+
+		calls := 0
+
+		lp.FullResponseE(func(resp Response) error {
+			calls++
+			if calls == 1 {
+				return errSave
+			}
+
+			lp.Shutdown()
+
+			return nil
+		})
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionRetry
+		})
+
+		assert.NoError(t, lp.Run())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("retries stop", func(t *testing.T) {
+		t.Parallel()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+		})
+		lp.Backoff = Backoff{MaxAttempts: 3, Min: time.Millisecond, Max: time.Millisecond}
+
+		calls := 0
+
+		lp.FullResponseE(func(resp Response) error {
+			calls++
+
+			return errSave
+		})
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionRetry
+		})
+
+		assert.ErrorIs(t, lp.Run(), errSave)
+		assert.Equal(t, 3, calls)
+	})
+}
+
+func TestLongPoll_OnError_handlerRetry(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler error")
+
+	f := func(setPolicy func(lp *LongPoll)) (int, []error, error) {
+		t.Helper()
+
+		lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+		})
+		lp.Backoff = Backoff{MaxAttempts: 4, Min: time.Millisecond, Max: time.Millisecond}
+		setPolicy(lp)
+
+		attempts := 0
+
+		lp.Use(func(next events.Handler) events.Handler {
+			return func(ctx context.Context, e events.GroupEvent) error {
+				attempts++
+
+				return errHandler
+			}
+		})
+		lp.OnError(func(err error) ErrorAction {
+			return ErrorActionRetry
+		})
+
+		var deadLetters []error
+
+		lp.OnDeadLetter(func(ctx context.Context, e events.GroupEvent, raw json.RawMessage, err error) {
+			deadLetters = append(deadLetters, err)
+		})
+
+		err := lp.Run()
+
+		return attempts, deadLetters, err
+	}
+
+	attempts, deadLetters, err := f(func(lp *LongPoll) {})
+	assert.ErrorIs(t, err, errHandler)
+	assert.Equal(t, 4, attempts)
+	assert.Len(t, deadLetters, 1)
+
+	attempts, deadLetters, err = f(func(lp *LongPoll) {
+		lp.SetHandlerPolicy(HandlerPolicy{Action: ErrorActionRetry, MaxAttempts: 2})
+	})
+	assert.ErrorIs(t, err, errHandler)
+	assert.Equal(t, 2, attempts)
+	assert.Len(t, deadLetters, 1)
+}
+
 func TestLongPoll_Handler(t *testing.T) {
 	t.Parallel()
 	// nolint:gocyclo
@@ -100,6 +1239,45 @@ func TestNewLongPoll(t *testing.T) {
 	f(api.NewVK(groupToken), groupID, false)
 }
 
+func TestLongPoll_Version(t *testing.T) {
+	t.Parallel()
+
+	var checkVersion string
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		checkVersion = r.URL.Query().Get("v")
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[]}`))
+	})
+	WithVersion("5.103")(lp)
+
+	var serverVersion, eventsVersion string
+
+	handler := lp.VK.Handler
+	lp.VK.Handler = func(method string, params ...api.Params) (api.Response, error) {
+		for _, p := range params {
+			if v, ok := p["v"]; ok {
+				serverVersion = v.(string)
+			}
+
+			if v, ok := p["api_version"]; ok {
+				eventsVersion = v.(string)
+			}
+		}
+
+		return handler(method, params...)
+	}
+
+	assert.NoError(t, lp.updateServer(context.Background(), true))
+	assert.Equal(t, "5.103", serverVersion)
+
+	assert.NoError(t, lp.autoSetting(context.Background()))
+	assert.Equal(t, "5.103", eventsVersion)
+
+	_, err := lp.check(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "5.103", checkVersion)
+}
+
 func TestNewLongPollCommunity(t *testing.T) {
 	t.Parallel()
 
@@ -175,7 +1353,7 @@ func TestLongPoll_checkResponse(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			if err := lp.checkResponse(tt.argResponse); (err != nil) != tt.wantErr {
+			if err := lp.checkResponse(context.Background(), tt.argResponse); (err != nil) != tt.wantErr {
 				t.Errorf("LongPoll.checkResponse() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -198,6 +1376,28 @@ func TestLongPoll_checkResponse(t *testing.T) {
 // 	})
 // }
 
+func TestDecodeResponse_tolerant(t *testing.T) {
+	t.Parallel()
+
+	const response = `{"ts":null,"failed":"2","extra":[1],"updates":[{"type":"test","object":{}},{"type":1}]}`
+
+	_, err := decodeResponse(strings.NewReader(response), false, nil)
+	assert.ErrorIs(t, err, ErrUnexpectedValue)
+
+	var warnings []string
+
+	actual, err := decodeResponse(strings.NewReader(response), true, func(format string, v ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, v...))
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", actual.Ts)
+	assert.Equal(t, 2, actual.Failed)
+	assert.Len(t, actual.Updates, 1)
+	assert.Len(t, actual.raw, 1)
+	assert.Equal(t, events.EventType("test"), actual.Updates[0].Type)
+	assert.Len(t, warnings, 3)
+}
+
 func TestLongPoll_RunError(t *testing.T) {
 	t.Parallel()
 
@@ -251,6 +1451,14 @@ func TestParseResponse(t *testing.T) {
 				Updates: []events.GroupEvent{},
 			},
 		},
+		{
+			name:     "unknown field",
+			response: `{"extra":{"ts":"1","failed":3},"ts":"8","updates":[]}`,
+			expected: Response{
+				Ts:      "8",
+				Updates: []events.GroupEvent{},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -267,3 +1475,29 @@ func TestParseResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseResponse_error(t *testing.T) {
+	t.Parallel()
+
+	f := func(response string) {
+		t.Helper()
+
+		_, err := parseResponse(strings.NewReader(response))
+		assert.Error(t, err)
+	}
+
+	f(`{"ts":true,"updates":[]}`)
+	f(`{"ts":"8","failed":"1"}`)
+	f(`{"ts":"8","updates":{}}`)
+	f(`{"ts":"8","updates":[{"type":1}]}`)
+}
+
+func BenchmarkParseResponse(b *testing.B) {
+	response := `{"ts":"8","updates":[` +
+		`{"type":"message_new","object":{"message":{"id":1,"peer_id":1,"text":"hello"},"client_info":{}},` +
+		`"group_id":1,"event_id":"abc","v":"5.131"}]}`
+
+	for i := 0; i < b.N; i++ {
+		_, _ = parseResponse(strings.NewReader(response))
+	}
+}
@@ -0,0 +1,93 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+)
+
+// Manager runs longpolls of several communities concurrently with shared
+// handlers.
+//
+// Handlers are registered on the Manager and are called after the handlers
+// of each added longpoll, which keep their own handlers, middlewares and
+// JSON codec. Handlers must be registered before Run, so that their event
+// types are enabled in the settings of the communities. The community of
+// the event can be obtained by events.GroupIDFromContext.
+type Manager struct {
+	longPolls []*LongPoll
+
+	events.FuncList
+}
+
+// NewManager returns a new Manager.
+func NewManager(longPolls ...*LongPoll) *Manager {
+	m := &Manager{
+		FuncList: *events.NewFuncList(),
+	}
+	m.Add(longPolls...)
+
+	return m
+}
+
+// Add adds longpolls to the Manager. Must be called before Run.
+func (m *Manager) Add(longPolls ...*LongPoll) {
+	for _, lp := range longPolls {
+		lp.shared = &m.FuncList
+	}
+
+	m.longPolls = append(m.longPolls, longPolls...)
+}
+
+// LongPolls returns the added longpolls.
+func (m *Manager) LongPolls() []*LongPoll {
+	return m.longPolls
+}
+
+// Run handler.
+func (m *Manager) Run() error {
+	return m.RunWithContext(context.Background())
+}
+
+// RunWithContext runs all longpolls and waits for them to stop.
+//
+// If one of the longpolls returns an error, the others are stopped and
+// the error is returned.
+func (m *Manager) RunWithContext(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	wg.Add(len(m.longPolls))
+
+	for _, lp := range m.longPolls {
+		go func(lp *LongPoll) {
+			defer wg.Done()
+
+			if err := lp.RunWithContext(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+
+					cancel()
+				})
+			}
+		}(lp)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+// Shutdown gracefully shuts down all longpolls.
+func (m *Manager) Shutdown() {
+	for _, lp := range m.longPolls {
+		lp.Shutdown()
+	}
+}
@@ -0,0 +1,115 @@
+package longpoll
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_Run(t *testing.T) {
+	t.Parallel()
+
+	handler := func(groupID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","group_id":` + groupID + `,"object":{}}]}`))
+		}
+	}
+
+	lp1 := newTestLongPoll(t, handler("1"))
+	lp2 := newTestLongPoll(t, handler("2"))
+
+	m := NewManager(lp1)
+	m.Add(lp2)
+	assert.Len(t, m.LongPolls(), 2)
+
+	var (
+		mux    sync.Mutex
+		groups = make(map[int]bool)
+	)
+
+	m.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		mux.Lock()
+		defer mux.Unlock()
+
+		groups[events.GroupIDFromContext(ctx)] = true
+		if len(groups) == 2 {
+			m.Shutdown()
+		}
+	})
+
+	assert.NoError(t, m.Run())
+	assert.Equal(t, map[int]bool{1: true, 2: true}, groups)
+}
+
+func TestManager_FuncList(t *testing.T) {
+	t.Parallel()
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+	})
+
+	var handled []string
+
+	lp.Use(func(next events.Handler) events.Handler {
+		return func(ctx context.Context, e events.GroupEvent) error {
+			handled = append(handled, "middleware")
+
+			return next(ctx, e)
+		}
+	})
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled = append(handled, "longpoll")
+	})
+
+	m := NewManager(lp)
+	m.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled = append(handled, "manager")
+
+		m.Shutdown()
+	})
+
+	assert.NoError(t, m.Run())
+	assert.Equal(t, []string{"middleware", "longpoll", "manager"}, handled)
+	assert.Equal(t, []events.EventType{"test"}, lp.ListEvents())
+
+	m.MessageNew(func(ctx context.Context, obj events.MessageNewObject) {})
+
+	settings := api.Params{}
+
+	vkHandler := lp.VK.Handler
+	lp.VK.Handler = func(method string, params ...api.Params) (api.Response, error) {
+		if method == "groups.setLongPollSettings" {
+			for _, p := range params {
+				for k, v := range p {
+					settings[k] = v
+				}
+			}
+		}
+
+		return vkHandler(method, params...)
+	}
+
+	assert.NoError(t, lp.autoSetting(context.Background()))
+	assert.Equal(t, true, settings["test"])
+	assert.Equal(t, true, settings[string(events.EventMessageNew)])
+}
+
+func TestManager_RunError(t *testing.T) {
+	t.Parallel()
+
+	lp1 := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	lp2 := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"failed":4}`))
+	})
+
+	m := NewManager(lp1, lp2)
+
+	assert.Equal(t, &Failed{4}, m.Run())
+}
@@ -0,0 +1,37 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"context"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+)
+
+// Metrics receives measurements of the longpoll, so they can be exported
+// to a monitoring system.
+//
+// Methods can be called from several goroutines.
+type Metrics interface {
+	// ObservePoll is called after each request to the longpoll server
+	// with the request duration, the number of updates and the error.
+	ObservePoll(d time.Duration, updates int, err error)
+	// ObserveFailed is called for each response with the failed field.
+	ObserveFailed(code int)
+	// ObserveHandler is called after handling of each event.
+	ObserveHandler(eventType events.EventType, d time.Duration, err error)
+}
+
+// Tracer starts spans of poll cycles and handled events, so they can be
+// exported to a tracing system.
+//
+// Methods can be called from several goroutines.
+type Tracer interface {
+	// StartPoll is called before each poll cycle. The returned context is
+	// the parent of contexts of events from the cycle. The end function is
+	// called after updates are dispatched to handlers.
+	StartPoll(ctx context.Context) (context.Context, func(err error))
+	// StartEvent is called before handling of each event. The returned
+	// context is passed to handlers. The end function is called after
+	// the event is handled.
+	StartEvent(ctx context.Context, event events.GroupEvent) (context.Context, func(err error))
+}
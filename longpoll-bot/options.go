@@ -0,0 +1,164 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/events"
+)
+
+// Option configures LongPoll.
+type Option func(*LongPoll)
+
+// WithServer sets the longpoll server, key and ts instead of getting them
+// with groups.getLongPollServer, and disables the automatic setting of
+// the longpoll. It allows to run the longpoll against a fake server in
+// tests without requests to VK API:
+//
+//	server := httptest.NewServer(handler)
+//	lp, _ := longpoll.NewLongPoll(api.NewVK(""), groupID,
+//		longpoll.WithServer(server.URL, "key", "1"),
+//	)
+//
+// If the server requires to update the key, ErrFixedServer is returned.
+func WithServer(server, key, ts string) Option {
+	return func(lp *LongPoll) {
+		lp.Server = server
+		lp.Key = key
+		lp.Ts = ts
+		lp.fixedServer = true
+	}
+}
+
+// WithWait sets the maximum waiting time in seconds. Default 25.
+func WithWait(wait int) Option {
+	return func(lp *LongPoll) {
+		lp.Wait = wait
+	}
+}
+
+// WithVersion sets the version of Bots Long Poll API.
+func WithVersion(version string) Option {
+	return func(lp *LongPoll) {
+		lp.Version = version
+	}
+}
+
+// WithClient sets the HTTP client for requests to the longpoll server.
+func WithClient(client *http.Client) Option {
+	return func(lp *LongPoll) {
+		lp.Client = client
+	}
+}
+
+// WithProxy sends requests to the longpoll server through the HTTP, HTTPS
+// or SOCKS5 proxy. It is applied to the client set before it:
+//
+//	u, _ := api.ParseProxyURL("socks5://127.0.0.1:9050")
+//	lp, err := longpoll.NewLongPoll(vk, groupID, longpoll.WithProxy(u))
+//
+// Requests to VK API use the proxy of VK, see api.VK.SetProxy.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(lp *LongPoll) {
+		lp.Client = api.ProxyClient(lp.Client, proxyURL)
+	}
+}
+
+// WithRequestTimeoutExtra sets the margin that is added to Wait to get the
+// timeout of a request to the longpoll server. Default 10 seconds.
+func WithRequestTimeoutExtra(d time.Duration) Option {
+	return func(lp *LongPoll) {
+		lp.RequestTimeoutExtra = d
+	}
+}
+
+// WithBackoff sets the retry settings of failed requests.
+func WithBackoff(backoff Backoff) Option {
+	return func(lp *LongPoll) {
+		lp.Backoff = backoff
+	}
+}
+
+// WithGoroutines sets the number of goroutines that handle events.
+func WithGoroutines(n int) Option {
+	return func(lp *LongPoll) {
+		lp.Goroutines(n)
+	}
+}
+
+// WithTsStorage sets the storage of ts.
+func WithTsStorage(storage TsStorage) Option {
+	return func(lp *LongPoll) {
+		lp.TsStorage = storage
+	}
+}
+
+// WithMetrics sets the receiver of measurements.
+func WithMetrics(metrics Metrics) Option {
+	return func(lp *LongPoll) {
+		lp.Metrics = metrics
+	}
+}
+
+// WithLogger sets the logger of internal behavior.
+func WithLogger(logger Logger) Option {
+	return func(lp *LongPoll) {
+		lp.Logger = logger
+	}
+}
+
+// WithMaxUpdates limits the number of updates from one response that are
+// handled at once and sets the policy for the updates beyond the limit.
+func WithMaxUpdates(n int, policy OverflowPolicy) Option {
+	return func(lp *LongPoll) {
+		lp.MaxUpdates = n
+		lp.Overflow = policy
+	}
+}
+
+// WithHandlerPolicy sets the policy for errors returned by handlers of all
+// events.
+func WithHandlerPolicy(policy HandlerPolicy) Option {
+	return func(lp *LongPoll) {
+		lp.SetHandlerPolicy(policy)
+	}
+}
+
+// WithTolerantDecoding enables the tolerant decoding of responses.
+func WithTolerantDecoding() Option {
+	return func(lp *LongPoll) {
+		lp.Tolerant = true
+	}
+}
+
+// WithUnknownFailedStrategy sets the strategy for failed codes that are
+// unknown to the SDK.
+func WithUnknownFailedStrategy(strategy FailedStrategy) Option {
+	return func(lp *LongPoll) {
+		lp.UnknownFailedStrategy = strategy
+	}
+}
+
+// WithLeaderElector sets the elector of the instance that polls the server.
+func WithLeaderElector(elector LeaderElector) Option {
+	return func(lp *LongPoll) {
+		lp.LeaderElector = elector
+	}
+}
+
+// WithWAL sets the write-ahead log of updates.
+func WithWAL(wal WAL) Option {
+	return func(lp *LongPoll) {
+		lp.WAL = wal
+	}
+}
+
+// WithJSONCodec sets the codec of objects of events, for example,
+// jsoniter.ConfigCompatibleWithStandardLibrary.
+func WithJSONCodec(c events.JSONCodec) Option {
+	return func(lp *LongPoll) {
+		lp.SetJSONCodec(c)
+	}
+}
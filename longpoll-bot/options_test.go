@@ -0,0 +1,173 @@
+package longpoll
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/apitest"
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLongPoll_options(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{}
+	backoff := Backoff{MaxAttempts: 2, Min: time.Second, Max: time.Second}
+	storage := &memoryTsStorage{}
+	metrics := &testMetrics{}
+	logger := log.New(io.Discard, "", 0)
+
+	lp := newLongPoll(api.NewVK(""), GID, []Option{
+		WithWait(90),
+		WithClient(client),
+		WithRequestTimeoutExtra(time.Second),
+		WithBackoff(backoff),
+		WithGoroutines(4),
+		WithTsStorage(storage),
+		WithMetrics(metrics),
+		WithLogger(logger),
+		WithMaxUpdates(10, OverflowDrop),
+		WithHandlerPolicy(HandlerPolicy{Action: ErrorActionSkip}),
+		WithTolerantDecoding(),
+		WithUnknownFailedStrategy(FailedStrategyBackoff),
+		WithLeaderElector(&testLeaderElector{}),
+	})
+
+	assert.Equal(t, GID, lp.GroupID)
+	assert.Equal(t, 90, lp.Wait)
+	assert.Equal(t, client, lp.Client)
+	assert.Equal(t, time.Second, lp.RequestTimeoutExtra)
+	assert.Equal(t, backoff, lp.Backoff)
+	assert.Equal(t, 4, lp.goroutines)
+	assert.Equal(t, storage, lp.TsStorage)
+	assert.Equal(t, metrics, lp.Metrics)
+	assert.Equal(t, logger, lp.Logger)
+	assert.Equal(t, 10, lp.MaxUpdates)
+	assert.Equal(t, OverflowDrop, lp.Overflow)
+	assert.Equal(t, &HandlerPolicy{Action: ErrorActionSkip}, lp.defaultHandlerPolicy)
+	assert.True(t, lp.Tolerant)
+	assert.Equal(t, FailedStrategyBackoff, lp.UnknownFailedStrategy)
+	assert.Equal(t, &testLeaderElector{}, lp.LeaderElector)
+}
+
+func TestWithProxy(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{Timeout: time.Minute}
+	proxyURL, _ := url.Parse("http://127.0.0.1:8080")
+
+	lp := newLongPoll(api.NewVK(""), GID, []Option{
+		WithClient(client),
+		WithProxy(proxyURL),
+	})
+
+	assert.NotSame(t, client, lp.Client)
+	assert.Equal(t, time.Minute, lp.Client.Timeout)
+
+	transport, ok := lp.Client.Transport.(*http.Transport)
+	if assert.True(t, ok) {
+		u, err := transport.Proxy(&http.Request{})
+		assert.NoError(t, err)
+		assert.Equal(t, proxyURL, u)
+	}
+}
+
+func TestWithServer(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ts") == "1" {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{}}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"failed":2}`))
+	}))
+	defer server.Close()
+
+	lp, err := NewLongPoll(api.NewVK(""), GID,
+		WithServer(server.URL, "key", "1"),
+		WithClient(server.Client()),
+	)
+	assert.NoError(t, err)
+
+	var handled int
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled++
+	})
+
+	assert.ErrorIs(t, lp.Run(), ErrFixedServer)
+	assert.Equal(t, 1, handled)
+}
+
+func TestNewLongPollCommunity_withServer(t *testing.T) {
+	t.Parallel()
+
+	fake := apitest.New()
+	fake.On("groups.getById", api.GroupsGetByIDResponse{{ID: GID}})
+
+	lp, err := NewLongPollCommunity(fake.VK(), WithServer("https://lp.vk.com/wh1", "key", "1"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, GID, lp.GroupID)
+	assert.Equal(t, "https://lp.vk.com/wh1", lp.Server)
+	assert.Equal(t, "key", lp.Key)
+	assert.Equal(t, "1", lp.Ts)
+	assert.Empty(t, fake.Calls("groups.getLongPollServer"))
+}
+
+func TestNewLongPoll_defaults(t *testing.T) {
+	t.Parallel()
+
+	lp := newLongPoll(api.NewVK(""), GID, nil)
+
+	assert.Equal(t, 25, lp.Wait)
+	assert.Equal(t, http.DefaultClient, lp.Client)
+	assert.Equal(t, 5, lp.Backoff.MaxAttempts)
+	assert.Equal(t, 10*time.Second, lp.RequestTimeoutExtra)
+}
+
+type testJSONCodec struct {
+	called bool
+}
+
+func (c *testJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (c *testJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	c.called = true
+
+	return json.Unmarshal(data, v)
+}
+
+func TestWithJSONCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := &testJSONCodec{}
+
+	lp := newLongPoll(api.NewVK(""), GID, []Option{
+		WithJSONCodec(codec),
+	})
+
+	lp.MessageEvent(func(_ context.Context, _ events.MessageEventObject) {})
+
+	err := lp.Handler(context.Background(), events.GroupEvent{
+		Type:   events.EventMessageEvent,
+		Object: []byte(`{}`),
+	})
+	assert.NoError(t, err)
+	assert.True(t, codec.called)
+}
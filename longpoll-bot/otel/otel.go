@@ -0,0 +1,95 @@
+/*
+Package otel implements OpenTelemetry tracing of Bots Long Poll API.
+
+Each poll cycle gets a span, and each handled event gets a child span. The
+context of the event span is passed to handlers, so requests to VK API made
+with this context are traced as well.
+
+	otel.Instrument(lp, nil)
+*/
+package otel // import "github.com/SevereCloud/vksdk/v2/longpoll-bot/otel"
+
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	longpoll "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer.
+const InstrumentationName = "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+// Attribute keys.
+const (
+	GroupIDKey   = attribute.Key("vk.group_id")
+	EventTypeKey = attribute.Key("vk.event.type")
+	EventIDKey   = attribute.Key("vk.event.id")
+)
+
+// Tracer implements longpoll.Tracer.
+type Tracer struct {
+	tracer  trace.Tracer
+	groupID int
+}
+
+// NewTracer returns a new Tracer. If tp is nil, the global tracer provider
+// is used.
+func NewTracer(tp trace.TracerProvider, groupID int) *Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return &Tracer{
+		tracer:  tp.Tracer(InstrumentationName),
+		groupID: groupID,
+	}
+}
+
+// Instrument sets the tracer of the longpoll.
+func Instrument(lp *longpoll.LongPoll, tp trace.TracerProvider) *Tracer {
+	t := NewTracer(tp, lp.GroupID)
+	lp.Tracer = t
+
+	return t
+}
+
+// StartPoll implements longpoll.Tracer.
+func (t *Tracer) StartPoll(ctx context.Context) (context.Context, func(err error)) {
+	ctx, span := t.tracer.Start(ctx, "longpoll.poll",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(GroupIDKey.Int(t.groupID)),
+	)
+
+	return ctx, func(err error) {
+		end(span, err)
+	}
+}
+
+// StartEvent implements longpoll.Tracer.
+func (t *Tracer) StartEvent(ctx context.Context, event events.GroupEvent) (context.Context, func(err error)) {
+	ctx, span := t.tracer.Start(ctx, "longpoll.event "+string(event.Type),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			GroupIDKey.Int(event.GroupID),
+			EventTypeKey.String(string(event.Type)),
+			EventIDKey.String(event.EventID),
+		),
+	)
+
+	return ctx, func(err error) {
+		end(span, err)
+	}
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
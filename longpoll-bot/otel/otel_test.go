@@ -0,0 +1,55 @@
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/events"
+	longpoll "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+	"github.com/SevereCloud/vksdk/v2/longpoll-bot/otel"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInstrument(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{},"group_id":1,"event_id":"abc"}]}`))
+	}))
+	defer server.Close()
+
+	lp, err := longpoll.NewLongPoll(api.NewVK(""), 1, longpoll.WithServer(server.URL, "key", "1"))
+	assert.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	otel.Instrument(lp, tp)
+
+	var handlerSpan trace.SpanContext
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+	})
+	lp.FullResponse(func(resp longpoll.Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		event, poll := spans[0], spans[1]
+
+		assert.Equal(t, "longpoll.event test", event.Name())
+		assert.Equal(t, "longpoll.poll", poll.Name())
+		assert.Equal(t, poll.SpanContext().SpanID(), event.Parent().SpanID())
+		assert.Equal(t, event.SpanContext().SpanID(), handlerSpan.SpanID())
+	}
+}
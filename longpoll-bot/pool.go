@@ -0,0 +1,121 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+)
+
+type task struct {
+	ctx   context.Context
+	event events.GroupEvent
+	raw   json.RawMessage
+	wg    *sync.WaitGroup
+}
+
+// workerPool dispatches events to a fixed number of goroutines.
+type workerPool struct {
+	tasks  chan task
+	wg     sync.WaitGroup
+	last   chan struct{}
+	cancel context.CancelFunc
+
+	mux sync.Mutex
+	err error
+}
+
+func newWorkerPool(
+	n int,
+	handle func(context.Context, events.GroupEvent, json.RawMessage) error,
+	cancel context.CancelFunc,
+) *workerPool {
+	p := &workerPool{
+		tasks:  make(chan task),
+		cancel: cancel,
+	}
+
+	p.wg.Add(n)
+
+	for i := 0; i < n; i++ {
+		go p.worker(handle)
+	}
+
+	return p
+}
+
+func (p *workerPool) worker(handle func(context.Context, events.GroupEvent, json.RawMessage) error) {
+	defer p.wg.Done()
+
+	for t := range p.tasks {
+		if err := handle(t.ctx, t.event, t.raw); err != nil {
+			p.fail(err)
+		}
+
+		t.wg.Done()
+	}
+}
+
+// fail saves the first error and stops polling.
+func (p *workerPool) fail(err error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.err == nil {
+		p.err = err
+		p.cancel()
+	}
+}
+
+// Err returns the first handler error.
+func (p *workerPool) Err() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	return p.err
+}
+
+// dispatch sends updates to workers. The done function is called after
+// all updates are handled and after done of the previous dispatch. The
+// returned channel is closed after done is called.
+func (p *workerPool) dispatch(ctx context.Context, resp Response, done func() error) <-chan struct{} {
+	var wg sync.WaitGroup
+
+	wg.Add(len(resp.Updates))
+
+	for i, event := range resp.Updates {
+		p.tasks <- task{ctx: ctx, event: event, raw: resp.rawUpdate(i), wg: &wg}
+	}
+
+	prev := p.last
+	next := make(chan struct{})
+	p.last = next
+
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+		defer close(next)
+
+		wg.Wait()
+
+		if prev != nil {
+			<-prev
+		}
+
+		if p.Err() == nil {
+			if err := done(); err != nil {
+				p.fail(err)
+			}
+		}
+	}()
+
+	return next
+}
+
+// close waits for all dispatched updates.
+func (p *workerPool) close() {
+	close(p.tasks)
+	p.wg.Wait()
+}
@@ -0,0 +1,143 @@
+/*
+Package prometheus implements the Prometheus collector of Bots Long Poll API
+metrics.
+
+	collector, err := prometheus.Register(prom.DefaultRegisterer, lp)
+*/
+package prometheus // import "github.com/SevereCloud/vksdk/v2/longpoll-bot/prometheus"
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	longpoll "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace of metrics.
+const Namespace = "vksdk_longpoll"
+
+// Collector implements longpoll.Metrics and prometheus.Collector.
+//
+// Exposed metrics:
+//
+//	vksdk_longpoll_polls_total{result}
+//	vksdk_longpoll_poll_duration_seconds
+//	vksdk_longpoll_failed_total{code}
+//	vksdk_longpoll_events_total{type}
+//	vksdk_longpoll_handler_errors_total{type}
+//	vksdk_longpoll_handler_duration_seconds{type}
+type Collector struct {
+	polls           *prom.CounterVec
+	pollDuration    prom.Histogram
+	failed          *prom.CounterVec
+	events          *prom.CounterVec
+	handlerErrors   *prom.CounterVec
+	handlerDuration *prom.HistogramVec
+}
+
+// NewCollector returns a new Collector. The constant labels are added to
+// all metrics, e.g. to distinguish communities.
+func NewCollector(constLabels prom.Labels) *Collector {
+	return &Collector{
+		polls: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "polls_total",
+			Help:        "Total number of requests to the longpoll server.",
+			ConstLabels: constLabels,
+		}, []string{"result"}),
+		pollDuration: prom.NewHistogram(prom.HistogramOpts{
+			Namespace:   Namespace,
+			Name:        "poll_duration_seconds",
+			Help:        "Duration of requests to the longpoll server.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{0.1, 0.5, 1, 5, 10, 25, 30, 60, 90},
+		}),
+		failed: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "failed_total",
+			Help:        "Total number of responses with the failed field.",
+			ConstLabels: constLabels,
+		}, []string{"code"}),
+		events: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "events_total",
+			Help:        "Total number of handled events.",
+			ConstLabels: constLabels,
+		}, []string{"type"}),
+		handlerErrors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   Namespace,
+			Name:        "handler_errors_total",
+			Help:        "Total number of errors of event handlers.",
+			ConstLabels: constLabels,
+		}, []string{"type"}),
+		handlerDuration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace:   Namespace,
+			Name:        "handler_duration_seconds",
+			Help:        "Duration of event handling.",
+			ConstLabels: constLabels,
+			Buckets:     prom.DefBuckets,
+		}, []string{"type"}),
+	}
+}
+
+// Register creates a collector for the longpoll, registers it and sets it
+// as the longpoll metrics. The group_id label is added to all metrics.
+func Register(reg prom.Registerer, lp *longpoll.LongPoll) (*Collector, error) {
+	c := NewCollector(prom.Labels{"group_id": strconv.Itoa(lp.GroupID)})
+
+	if err := reg.Register(c); err != nil {
+		return nil, err
+	}
+
+	lp.Metrics = c
+
+	return c, nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.polls.Describe(ch)
+	c.pollDuration.Describe(ch)
+	c.failed.Describe(ch)
+	c.events.Describe(ch)
+	c.handlerErrors.Describe(ch)
+	c.handlerDuration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.polls.Collect(ch)
+	c.pollDuration.Collect(ch)
+	c.failed.Collect(ch)
+	c.events.Collect(ch)
+	c.handlerErrors.Collect(ch)
+	c.handlerDuration.Collect(ch)
+}
+
+// ObservePoll implements longpoll.Metrics.
+func (c *Collector) ObservePoll(d time.Duration, updates int, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+
+	c.polls.WithLabelValues(result).Inc()
+	c.pollDuration.Observe(d.Seconds())
+}
+
+// ObserveFailed implements longpoll.Metrics.
+func (c *Collector) ObserveFailed(code int) {
+	c.failed.WithLabelValues(strconv.Itoa(code)).Inc()
+}
+
+// ObserveHandler implements longpoll.Metrics.
+func (c *Collector) ObserveHandler(eventType events.EventType, d time.Duration, err error) {
+	c.events.WithLabelValues(string(eventType)).Inc()
+	c.handlerDuration.WithLabelValues(string(eventType)).Observe(d.Seconds())
+
+	if err != nil {
+		c.handlerErrors.WithLabelValues(string(eventType)).Inc()
+	}
+}
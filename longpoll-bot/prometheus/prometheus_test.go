@@ -0,0 +1,47 @@
+package prometheus_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/events"
+	longpoll "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+	"github.com/SevereCloud/vksdk/v2/longpoll-bot/prometheus"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	lp, err := longpoll.NewLongPoll(api.NewVK(""), 123456, longpoll.WithServer("http://127.0.0.1", "key", "1"))
+	assert.NoError(t, err)
+
+	reg := prom.NewRegistry()
+
+	c, err := prometheus.Register(reg, lp)
+	assert.NoError(t, err)
+	assert.Equal(t, c, lp.Metrics)
+
+	_, err = prometheus.Register(reg, lp)
+	assert.Error(t, err)
+
+	c.ObservePoll(time.Second, 2, nil)
+	c.ObservePoll(time.Second, 0, errors.New("poll error"))
+	c.ObserveFailed(2)
+	c.ObserveHandler(events.EventMessageNew, time.Millisecond, nil)
+	c.ObserveHandler(events.EventMessageNew, time.Millisecond, errors.New("handler error"))
+
+	count, err := testutil.GatherAndCount(reg,
+		"vksdk_longpoll_polls_total",
+		"vksdk_longpoll_failed_total",
+		"vksdk_longpoll_events_total",
+		"vksdk_longpoll_handler_errors_total",
+		"vksdk_longpoll_handler_duration_seconds",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
@@ -0,0 +1,209 @@
+package longpoll // import "github.com/SevereCloud/vksdk/v2/longpoll-bot"
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/SevereCloud/vksdk/v2/internal"
+)
+
+// WALEntry is the updates of one response saved in the write-ahead log.
+type WALEntry struct {
+	GroupID int                 `json:"group_id"`
+	Ts      string              `json:"ts"`
+	Updates []events.GroupEvent `json:"updates,omitempty"`
+}
+
+// WAL is a write-ahead log of updates.
+//
+// Updates are appended before they are dispatched to handlers and marked
+// done after all of them are handled, so unprocessed updates are replayed
+// on the next run after a crash.
+type WAL interface {
+	// Append saves the updates of the response with the ts.
+	Append(entry WALEntry) error
+	// Done marks the updates of the response with the ts as processed.
+	Done(groupID int, ts string) error
+	// Pending returns the unprocessed entries in the order of appending.
+	Pending(groupID int) ([]WALEntry, error)
+}
+
+// replay handles the unprocessed updates from the WAL.
+func (lp *LongPoll) replay(ctx context.Context) error {
+	if lp.WAL == nil {
+		return nil
+	}
+
+	entries, err := lp.WAL.Pending(lp.GroupID)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		lp.logf("longpoll: replay ts=%s updates=%d", entry.Ts, len(entry.Updates))
+
+		eventCtx := context.WithValue(ctx, internal.LongPollTsKey, entry.Ts)
+
+		for _, event := range entry.Updates {
+			if err := lp.handle(eventCtx, event, nil); err != nil {
+				return err
+			}
+		}
+
+		// the next request continues after the replayed updates, otherwise
+		// the server returns them again
+		lp.Ts = entry.Ts
+
+		if lp.TsStorage != nil {
+			if err := lp.TsStorage.Set(lp.GroupID, entry.Ts); err != nil {
+				return err
+			}
+		}
+
+		if err := lp.WAL.Done(lp.GroupID, entry.Ts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FileWAL is the WAL stored in a file as JSON lines.
+//
+// The file is truncated when all entries are processed.
+type FileWAL struct {
+	mux     sync.Mutex
+	file    *os.File
+	pending []WALEntry
+}
+
+type walRecord struct {
+	WALEntry
+	Done bool `json:"done,omitempty"`
+}
+
+// OpenFileWAL opens or creates the WAL file and reads unprocessed entries.
+func OpenFileWAL(name string) (*FileWAL, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &FileWAL{file: file}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16*1024*1024)
+
+	for scanner.Scan() {
+		var record walRecord
+
+		// a partially written record after a crash is ignored
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+
+		if record.Done {
+			w.remove(record.GroupID, record.Ts)
+		} else {
+			w.pending = append(w.pending, record.WALEntry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Append implements WAL.
+func (w *FileWAL) Append(entry WALEntry) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if err := w.write(walRecord{WALEntry: entry}); err != nil {
+		return err
+	}
+
+	w.pending = append(w.pending, entry)
+
+	return w.file.Sync()
+}
+
+// Done implements WAL.
+func (w *FileWAL) Done(groupID int, ts string) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	w.remove(groupID, ts)
+
+	if len(w.pending) == 0 {
+		if err := w.file.Truncate(0); err != nil {
+			return err
+		}
+
+		_, err := w.file.Seek(0, io.SeekStart)
+
+		return err
+	}
+
+	return w.write(walRecord{
+		WALEntry: WALEntry{GroupID: groupID, Ts: ts},
+		Done:     true,
+	})
+}
+
+// Pending implements WAL.
+func (w *FileWAL) Pending(groupID int) ([]WALEntry, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	var entries []WALEntry
+
+	for _, entry := range w.pending {
+		if entry.GroupID == groupID {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// Close closes the file.
+func (w *FileWAL) Close() error {
+	return w.file.Close()
+}
+
+func (w *FileWAL) write(record walRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.file.Write(append(b, '\n'))
+
+	return err
+}
+
+func (w *FileWAL) remove(groupID int, ts string) {
+	for i, entry := range w.pending {
+		if entry.GroupID == groupID && entry.Ts == ts {
+			w.pending = append(w.pending[:i], w.pending[i+1:]...)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package longpoll
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/v2/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWAL(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "wal")
+
+	w, err := OpenFileWAL(name)
+	assert.NoError(t, err)
+
+	event := events.GroupEvent{Type: "test", Object: []byte(`{}`), GroupID: GID}
+
+	assert.NoError(t, w.Append(WALEntry{GroupID: GID, Ts: "2", Updates: []events.GroupEvent{event}}))
+	assert.NoError(t, w.Append(WALEntry{GroupID: GID, Ts: "3", Updates: []events.GroupEvent{event}}))
+	assert.NoError(t, w.Append(WALEntry{GroupID: 1, Ts: "3", Updates: []events.GroupEvent{event}}))
+	assert.NoError(t, w.Done(GID, "2"))
+	assert.NoError(t, w.Close())
+
+	// partially written record
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NoError(t, err)
+	_, _ = f.WriteString(`{"group_id":`)
+	assert.NoError(t, f.Close())
+
+	w, err = OpenFileWAL(name)
+	assert.NoError(t, err)
+
+	entries, err := w.Pending(GID)
+	assert.NoError(t, err)
+	assert.Equal(t, []WALEntry{{GroupID: GID, Ts: "3", Updates: []events.GroupEvent{event}}}, entries)
+
+	assert.NoError(t, w.Done(GID, "3"))
+	assert.NoError(t, w.Done(1, "3"))
+
+	info, err := os.Stat(name)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+	assert.NoError(t, w.Close())
+}
+
+func TestLongPoll_WAL(t *testing.T) {
+	t.Parallel()
+
+	w, err := OpenFileWAL(filepath.Join(t.TempDir(), "wal"))
+	assert.NoError(t, err)
+
+	defer w.Close()
+
+	event := events.GroupEvent{Type: "test", Object: []byte(`{"id":1}`)}
+	assert.NoError(t, w.Append(WALEntry{GroupID: GID, Ts: "1", Updates: []events.GroupEvent{event}}))
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{"id":2}}]}`))
+	})
+	lp.GroupID = GID
+	lp.WAL = w
+
+	var handled []string
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled = append(handled, string(e.Object))
+
+		entries, err := w.Pending(GID)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, []string{`{"id":1}`, `{"id":2}`}, handled)
+
+	entries, err := w.Pending(GID)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLongPoll_WAL_tsStorage(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "wal")
+	event := events.GroupEvent{Type: "test", Object: []byte(`{"id":1}`)}
+
+	// the process crashed after the response with ts=2 was appended, but
+	// before ts was saved
+	w, err := OpenFileWAL(name)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Append(WALEntry{GroupID: GID, Ts: "2", Updates: []events.GroupEvent{event}}))
+	assert.NoError(t, w.Close())
+
+	storage := &memoryTsStorage{ts: map[int]string{GID: "1"}}
+
+	w, err = OpenFileWAL(name)
+	assert.NoError(t, err)
+
+	defer w.Close()
+
+	var requested []string
+
+	lp := newTestLongPoll(t, func(w http.ResponseWriter, r *http.Request) {
+		ts := r.URL.Query().Get("ts")
+		requested = append(requested, ts)
+
+		if ts == "1" {
+			_, _ = w.Write([]byte(`{"ts":"2","updates":[{"type":"test","object":{"id":1}}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ts":"3","updates":[]}`))
+	})
+	lp.GroupID = GID
+	lp.WAL = w
+	lp.TsStorage = storage
+
+	var handled []string
+
+	lp.OnEvent("test", func(ctx context.Context, e events.GroupEvent) {
+		handled = append(handled, string(e.Object))
+	})
+	lp.FullResponse(func(resp Response) {
+		lp.Shutdown()
+	})
+
+	assert.NoError(t, lp.Run())
+	assert.Equal(t, []string{`{"id":1}`}, handled)
+	assert.Equal(t, []string{"2"}, requested)
+	assert.Equal(t, "3", storage.ts[GID])
+}
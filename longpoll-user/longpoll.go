@@ -123,6 +123,19 @@ func NewLongPoll(vk *api.VK, mode Mode) (*LongPoll, error) {
 	return lp, err
 }
 
+// SetProxy sets the HTTP, HTTPS or SOCKS5 proxy of requests to the longpoll
+// server. Requests to VK API use the proxy of VK, see api.VK.SetProxy.
+func (lp *LongPoll) SetProxy(rawURL string) error {
+	u, err := api.ParseProxyURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	lp.Client = api.ProxyClient(lp.Client, u)
+
+	return nil
+}
+
 // Goroutine invoke functions in a goroutine.
 func (lp *LongPoll) Goroutine(v bool) {
 	lp.goroutine = v
@@ -212,7 +225,7 @@ func (lp LongPoll) handler(event []interface{}) error {
 
 	for _, f := range lp.funcList[key] {
 		if lp.goroutine {
-			go func() { _ = f(event) }()
+			go func(f EventNewFunc) { _ = f(event) }(f)
 		} else {
 			err := f(event)
 			if err != nil {
@@ -242,7 +255,7 @@ func (lp *LongPoll) Run() error {
 
 		for _, f := range lp.funcFullResponseList {
 			if lp.goroutine {
-				go func() { f(resp) }()
+				go f(resp)
 			} else {
 				f(resp)
 			}
@@ -765,6 +765,7 @@ type GroupsLongPollEvents struct {
 	AppPayload                    BaseBoolInt `json:"app_payload"`
 	MessageRead                   BaseBoolInt `json:"message_read"`
 	MessageEvent                  BaseBoolInt `json:"message_event"`
+	MessageReactionEvent          BaseBoolInt `json:"message_reaction_event"`
 	DonutSubscriptionCreate       BaseBoolInt `json:"donut_subscription_create"`
 	DonutSubscriptionProlonged    BaseBoolInt `json:"donut_subscription_prolonged"`
 	DonutSubscriptionExpired      BaseBoolInt `json:"donut_subscription_expired"`
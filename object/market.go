@@ -182,6 +182,10 @@ type MarketOrder struct {
 	TotalPrice        MarketPrice          `json:"total_price"`
 	DisplayOrderID    string               `json:"display_order_id"`
 	Comment           string               `json:"comment"`
+	MerchantComment   string               `json:"merchant_comment"`
+	Weight            int                  `json:"weight"`
+	IsViewedByAdmin   BaseBoolInt          `json:"is_viewed_by_admin"`
+	CancelInfo        BaseLink             `json:"cancel_info"`
 	PreviewOrderItems []MarketOrderItem    `json:"preview_order_items"`
 	PriceDetails      []MarketPriceDetail  `json:"price_details"`
 	Delivery          MarketOrderDelivery  `json:"delivery"`
@@ -740,6 +740,43 @@ type MessagesPinnedMessage struct {
 // MessagesUserXtrInvitedBy struct.
 type MessagesUserXtrInvitedBy struct{}
 
+// ChatPeerIDOffset is added to the chat ID to get the peer ID of the chat.
+const ChatPeerIDOffset = 2000000000
+
+// IsChat reports whether the message is from a chat.
+func (message MessagesMessage) IsChat() bool {
+	return message.PeerID > ChatPeerIDOffset
+}
+
+// ChatID returns the chat ID if the message is from a chat, otherwise zero.
+func (message MessagesMessage) ChatID() int {
+	if !message.IsChat() {
+		return 0
+	}
+
+	return message.PeerID - ChatPeerIDOffset
+}
+
+// Reply returns the forward parameter to reply to the message by its
+// conversation_message_id.
+//
+//	b.Forward(message.Reply())
+func (message MessagesMessage) Reply() MessagesForward {
+	forward := message.Forward()
+	forward.IsReply = true
+
+	return forward
+}
+
+// Forward returns the forward parameter to forward the message by its
+// conversation_message_id to the same peer.
+func (message MessagesMessage) Forward() MessagesForward {
+	return MessagesForward{
+		PeerID:                 message.PeerID,
+		ConversationMessageIDs: []int{message.ConversationMessageID},
+	}
+}
+
 // MessagesForward struct.
 type MessagesForward struct {
 	// Message owner. It is worth passing it on if you want to forward messages
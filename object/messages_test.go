@@ -353,3 +353,30 @@ func TestMessagesForward_ToJSON(t *testing.T) {
 		`{}`,
 	)
 }
+
+func TestMessagesMessage_ChatID(t *testing.T) {
+	t.Parallel()
+
+	message := object.MessagesMessage{PeerID: 2000000001}
+	assert.True(t, message.IsChat())
+	assert.Equal(t, 1, message.ChatID())
+
+	message = object.MessagesMessage{PeerID: 1}
+	assert.False(t, message.IsChat())
+	assert.Equal(t, 0, message.ChatID())
+}
+
+func TestMessagesMessage_Reply(t *testing.T) {
+	t.Parallel()
+
+	message := object.MessagesMessage{PeerID: 2000000001, ConversationMessageID: 5}
+
+	assert.Equal(t, object.MessagesForward{
+		PeerID:                 2000000001,
+		ConversationMessageIDs: []int{5},
+	}, message.Forward())
+	assert.Equal(t,
+		`{"peer_id":2000000001,"conversation_message_ids":[5],"is_reply":true}`,
+		message.Reply().ToJSON(),
+	)
+}
@@ -402,12 +402,54 @@ type ExtendedResponse struct {
 }
 
 // ClientInfo struct.
+//
+// Information about features available to the user.
 type ClientInfo struct {
-	ButtonActions  []string    `json:"button_actions"`
-	Keyboard       BaseBoolInt `json:"keyboard"`
+	// Button action types supported by the client, e.g. ButtonCallback.
+	ButtonActions []string `json:"button_actions"`
+
+	// Whether the client supports keyboards.
+	Keyboard BaseBoolInt `json:"keyboard"`
+
+	// Whether the client supports inline keyboards.
 	InlineKeyboard BaseBoolInt `json:"inline_keyboard"`
-	Carousel       BaseBoolInt `json:"carousel"`
-	LangID         int         `json:"lang_id"`
+
+	// Whether the client supports carousels.
+	Carousel BaseBoolInt `json:"carousel"`
+
+	// Client language, e.g. LangRU.
+	LangID int `json:"lang_id"`
+}
+
+// SupportsButton reports whether the client supports the button action
+// type, e.g. ButtonCallback.
+func (info ClientInfo) SupportsButton(action string) bool {
+	for _, a := range info.ButtonActions {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsKeyboard reports whether the client supports keyboards with the
+// button action type. If the action type is empty, only the support of
+// keyboards is checked.
+func (info ClientInfo) SupportsKeyboard(action string) bool {
+	return bool(info.Keyboard) && (action == "" || info.SupportsButton(action))
+}
+
+// SupportsInlineKeyboard reports whether the client supports inline
+// keyboards with the button action type. If the action type is empty, only
+// the support of inline keyboards is checked.
+func (info ClientInfo) SupportsInlineKeyboard(action string) bool {
+	return bool(info.InlineKeyboard) && (action == "" || info.SupportsButton(action))
+}
+
+// SupportsCarousel reports whether the client supports carousels.
+func (info ClientInfo) SupportsCarousel() bool {
+	return bool(info.Carousel)
 }
 
 // Language code.
@@ -1,6 +1,7 @@
 package object_test
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -182,3 +183,27 @@ func TestBaseSticker_MinSizeBackground(t *testing.T) {
 		Width: 10, Height: 20,
 	})
 }
+
+func TestClientInfo(t *testing.T) {
+	t.Parallel()
+
+	var info object.ClientInfo
+
+	err := json.Unmarshal([]byte(`{
+		"button_actions":["text","vkpay","open_app","location","open_link","callback"],
+		"keyboard":true,
+		"inline_keyboard":true,
+		"carousel":false,
+		"lang_id":0
+	}`), &info)
+	assert.NoError(t, err)
+
+	assert.True(t, info.SupportsButton(object.ButtonCallback))
+	assert.True(t, info.SupportsKeyboard(""))
+	assert.True(t, info.SupportsInlineKeyboard(object.ButtonCallback))
+	assert.False(t, info.SupportsCarousel())
+	assert.Equal(t, object.LangRU, info.LangID)
+
+	info.ButtonActions = []string{object.ButtonText}
+	assert.False(t, info.SupportsInlineKeyboard(object.ButtonCallback))
+}